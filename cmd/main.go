@@ -2,7 +2,9 @@ package main
 
 import (
 	"context"
-	"log"
+	"flag"
+	"log/slog"
+	"os"
 	"os/signal"
 	"syscall"
 	"tender-service/internal/app"
@@ -10,26 +12,29 @@ import (
 )
 
 func main() {
-	cfg := config.MustLoad("./config/config.yaml")
+	configPath := flag.String("config", config.Path(), "path to config file")
+	flag.Parse()
 
-	ctx := context.TODO()
+	cfg := config.MustLoad(*configPath)
 
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
 	a, err := app.NewApp(ctx, cfg)
 	if err != nil {
-		log.Fatal("cannot setup server:", err.Error())
+		slog.Error("cannot setup server", slog.Any("error", err))
+		os.Exit(1)
 	}
 	go func() {
 		if err = a.Run(); err != nil {
-			log.Fatal("stop server:", err.Error())
+			slog.Error("stop server", slog.Any("error", err))
+			os.Exit(1)
 		}
 	}()
 
 	<-ctx.Done()
-	log.Println("got interruption signal")
+	slog.Info("got interruption signal")
 	if err := a.Stop(); err != nil {
-		log.Printf("server shutdown returned an err: %v\n", err)
+		slog.Error("server shutdown returned an error", slog.Any("error", err))
 	}
 }
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"github.com/urfave/cli/v2"
+	"tender-service/internal/app"
+)
+
+func bidCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "bid",
+		Usage: "maintain bids",
+		Subcommands: []*cli.Command{
+			{
+				Name:      "recompute-decisions",
+				Usage:     "derive decisions of the tender bids from stored votes",
+				ArgsUsage: "<tender id>",
+				Action: withCtl(func(c *cli.Context, ctl *app.Ctl) error {
+					tenderId, err := uuidArg(c, "tender id")
+					if err != nil {
+						return err
+					}
+
+					changed, err := ctl.BidService().RecomputeBidDecisions(c.Context, tenderId)
+					if err != nil {
+						return err
+					}
+
+					for _, b := range changed {
+						fmt.Fprintf(c.App.Writer, "%s\t%s\n", b.Id, b.Decision)
+					}
+					fmt.Fprintf(c.App.Writer, "%d bid decisions changed\n", len(changed))
+
+					return nil
+				}),
+			},
+		},
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"github.com/urfave/cli/v2"
+	"io"
+	"os"
+	"tender-service/internal/app"
+)
+
+const stdio = "-"
+
+func exportCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "export",
+		Usage: "write employees, organizations, tenders, bids and their history as json",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "output", Aliases: []string{"o"}, Value: stdio, Usage: "file to write, - for stdout"},
+		},
+		Action: withCtl(func(c *cli.Context, ctl *app.Ctl) error {
+			var w io.Writer = c.App.Writer
+			if path := c.String("output"); path != stdio {
+				file, err := os.Create(path)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				w = file
+			}
+
+			return ctl.DumpService().Export(c.Context, w)
+		}),
+	}
+}
+
+func importCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "import",
+		Usage: "load an export into a migrated database, rows that already exist are skipped",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "input", Aliases: []string{"i"}, Value: stdio, Usage: "file to read, - for stdin"},
+		},
+		Action: withCtl(func(c *cli.Context, ctl *app.Ctl) error {
+			var r io.Reader = os.Stdin
+			if path := c.String("input"); path != stdio {
+				file, err := os.Open(path)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				r = file
+			}
+
+			imported, err := ctl.DumpService().Import(c.Context, r)
+			if err != nil {
+				return err
+			}
+
+			for _, table := range imported {
+				fmt.Fprintf(c.App.Writer, "%s\t%d\n", table.Name, table.Inserted)
+			}
+
+			return nil
+		}),
+	}
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"github.com/urfave/cli/v2"
+	"tender-service/internal/app"
+	"tender-service/internal/mapper"
+	"tender-service/internal/model/entity"
+)
+
+func employeeCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "employee",
+		Usage: "manage employees",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "create",
+				Usage: "create an employee",
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "username", Required: true},
+					&cli.StringFlag{Name: "first-name"},
+					&cli.StringFlag{Name: "last-name"},
+				},
+				Action: withCtl(func(c *cli.Context, ctl *app.Ctl) error {
+					created, err := ctl.EmployeeService().CreateEmployee(c.Context, entity.Employee{
+						Username:  c.String("username"),
+						FirstName: c.String("first-name"),
+						LastName:  c.String("last-name"),
+					})
+					if err != nil {
+						return err
+					}
+
+					return printJson(c, mapper.EmployeeToEmployeeDto(created))
+				}),
+			},
+		},
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/urfave/cli/v2"
+	"os"
+	"tender-service/internal/app"
+	"tender-service/internal/config"
+)
+
+const configFlag = "config"
+
+func main() {
+	ctl := &cli.App{
+		Name:  "tenderctl",
+		Usage: "operate tender-service without writing SQL by hand",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    configFlag,
+				Value:   config.DefaultPath,
+				EnvVars: []string{config.PathEnv},
+				Usage:   "path to config file, shared with the server",
+			},
+		},
+		Commands: []*cli.Command{
+			migrateCommand(),
+			employeeCommand(),
+			organizationCommand(),
+			tenderCommand(),
+			bidCommand(),
+			exportCommand(),
+			importCommand(),
+			outboxCommand(),
+		},
+	}
+
+	if err := ctl.Run(os.Args); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func loadConfig(c *cli.Context) (config.Config, error) {
+	return config.Load(c.String(configFlag))
+}
+
+// withCtl loads the config and hands the app services to the action
+func withCtl(action func(c *cli.Context, ctl *app.Ctl) error) cli.ActionFunc {
+	return func(c *cli.Context) error {
+		cfg, err := loadConfig(c)
+		if err != nil {
+			return err
+		}
+
+		ctl := app.NewCtl(cfg)
+		defer ctl.Close()
+
+		return action(c, ctl)
+	}
+}
+
+func printJson(c *cli.Context, value any) error {
+	encoder := json.NewEncoder(c.App.Writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
+
+func parseUuid(value, name string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("incorrect %s %q: %w", name, value, err)
+	}
+	return id, nil
+}
+
+func uuidArg(c *cli.Context, name string) (uuid.UUID, error) {
+	if c.NArg() != 1 {
+		return uuid.Nil, fmt.Errorf("expected one argument: %s", name)
+	}
+	return parseUuid(c.Args().First(), name)
+}
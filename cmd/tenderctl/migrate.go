@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/pressly/goose/v3"
+	"github.com/urfave/cli/v2"
+	"strconv"
+	"tender-service/internal/migrate"
+	"time"
+)
+
+func migrateCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "migrate",
+		Usage: "run and inspect goose migrations from postgres.migrations-dir",
+		Subcommands: []*cli.Command{
+			{
+				Name:   "up",
+				Usage:  "apply all pending migrations",
+				Action: withMigrator(runMigrations((*migrate.Migrator).Up)),
+			},
+			{
+				Name:   "down",
+				Usage:  "roll back the latest applied migration",
+				Action: withMigrator(runMigrations((*migrate.Migrator).Down)),
+			},
+			{
+				Name:      "to-version",
+				Usage:     "migrate up or down to the version, 0 rolls back everything",
+				ArgsUsage: "<version>",
+				Action: withMigrator(func(c *cli.Context, migrator *migrate.Migrator) error {
+					version, err := strconv.ParseInt(c.Args().First(), 10, 64)
+					if err != nil || version < 0 {
+						return fmt.Errorf("incorrect version %q", c.Args().First())
+					}
+					return runMigrations(func(m *migrate.Migrator, ctx context.Context) ([]*goose.MigrationResult, error) {
+						return m.To(ctx, version)
+					})(c, migrator)
+				}),
+			},
+			{
+				Name:   "status",
+				Usage:  "print applied and pending migrations",
+				Action: withMigrator(printMigrationStatus),
+			},
+		},
+	}
+}
+
+func withMigrator(action func(c *cli.Context, migrator *migrate.Migrator) error) cli.ActionFunc {
+	return func(c *cli.Context) error {
+		cfg, err := loadConfig(c)
+		if err != nil {
+			return err
+		}
+
+		migrator, err := migrate.NewMigrator(cfg.Postgres.Conn, cfg.Postgres.MigrationsDir)
+		if err != nil {
+			return err
+		}
+		defer migrator.Close()
+
+		return action(c, migrator)
+	}
+}
+
+func runMigrations(run func(m *migrate.Migrator, ctx context.Context) ([]*goose.MigrationResult, error)) func(c *cli.Context, migrator *migrate.Migrator) error {
+	return func(c *cli.Context, migrator *migrate.Migrator) error {
+		results, err := run(migrator, c.Context)
+		for _, result := range results {
+			fmt.Fprintln(c.App.Writer, result)
+		}
+		if err != nil {
+			return err
+		}
+
+		version, err := migrator.Version(c.Context)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(c.App.Writer, "database is at version %d\n", version)
+		return nil
+	}
+}
+
+func printMigrationStatus(c *cli.Context, migrator *migrate.Migrator) error {
+	statuses, err := migrator.Status(c.Context)
+	if err != nil {
+		return err
+	}
+
+	for _, status := range statuses {
+		appliedAt := "-"
+		if status.State == goose.StateApplied {
+			appliedAt = status.AppliedAt.Format(time.RFC3339)
+		}
+		fmt.Fprintf(c.App.Writer, "%-8s %-25s %s\n", status.State, appliedAt, status.Source.Path)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"github.com/urfave/cli/v2"
+	"tender-service/internal/app"
+	"tender-service/internal/mapper"
+	"tender-service/internal/model/entity/organization"
+)
+
+func organizationCommand() *cli.Command {
+	memberFlags := []cli.Flag{
+		&cli.StringFlag{Name: "organization", Usage: "organization id", Required: true},
+		&cli.StringFlag{Name: "username", Required: true},
+	}
+
+	return &cli.Command{
+		Name:  "organization",
+		Usage: "manage organizations and their responsible employees",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "create",
+				Usage: "create an organization",
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "name", Required: true},
+					&cli.StringFlag{Name: "description"},
+					&cli.StringFlag{Name: "type", Usage: "IE, LLC or JSC"},
+				},
+				Action: withCtl(func(c *cli.Context, ctl *app.Ctl) error {
+					created, err := ctl.OrganizationService().CreateOrganization(c.Context, organization.Organization{
+						Name:        c.String("name"),
+						Description: c.String("description"),
+						Type:        organization.Type(c.String("type")),
+					})
+					if err != nil {
+						return err
+					}
+
+					return printJson(c, mapper.OrganizationToOrganizationDto(created))
+				}),
+			},
+			{
+				Name:   "add-member",
+				Usage:  "make an employee responsible for the organization",
+				Flags:  memberFlags,
+				Action: withCtl(membershipAction(true)),
+			},
+			{
+				Name:   "remove-member",
+				Usage:  "revoke responsibility of an employee for the organization",
+				Flags:  memberFlags,
+				Action: withCtl(membershipAction(false)),
+			},
+		},
+	}
+}
+
+func membershipAction(add bool) func(c *cli.Context, ctl *app.Ctl) error {
+	return func(c *cli.Context, ctl *app.Ctl) error {
+		orgId, err := parseUuid(c.String("organization"), "organization id")
+		if err != nil {
+			return err
+		}
+
+		employee, err := ctl.EmployeeService().GetEmployeeByUsername(c.Context, c.String("username"))
+		if err != nil {
+			return err
+		}
+
+		if add {
+			return ctl.OrganizationService().AddOrganizationEmployee(c.Context, orgId, employee)
+		}
+		return ctl.OrganizationService().RemoveOrganizationEmployee(c.Context, orgId, employee)
+	}
+}
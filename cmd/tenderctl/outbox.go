@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"github.com/urfave/cli/v2"
+	"tender-service/internal/app"
+	"tender-service/internal/model/entity/notification"
+)
+
+func outboxCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "outbox",
+		Usage: "manage the notification outbox",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "replay",
+				Usage: "schedule notifications for delivery again, running servers pick them up",
+				Flags: []cli.Flag{
+					&cli.StringSliceFlag{Name: "id", Usage: "notification id, all matching notifications when omitted"},
+					&cli.StringSliceFlag{Name: "status", Value: cli.NewStringSlice(string(notification.Failed)), Usage: "Pending, Processing, Sent or Failed"},
+					&cli.TimestampFlag{Name: "since", Layout: "2006-01-02T15:04:05Z07:00", Usage: "only notifications created after the RFC 3339 time"},
+				},
+				Action: withCtl(func(c *cli.Context, ctl *app.Ctl) error {
+					var filter notification.ReplayFilter
+
+					for _, value := range c.StringSlice("id") {
+						id, err := parseUuid(value, "notification id")
+						if err != nil {
+							return err
+						}
+						filter.Ids = append(filter.Ids, id)
+					}
+
+					for _, status := range c.StringSlice("status") {
+						filter.Statuses = append(filter.Statuses, notification.Status(status))
+					}
+
+					filter.Since = c.Timestamp("since")
+
+					count, err := ctl.NotificationService().ReplayNotifications(c.Context, filter)
+					if err != nil {
+						return err
+					}
+
+					fmt.Fprintf(c.App.Writer, "%d notifications scheduled\n", count)
+					return nil
+				}),
+			},
+		},
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"github.com/urfave/cli/v2"
+	"tender-service/internal/app"
+	"tender-service/internal/model/entity/tender"
+)
+
+func tenderCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "tender",
+		Usage: "change tenders bypassing employee rights",
+		Subcommands: []*cli.Command{
+			{
+				Name:      "close",
+				Usage:     "force-close a tender",
+				ArgsUsage: "<tender id>",
+				Action:    withCtl(forceStatusAction(tender.Closed)),
+			},
+			{
+				Name:      "reopen",
+				Usage:     "publish a closed tender again",
+				ArgsUsage: "<tender id>",
+				Flags: []cli.Flag{
+					&cli.BoolFlag{Name: "clear-award", Usage: "drop the award and return the bid decisions to None"},
+				},
+				Action: withCtl(reopenAction),
+			},
+		},
+	}
+}
+
+func forceStatusAction(status tender.Status) func(c *cli.Context, ctl *app.Ctl) error {
+	return func(c *cli.Context, ctl *app.Ctl) error {
+		tenderId, err := uuidArg(c, "tender id")
+		if err != nil {
+			return err
+		}
+
+		updated, err := ctl.TenderService().ForceTenderStatus(c.Context, tenderId, status)
+		if err != nil {
+			return err
+		}
+
+		return printJson(c, updated)
+	}
+}
+
+func reopenAction(c *cli.Context, ctl *app.Ctl) error {
+	tenderId, err := uuidArg(c, "tender id")
+	if err != nil {
+		return err
+	}
+
+	reopened, err := ctl.TenderService().ReopenTender(c.Context, tenderId, c.Bool("clear-award"))
+	if err != nil {
+		return err
+	}
+
+	return printJson(c, reopened)
+}
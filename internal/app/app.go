@@ -2,22 +2,44 @@ package app
 
 import (
 	"context"
-	"flag"
-	_ "github.com/jackc/pgx/v5/stdlib"
-	"github.com/pressly/goose/v3"
 	httpSwagger "github.com/swaggo/http-swagger"
-	"log"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/grpc"
+	"log/slog"
+	"net"
 	"net/http"
+	"sync"
+	"sync/atomic"
 	"tender-service/internal/config"
+	"tender-service/internal/health"
+	"tender-service/internal/logger"
 	"tender-service/internal/middleware"
+	"tender-service/internal/migrate"
+	"tender-service/internal/openapi"
+	"tender-service/internal/ratelimit"
+	"tender-service/internal/rpc"
+	"tender-service/internal/tracing"
+	tenderv1 "tender-service/pkg/api/tender/v1"
+	"time"
 )
 
+const grpcShutdownTimeout = 5 * time.Second
+
 type App struct {
-	provider *serviceProvider
-	server   http.Server
+	provider     *serviceProvider
+	server       http.Server
+	grpcServer   *grpc.Server
+	grpcListener net.Listener
+	workers      sync.WaitGroup
+	running      atomic.Int64
+	stopWorkers  context.CancelFunc
+	tracer       *sdktrace.TracerProvider
 }
 
 func NewApp(ctx context.Context, cfg config.Config) (*App, error) {
+	slog.SetDefault(logger.NewLogger(cfg.Log))
+
 	a := &App{}
 	a.provider = newServiceProvider(cfg)
 
@@ -32,8 +54,11 @@ func NewApp(ctx context.Context, cfg config.Config) (*App, error) {
 func (a *App) setup(ctx context.Context) error {
 
 	funcs := []func(context.Context) error{
+		a.setupTracing,
 		a.runMigrationsForPostgres,
+		a.setupHealth,
 		a.setupHttpServer,
+		a.setupGrpcServer,
 	}
 
 	for _, f := range funcs {
@@ -48,47 +73,123 @@ func (a *App) setup(ctx context.Context) error {
 
 func (a *App) setupHttpServer(ctx context.Context) error {
 
-	tenderMux := http.NewServeMux()
-	tenderMux.HandleFunc("POST /new", a.provider.TenderController().PostNewTender(ctx))
+	idempotent := func(handler http.Handler) http.Handler {
+		return middleware.GetIdempotencyMiddleware(a.provider.IdempotencyService(), a.provider.config.Idempotency.MaxBodySize, a.provider.Handler(), handler)
+	}
+
+	tenderMux := newRouter("/api/tenders")
+	tenderMux.Handle("POST /new", idempotent(a.provider.TenderController().PostNewTender(ctx)))
 	tenderMux.HandleFunc("GET /my", a.provider.TenderController().GetUserTenders(ctx))
 	tenderMux.HandleFunc("GET /{tenderId}/status", a.provider.TenderController().GetTenderStatus(ctx))
 	tenderMux.HandleFunc("PUT /{tenderId}/status", a.provider.TenderController().PutTenderStatus(ctx))
+	tenderMux.HandleFunc("PUT /{tenderId}/cancel", a.provider.TenderController().PutTenderCancel(ctx))
+	tenderMux.HandleFunc("GET /{tenderId}/award", a.provider.TenderController().GetTenderAward(ctx))
 	tenderMux.HandleFunc("PATCH /{tenderId}/edit", a.provider.TenderController().PatchTender(ctx))
 	tenderMux.HandleFunc("PUT /{tenderId}/rollback/{version}", a.provider.TenderController().PutTenderRollback(ctx))
 
-	bidMux := http.NewServeMux()
-	bidMux.HandleFunc("POST /new", a.provider.BidController().PostNewBid(ctx))
+	bidMux := newRouter("/api/bids")
+	bidMux.Handle("POST /new", idempotent(a.provider.BidController().PostNewBid(ctx)))
 	bidMux.HandleFunc("GET /my", a.provider.BidController().GetUserBids(ctx))
 	bidMux.HandleFunc("GET /{tenderId}/list", a.provider.BidController().GetTenderBids(ctx))
 	bidMux.HandleFunc("GET /{bidId}/status", a.provider.BidController().GetBidStatus(ctx))
 	bidMux.HandleFunc("PUT /{bidId}/status", a.provider.BidController().PutBidStatus(ctx))
 	bidMux.HandleFunc("PATCH /{bidId}/edit", a.provider.BidController().PatchBid(ctx))
-	bidMux.HandleFunc("PUT /{bidId}/submit_decision", a.provider.BidController().PutBidSubmitDecision(ctx))
-	bidMux.HandleFunc("PUT /{bidId}/feedback", a.provider.BidController().PutBidFeedback(ctx))
+	bidMux.Handle("PUT /{bidId}/submit_decision", idempotent(a.provider.BidController().PutBidSubmitDecision(ctx)))
+	bidMux.HandleFunc("DELETE /{bidId}/submit_decision", a.provider.BidController().DeleteBidSubmitDecision(ctx))
+	bidMux.HandleFunc("GET /{bidId}/decisions", a.provider.BidController().GetBidDecisions(ctx))
+	bidMux.Handle("PUT /{bidId}/feedback", idempotent(a.provider.BidController().PutBidFeedback(ctx)))
 	bidMux.HandleFunc("PUT /{bidId}/rollback/{version}", a.provider.BidController().PutBidRollback(ctx))
 	bidMux.HandleFunc("GET /{tenderId}/reviews", a.provider.BidController().GetBidReviews(ctx))
+	bidMux.HandleFunc("PUT /{bidId}/withdraw", a.provider.BidController().PutBidWithdraw(ctx))
+
+	searchMux := newRouter("/api/searches")
+	searchMux.HandleFunc("POST /new", a.provider.SavedSearchController().PostNewSavedSearch(ctx))
+	searchMux.HandleFunc("GET /my", a.provider.SavedSearchController().GetUserSavedSearches(ctx))
+	searchMux.HandleFunc("DELETE /{searchId}", a.provider.SavedSearchController().DeleteSavedSearch(ctx))
 
-	api := http.NewServeMux()
+	contractMux := newRouter("/api/contracts")
+	contractMux.HandleFunc("POST /new", a.provider.ContractController().PostNewContract(ctx))
+	contractMux.HandleFunc("GET /my", a.provider.ContractController().GetUserContracts(ctx))
+	contractMux.HandleFunc("GET /{contractId}", a.provider.ContractController().GetContract(ctx))
+	contractMux.HandleFunc("POST /{contractId}/milestones", a.provider.ContractController().PostContractMilestone(ctx))
+	contractMux.HandleFunc("PUT /{contractId}/milestones/{milestoneId}/complete", a.provider.ContractController().PutContractMilestoneComplete(ctx))
+	contractMux.HandleFunc("POST /{contractId}/rating", a.provider.ContractController().PostContractRating(ctx))
+
+	ratingMux := newRouter("/api/ratings")
+	ratingMux.HandleFunc("GET /employees/{employeeId}", a.provider.RatingController().GetEmployeeReputation(ctx))
+	ratingMux.HandleFunc("GET /employees/{employeeId}/history", a.provider.RatingController().GetEmployeeRatingHistory(ctx))
+	ratingMux.HandleFunc("GET /organizations/{organizationId}", a.provider.RatingController().GetOrganizationReputation(ctx))
+
+	categoryMux := newRouter("/api/admin/categories")
+	categoryMux.HandleFunc("POST /new", a.provider.CategoryController().PostNewCategory(ctx))
+	categoryMux.HandleFunc("PATCH /{categoryId}/edit", a.provider.CategoryController().PatchCategory(ctx))
+	categoryMux.HandleFunc("DELETE /{categoryId}", a.provider.CategoryController().DeleteCategory(ctx))
+
+	adminMux := newRouter("/api/admin")
+	adminMux.Handle("/categories/", http.StripPrefix("/categories", categoryMux))
+
+	api := newRouter("/api")
 
 	api.Handle("GET /ping", a.provider.PingController().GetPing(ctx))
 	api.Handle("GET /tenders", a.provider.TenderController().GetTenders(ctx))
+	api.Handle("GET /tenders/search", a.provider.TenderController().SearchTenders(ctx))
+	api.Handle("GET /categories", a.provider.CategoryController().GetCategories(ctx))
+	api.Handle("GET /categories/{categoryId}", a.provider.CategoryController().GetCategory(ctx))
 
 	api.Handle("/bids/", http.StripPrefix("/bids", bidMux))
 	api.Handle("/tenders/", http.StripPrefix("/tenders", tenderMux))
+	api.Handle("/searches/", http.StripPrefix("/searches", searchMux))
+	api.Handle("/contracts/", http.StripPrefix("/contracts", contractMux))
+	api.Handle("/ratings/", http.StripPrefix("/ratings", ratingMux))
+	api.Handle("/admin/", middleware.GetAdminMiddleware(a.provider.config.Admin.Token, a.provider.Handler(), http.StripPrefix("/admin", adminMux)))
 
 	main := http.NewServeMux()
 
-	main.Handle("/api/", middleware.GetLoggerMiddleware(http.StripPrefix("/api", api)))
+	var apiRouter http.Handler = api
+
+	if openApi := a.provider.config.OpenApi; openApi.ValidateRequests || openApi.ValidateResponses {
+		validator, err := openapi.NewValidator(openApi.Path)
+		if err != nil {
+			return err
+		}
+
+		apiRouter = middleware.GetOpenApiMiddleware(validator, openApi.ValidateRequests, openApi.ValidateResponses, a.provider.Handler(), api)
+	}
+
+	var apiHandler http.Handler = http.StripPrefix("/api", apiRouter)
+
+	if rateLimit := a.provider.config.RateLimit; rateLimit.Enabled {
+		if err := ratelimit.ValidateBackend(rateLimit.Backend); err != nil {
+			return err
+		}
+
+		limits, err := ratelimit.NewLimits(rateLimit)
+		if err != nil {
+			return err
+		}
+
+		apiHandler = middleware.GetRateLimitMiddleware(
+			a.provider.RateLimiter(), limits, a.provider.IdentityResolver(), rateLimit.TrustProxy, a.provider.Handler(), apiHandler,
+		)
+	}
+
+	main.Handle("/api/", otelhttp.NewHandler(middleware.GetRequestMiddleware(
+		middleware.GetMetricsMiddleware(a.provider.Metrics(), middleware.GetLoggerMiddleware(apiHandler)),
+	), "api"))
+
+	main.Handle("GET /metrics", a.provider.Metrics().Handler())
+	main.HandleFunc("GET /healthz", a.provider.HealthController().GetHealthz(ctx))
+	main.HandleFunc("GET /readyz", a.provider.HealthController().GetReadyz(ctx))
 
 	main.HandleFunc("/swagger/*", httpSwagger.Handler(
 		httpSwagger.URL("/docs/openapi.yml"),
 	))
 
 	main.HandleFunc("/docs/openapi.yml", func(w http.ResponseWriter, r *http.Request) {
-		http.ServeFile(w, r, "./docs/openapi.yml")
+		http.ServeFile(w, r, a.provider.config.OpenApi.Path)
 	})
 
-	log.Println("starting on:" + a.provider.config.Server.Address)
+	slog.Info("starting http server", slog.String("address", a.provider.config.Server.Address))
 
 	a.server = http.Server{
 		Addr:    a.provider.config.Server.Address,
@@ -97,29 +198,143 @@ func (a *App) setupHttpServer(ctx context.Context) error {
 	return nil
 }
 
+func (a *App) setupGrpcServer(_ context.Context) error {
+	cfg := a.provider.config.Grpc
+	if !cfg.Enabled {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", cfg.Address)
+	if err != nil {
+		return err
+	}
+
+	a.grpcServer = rpc.NewServer()
+	tenderv1.RegisterTenderServiceServer(a.grpcServer, a.provider.TenderServer())
+	tenderv1.RegisterBidServiceServer(a.grpcServer, a.provider.BidServer())
+
+	slog.Info("starting grpc server", slog.String("address", cfg.Address))
+
+	a.grpcListener = listener
+	return nil
+}
+
 func (a *App) Run() error {
+	a.runWorkers()
+
+	if a.grpcServer != nil {
+		go func() {
+			if err := a.grpcServer.Serve(a.grpcListener); err != nil {
+				slog.Error("grpc server stopped", slog.Any("error", err))
+			}
+		}()
+	}
+
 	return a.server.ListenAndServe()
 }
 
 func (a *App) Stop() error {
-	log.Println("Gracefully shutdown...")
-	return a.server.Shutdown(context.Background())
+	slog.Info("gracefully shutting down")
+	a.provider.Health().Drain()
+	time.Sleep(a.provider.config.Server.DrainDelay)
+
+	err := a.server.Shutdown(context.Background())
+
+	if a.grpcServer != nil {
+		a.stopGrpcServer()
+	}
+
+	if a.stopWorkers != nil {
+		a.stopWorkers()
+		a.workers.Wait()
+	}
+
+	if a.tracer != nil {
+		if shutdownErr := a.tracer.Shutdown(context.Background()); shutdownErr != nil {
+			slog.Error("cannot flush traces", slog.Any("error", shutdownErr))
+		}
+	}
+
+	return err
 }
 
-func (a *App) runMigrationsForPostgres(_ context.Context) error {
-	log.Println("running migrations in:", a.provider.config.Postgres.MigrationsDir)
+// stopGrpcServer waits for unary calls, event streams never end by themselves so they are cut after a timeout
+func (a *App) stopGrpcServer() {
+	stopped := make(chan struct{})
+	go func() {
+		a.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(grpcShutdownTimeout):
+		a.grpcServer.Stop()
+	}
+}
+
+func (a *App) runWorkers() {
+	ctx, cancel := context.WithCancel(context.Background())
+	a.stopWorkers = cancel
+
+	for _, w := range a.provider.Workers() {
+		a.workers.Add(1)
+		a.running.Add(1)
+		go func() {
+			defer a.workers.Done()
+			defer a.running.Add(-1)
+			w.Run(ctx)
+		}()
+	}
+}
+
+func (a *App) setupHealth(_ context.Context) error {
+	migrationsCheck, err := health.NewMigrationsCheck(a.provider.Pool(), a.provider.config.Postgres.MigrationsDir)
+	if err != nil {
+		return err
+	}
+
+	workersCount := len(a.provider.Workers())
+
+	h := a.provider.Health()
+	h.Register(health.PostgresComponent, health.NewPostgresCheck(a.provider.Pool()))
+	h.Register(health.MigrationsComponent, migrationsCheck)
+	h.Register(health.WorkersComponent, health.NewWorkersCheck(workersCount, func() int {
+		return int(a.running.Load())
+	}))
+
+	return nil
+}
+
+func (a *App) setupTracing(ctx context.Context) error {
+	tracer, err := tracing.NewTracerProvider(ctx, a.provider.config.Tracing)
+	if err != nil {
+		return err
+	}
+
+	a.tracer = tracer
+	return nil
+}
+
+func (a *App) runMigrationsForPostgres(ctx context.Context) error {
+	if !a.provider.config.Postgres.MigrateOnStartup {
+		slog.Info("migrations on startup are disabled")
+		return nil
+	}
 
-	dsn := flag.String("dsn", a.provider.config.Postgres.Conn, "PostgreSQL")
+	slog.Info("running migrations", slog.String("dir", a.provider.config.Postgres.MigrationsDir))
 
-	sql, err := goose.OpenDBWithDriver("postgres", *dsn)
+	migrator, err := migrate.NewMigrator(a.provider.config.Postgres.Conn, a.provider.config.Postgres.MigrationsDir)
 	if err != nil {
 		return err
 	}
+	defer migrator.Close()
 
-	err = goose.Up(sql, a.provider.config.Postgres.MigrationsDir)
+	results, err := migrator.Up(ctx)
 	if err != nil {
 		return err
 	}
 
+	slog.Info("migrations applied", slog.Int("count", len(results)))
 	return nil
 }
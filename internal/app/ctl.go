@@ -0,0 +1,53 @@
+package app
+
+import (
+	"log/slog"
+	"tender-service/internal/config"
+	"tender-service/internal/logger"
+	"tender-service/internal/service"
+)
+
+// Ctl gives operator tooling the services of the app without starting servers and workers
+type Ctl struct {
+	provider *serviceProvider
+}
+
+func NewCtl(cfg config.Config) *Ctl {
+	slog.SetDefault(logger.NewLogger(cfg.Log))
+
+	return &Ctl{provider: newServiceProvider(cfg)}
+}
+
+func (c *Ctl) Config() config.Config {
+	return c.provider.config
+}
+
+func (c *Ctl) EmployeeService() service.EmployeeService {
+	return c.provider.EmployeeService()
+}
+
+func (c *Ctl) OrganizationService() service.OrganizationService {
+	return c.provider.OrganizationService()
+}
+
+func (c *Ctl) TenderService() service.TenderService {
+	return c.provider.TenderService()
+}
+
+func (c *Ctl) BidService() service.BidService {
+	return c.provider.BidService()
+}
+
+func (c *Ctl) NotificationService() service.NotificationService {
+	return c.provider.NotificationService()
+}
+
+func (c *Ctl) DumpService() service.DumpService {
+	return c.provider.DumpService()
+}
+
+func (c *Ctl) Close() {
+	if c.provider.pool != nil {
+		c.provider.pool.Close()
+	}
+}
@@ -0,0 +1,32 @@
+package app
+
+import (
+	"net/http"
+	"strings"
+	"tender-service/internal/middleware"
+)
+
+type router struct {
+	mux    *http.ServeMux
+	prefix string
+}
+
+func newRouter(prefix string) *router {
+	return &router{mux: http.NewServeMux(), prefix: prefix}
+}
+
+func (r *router) Handle(pattern string, handler http.Handler) {
+	path := pattern
+	if _, after, found := strings.Cut(pattern, " "); found {
+		path = after
+	}
+	r.mux.Handle(pattern, middleware.WithRoute(r.prefix+path, handler))
+}
+
+func (r *router) HandleFunc(pattern string, handler http.HandlerFunc) {
+	r.Handle(pattern, handler)
+}
+
+func (r *router) ServeHTTP(w http.ResponseWriter, request *http.Request) {
+	r.mux.ServeHTTP(w, request)
+}
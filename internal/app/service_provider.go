@@ -6,42 +6,106 @@ import (
 	"tender-service/internal/config"
 	"tender-service/internal/controller"
 	bid3 "tender-service/internal/controller/bid"
+	category3 "tender-service/internal/controller/category"
+	contract3 "tender-service/internal/controller/contract"
+	health2 "tender-service/internal/controller/health"
 	"tender-service/internal/controller/ping"
+	rating3 "tender-service/internal/controller/rating"
+	savedsearch3 "tender-service/internal/controller/savedsearch"
 	tender3 "tender-service/internal/controller/tender"
+	"tender-service/internal/events"
+	"tender-service/internal/health"
 	"tender-service/internal/httperr"
+	"tender-service/internal/metrics"
+	"tender-service/internal/middleware"
+	"tender-service/internal/notifier"
+	log2 "tender-service/internal/notifier/log"
+	"tender-service/internal/notifier/webhook"
+	"tender-service/internal/ratelimit"
 	"tender-service/internal/repository"
+	award2 "tender-service/internal/repository/award"
 	"tender-service/internal/repository/bid"
+	"tender-service/internal/repository/category"
+	contract2 "tender-service/internal/repository/contract"
 	"tender-service/internal/repository/decision"
+	"tender-service/internal/repository/dump"
 	"tender-service/internal/repository/employee"
 	"tender-service/internal/repository/feedback"
+	idempotency2 "tender-service/internal/repository/idempotency"
+	notification2 "tender-service/internal/repository/notification"
 	"tender-service/internal/repository/organization"
+	ratelimit2 "tender-service/internal/repository/ratelimit"
+	rating2 "tender-service/internal/repository/rating"
 	"tender-service/internal/repository/responsible"
+	savedsearch2 "tender-service/internal/repository/savedsearch"
 	"tender-service/internal/repository/tender"
+	bid4 "tender-service/internal/rpc/bid"
+	tender4 "tender-service/internal/rpc/tender"
 	"tender-service/internal/service"
 	bid2 "tender-service/internal/service/bid"
+	category2 "tender-service/internal/service/category"
+	"tender-service/internal/service/contract"
+	dump2 "tender-service/internal/service/dump"
 	employee2 "tender-service/internal/service/employee"
+	"tender-service/internal/service/idempotency"
+	"tender-service/internal/service/notification"
 	organization2 "tender-service/internal/service/organization"
+	"tender-service/internal/service/rating"
+	"tender-service/internal/service/savedsearch"
 	tender2 "tender-service/internal/service/tender"
+	"tender-service/internal/tracing"
+	"tender-service/internal/util"
+	"tender-service/internal/worker"
+	tenderv1 "tender-service/pkg/api/tender/v1"
 )
 
 type serviceProvider struct {
 	pool                              *pgxpool.Pool
 	config                            config.Config
 	pingController                    controller.PingController
+	healthController                  controller.HealthController
 	bidController                     controller.BidController
 	tenderController                  controller.TenderController
+	savedSearchController             controller.SavedSearchController
+	categoryController                controller.CategoryController
+	contractController                controller.ContractController
+	ratingController                  controller.RatingController
 	bidRepository                     repository.BidRepository
 	employeeRepository                repository.EmployeeRepository
 	decisionRepository                repository.DecisionRepository
+	awardRepository                   repository.AwardRepository
+	contractRepository                repository.ContractRepository
+	ratingRepository                  repository.RatingRepository
 	tenderRepository                  repository.TenderRepository
 	organizationResponsibleRepository repository.OrganizationResponsibleRepository
 	feedbackRepository                repository.FeedbackRepository
 	organizationRepository            repository.OrganizationRepository
+	savedSearchRepository             repository.SavedSearchRepository
+	notificationRepository            repository.NotificationRepository
+	categoryRepository                repository.CategoryRepository
+	rateLimitRepository               repository.RateLimitRepository
+	idempotencyRepository             repository.IdempotencyRepository
+	dumpRepository                    repository.DumpRepository
 	tenderService                     service.TenderService
 	bidService                        service.BidService
 	employeeService                   service.EmployeeService
 	organizationService               service.OrganizationService
+	savedSearchService                service.SavedSearchService
+	notificationService               service.NotificationService
+	categoryService                   service.CategoryService
+	idempotencyService                service.IdempotencyService
+	dumpService                       service.DumpService
+	contractService                   service.ContractService
+	ratingService                     service.RatingService
+	notifier                          notifier.Notifier
 	handler                           httperr.ApiErrorHandler
+	metrics                           *metrics.Metrics
+	health                            *health.Health
+	rateLimiter                       ratelimit.Limiter
+	identityResolver                  middleware.IdentityResolver
+	tenderBroker                      *events.TenderBroker
+	tenderServer                      tenderv1.TenderServiceServer
+	bidServer                         tenderv1.BidServiceServer
 }
 
 func newServiceProvider(cfg config.Config) *serviceProvider {
@@ -57,6 +121,27 @@ func (s *serviceProvider) Handler() httperr.ApiErrorHandler {
 	return s.handler
 }
 
+func (s *serviceProvider) Metrics() *metrics.Metrics {
+	if s.metrics == nil {
+		s.metrics = metrics.NewMetrics(s.Pool())
+	}
+	return s.metrics
+}
+
+func (s *serviceProvider) Health() *health.Health {
+	if s.health == nil {
+		s.health = health.NewHealth()
+	}
+	return s.health
+}
+
+func (s *serviceProvider) HealthController() controller.HealthController {
+	if s.healthController == nil {
+		s.healthController = health2.NewHealthController(s.Health())
+	}
+	return s.healthController
+}
+
 func (s *serviceProvider) PingController() controller.PingController {
 	if s.pingController == nil {
 		s.pingController = ping.NewPingController()
@@ -66,28 +151,81 @@ func (s *serviceProvider) PingController() controller.PingController {
 
 func (s *serviceProvider) BidController() controller.BidController {
 	if s.bidController == nil {
-		s.bidController = bid3.NewBidController(s.BidService(), s.Handler())
+		s.bidController = bid3.NewBidController(s.BidService(), s.Handler(), s.PageLimits())
 	}
 	return s.bidController
 }
 
 func (s *serviceProvider) TenderController() controller.TenderController {
 	if s.tenderController == nil {
-		s.tenderController = tender3.NewTenderController(s.TenderService(), s.Handler())
+		s.tenderController = tender3.NewTenderController(s.TenderService(), s.Handler(), s.PageLimits())
 	}
 	return s.tenderController
 }
 
+func (s *serviceProvider) SavedSearchController() controller.SavedSearchController {
+	if s.savedSearchController == nil {
+		s.savedSearchController = savedsearch3.NewSavedSearchController(s.SavedSearchService(), s.Handler())
+	}
+	return s.savedSearchController
+}
+
+func (s *serviceProvider) CategoryController() controller.CategoryController {
+	if s.categoryController == nil {
+		s.categoryController = category3.NewCategoryController(s.CategoryService(), s.Handler())
+	}
+	return s.categoryController
+}
+
+func (s *serviceProvider) ContractController() controller.ContractController {
+	if s.contractController == nil {
+		s.contractController = contract3.NewContractController(s.ContractService(), s.Handler())
+	}
+	return s.contractController
+}
+
+func (s *serviceProvider) RatingController() controller.RatingController {
+	if s.ratingController == nil {
+		s.ratingController = rating3.NewRatingController(s.RatingService(), s.Handler(), s.PageLimits())
+	}
+	return s.ratingController
+}
+
+func (s *serviceProvider) TenderServer() tenderv1.TenderServiceServer {
+	if s.tenderServer == nil {
+		s.tenderServer = tender4.NewTenderServer(s.TenderService(), s.PageLimits())
+	}
+	return s.tenderServer
+}
+
+func (s *serviceProvider) BidServer() tenderv1.BidServiceServer {
+	if s.bidServer == nil {
+		s.bidServer = bid4.NewBidServer(s.BidService(), s.PageLimits())
+	}
+	return s.bidServer
+}
+
+func (s *serviceProvider) PageLimits() util.PageLimits {
+	return util.NewPageLimits(s.config.Pagination.DefaultLimit, s.config.Pagination.MaxLimit)
+}
+
 func (s *serviceProvider) TenderService() service.TenderService {
 	if s.tenderService == nil {
-		s.tenderService = tender2.NewTenderService(s.TenderRepository(), s.EmployeeService(), s.OrganizationService())
+		s.tenderService = tender2.NewTenderService(s.TenderRepository(), s.AwardRepository(), s.EmployeeService(), s.OrganizationService(), s.NotificationService(), s.CategoryService(), s.Metrics(), s.TenderBroker())
 	}
 	return s.tenderService
 }
 
+func (s *serviceProvider) TenderBroker() *events.TenderBroker {
+	if s.tenderBroker == nil {
+		s.tenderBroker = events.NewTenderBroker(s.config.Grpc.EventBuffer)
+	}
+	return s.tenderBroker
+}
+
 func (s *serviceProvider) BidService() service.BidService {
 	if s.bidService == nil {
-		s.bidService = bid2.NewBidService(s.EmployeeService(), s.OrganizationService(), s.BidRepository(), s.TenderService(), s.FeedbackRepository(), s.DecisionRepository())
+		s.bidService = bid2.NewBidService(s.EmployeeService(), s.OrganizationService(), s.BidRepository(), s.TenderService(), s.FeedbackRepository(), s.DecisionRepository(), s.AwardRepository(), s.RatingRepository(), s.Metrics())
 	}
 	return s.bidService
 }
@@ -106,6 +244,100 @@ func (s *serviceProvider) OrganizationService() service.OrganizationService {
 	return s.organizationService
 }
 
+func (s *serviceProvider) SavedSearchService() service.SavedSearchService {
+	if s.savedSearchService == nil {
+		s.savedSearchService = savedsearch.NewSavedSearchService(s.SavedSearchRepository(), s.EmployeeService(), s.OrganizationService(), s.CategoryService())
+	}
+	return s.savedSearchService
+}
+
+func (s *serviceProvider) ContractService() service.ContractService {
+	if s.contractService == nil {
+		s.contractService = contract.NewContractService(s.ContractRepository(), s.RatingRepository(), s.BidRepository(), s.TenderService(), s.EmployeeService(), s.OrganizationService(), s.Metrics())
+	}
+	return s.contractService
+}
+
+func (s *serviceProvider) RatingService() service.RatingService {
+	if s.ratingService == nil {
+		s.ratingService = rating.NewRatingService(s.RatingRepository(), s.EmployeeService(), s.OrganizationService())
+	}
+	return s.ratingService
+}
+
+func (s *serviceProvider) NotificationService() service.NotificationService {
+	if s.notificationService == nil {
+		cfg := s.config.Notifications
+		s.notificationService = notification.NewNotificationService(s.NotificationRepository(), s.Notifier(), cfg.BatchSize, cfg.MaxAttempts)
+	}
+	return s.notificationService
+}
+
+func (s *serviceProvider) CategoryService() service.CategoryService {
+	if s.categoryService == nil {
+		s.categoryService = category2.NewCategoryService(s.CategoryRepository())
+	}
+	return s.categoryService
+}
+
+func (s *serviceProvider) IdempotencyService() service.IdempotencyService {
+	if s.idempotencyService == nil {
+		s.idempotencyService = idempotency.NewIdempotencyService(s.IdempotencyRepository(), s.config.Idempotency.TTL)
+	}
+	return s.idempotencyService
+}
+
+func (s *serviceProvider) DumpService() service.DumpService {
+	if s.dumpService == nil {
+		s.dumpService = dump2.NewDumpService(s.DumpRepository())
+	}
+	return s.dumpService
+}
+
+func (s *serviceProvider) Notifier() notifier.Notifier {
+	if s.notifier == nil {
+		cfg := s.config.Notifications
+		switch cfg.Notifier {
+		case "webhook":
+			s.notifier = webhook.NewWebhookNotifier(cfg.WebhookUrl, cfg.WebhookTimeout)
+		default:
+			s.notifier = log2.NewLogNotifier()
+		}
+	}
+	return s.notifier
+}
+
+func (s *serviceProvider) Workers() []worker.Worker {
+	workers := []worker.Worker{
+		worker.NewNotificationDispatcher(s.NotificationService(), s.config.Notifications.PollInterval),
+		worker.NewIdempotencyKeyCleaner(s.IdempotencyService()),
+	}
+
+	if s.config.RateLimit.Enabled && s.config.RateLimit.Backend == ratelimit.PostgresBackend {
+		workers = append(workers, worker.NewRateLimitCleaner(s.RateLimitRepository(), ratelimit.StaleBucketAge))
+	}
+
+	return workers
+}
+
+func (s *serviceProvider) RateLimiter() ratelimit.Limiter {
+	if s.rateLimiter == nil {
+		if s.config.RateLimit.Backend == ratelimit.PostgresBackend {
+			s.rateLimiter = ratelimit.NewPostgresLimiter(s.RateLimitRepository())
+		} else {
+			s.rateLimiter = ratelimit.NewMemoryLimiter()
+		}
+	}
+	return s.rateLimiter
+}
+
+func (s *serviceProvider) IdentityResolver() middleware.IdentityResolver {
+	if s.identityResolver == nil {
+		s.identityResolver = ratelimit.NewIdentityResolver(s.EmployeeService(), s.OrganizationService())
+	}
+	return s.identityResolver
+}
+
 func (s *serviceProvider) BidRepository() repository.BidRepository {
 	if s.bidRepository == nil {
 		s.bidRepository = bid.NewBidRepository(s.Pool())
@@ -127,6 +359,27 @@ func (s *serviceProvider) DecisionRepository() repository.DecisionRepository {
 	return s.decisionRepository
 }
 
+func (s *serviceProvider) AwardRepository() repository.AwardRepository {
+	if s.awardRepository == nil {
+		s.awardRepository = award2.NewAwardRepository(s.Pool())
+	}
+	return s.awardRepository
+}
+
+func (s *serviceProvider) ContractRepository() repository.ContractRepository {
+	if s.contractRepository == nil {
+		s.contractRepository = contract2.NewContractRepository(s.Pool())
+	}
+	return s.contractRepository
+}
+
+func (s *serviceProvider) RatingRepository() repository.RatingRepository {
+	if s.ratingRepository == nil {
+		s.ratingRepository = rating2.NewRatingRepository(s.Pool())
+	}
+	return s.ratingRepository
+}
+
 func (s *serviceProvider) TenderRepository() repository.TenderRepository {
 	if s.tenderRepository == nil {
 		s.tenderRepository = tender.NewTenderRepository(s.Pool())
@@ -155,10 +408,57 @@ func (s *serviceProvider) OrganizationRepository() repository.OrganizationReposi
 	return s.organizationRepository
 }
 
+func (s *serviceProvider) SavedSearchRepository() repository.SavedSearchRepository {
+	if s.savedSearchRepository == nil {
+		s.savedSearchRepository = savedsearch2.NewSavedSearchRepository(s.Pool())
+	}
+	return s.savedSearchRepository
+}
+
+func (s *serviceProvider) NotificationRepository() repository.NotificationRepository {
+	if s.notificationRepository == nil {
+		s.notificationRepository = notification2.NewNotificationRepository(s.Pool())
+	}
+	return s.notificationRepository
+}
+
+func (s *serviceProvider) CategoryRepository() repository.CategoryRepository {
+	if s.categoryRepository == nil {
+		s.categoryRepository = category.NewCategoryRepository(s.Pool())
+	}
+	return s.categoryRepository
+}
+
+func (s *serviceProvider) RateLimitRepository() repository.RateLimitRepository {
+	if s.rateLimitRepository == nil {
+		s.rateLimitRepository = ratelimit2.NewRateLimitRepository(s.Pool())
+	}
+	return s.rateLimitRepository
+}
+
+func (s *serviceProvider) IdempotencyRepository() repository.IdempotencyRepository {
+	if s.idempotencyRepository == nil {
+		s.idempotencyRepository = idempotency2.NewIdempotencyRepository(s.Pool())
+	}
+	return s.idempotencyRepository
+}
+
+func (s *serviceProvider) DumpRepository() repository.DumpRepository {
+	if s.dumpRepository == nil {
+		s.dumpRepository = dump.NewDumpRepository(s.Pool())
+	}
+	return s.dumpRepository
+}
+
 func (s *serviceProvider) Pool() *pgxpool.Pool {
 	if s.pool == nil {
 		ctx := context.TODO()
-		pool, err := pgxpool.New(ctx, s.config.Postgres.Conn)
+		poolConfig, err := pgxpool.ParseConfig(s.config.Postgres.Conn)
+		if err != nil {
+			panic(err.Error())
+		}
+		poolConfig.ConnConfig.Tracer = tracing.NewQueryTracer()
+		pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
 		if err != nil {
 			panic(err.Error())
 		}
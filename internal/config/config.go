@@ -1,35 +1,132 @@
 package config
 
 import (
+	"fmt"
 	"github.com/ilyakaznacheev/cleanenv"
 	"log"
 	"os"
+	"time"
 )
 
 type Config struct {
-	Server   ServerConfig   `yaml:"server"`
-	Postgres PostgresConfig `yaml:"postgres"`
+	Server        ServerConfig        `yaml:"server"`
+	Postgres      PostgresConfig      `yaml:"postgres"`
+	Pagination    PaginationConfig    `yaml:"pagination"`
+	Notifications NotificationsConfig `yaml:"notifications"`
+	Admin         AdminConfig         `yaml:"admin"`
+	Tracing       TracingConfig       `yaml:"tracing"`
+	Log           LogConfig           `yaml:"log"`
+	RateLimit     RateLimitConfig     `yaml:"rate-limit"`
+	Idempotency   IdempotencyConfig   `yaml:"idempotency"`
+	Grpc          GrpcConfig          `yaml:"grpc"`
+	OpenApi       OpenApiConfig       `yaml:"openapi"`
 }
 
 type ServerConfig struct {
-	Address string `yaml:"address" env:"SERVER_ADDRESS" env-default:":8080"`
+	Address    string        `yaml:"address" env:"SERVER_ADDRESS" env-default:":8080"`
+	DrainDelay time.Duration `yaml:"drain-delay" env:"SERVER_DRAIN_DELAY" env-default:"0s"`
 }
 
 type PostgresConfig struct {
-	MigrationsDir string `yaml:"migrations-dir" env:"MIGRATIONS_DIR" env-default:"./migrations/prod"`
-	Conn          string `yaml:"conn" env:"POSTGRES_CONN" env-default:""`
+	MigrationsDir    string `yaml:"migrations-dir" env:"MIGRATIONS_DIR" env-default:"./migrations/prod"`
+	MigrateOnStartup bool   `yaml:"migrate-on-startup" env:"MIGRATE_ON_STARTUP" env-default:"true"`
+	Conn             string `yaml:"conn" env:"POSTGRES_CONN" env-default:""`
 }
 
-func MustLoad(configPath string) Config {
+type PaginationConfig struct {
+	DefaultLimit int `yaml:"default-limit" env:"PAGINATION_DEFAULT_LIMIT" env-default:"5"`
+	MaxLimit     int `yaml:"max-limit" env:"PAGINATION_MAX_LIMIT" env-default:"50"`
+}
+
+type NotificationsConfig struct {
+	Notifier       string        `yaml:"notifier" env:"NOTIFIER" env-default:"log"`
+	WebhookUrl     string        `yaml:"webhook-url" env:"NOTIFIER_WEBHOOK_URL" env-default:""`
+	WebhookTimeout time.Duration `yaml:"webhook-timeout" env:"NOTIFIER_WEBHOOK_TIMEOUT" env-default:"5s"`
+	PollInterval   time.Duration `yaml:"poll-interval" env:"NOTIFIER_POLL_INTERVAL" env-default:"1s"`
+	BatchSize      int           `yaml:"batch-size" env:"NOTIFIER_BATCH_SIZE" env-default:"50"`
+	MaxAttempts    int           `yaml:"max-attempts" env:"NOTIFIER_MAX_ATTEMPTS" env-default:"5"`
+}
+
+type AdminConfig struct {
+	Token string `yaml:"token" env:"ADMIN_TOKEN" env-default:""`
+}
+
+type TracingConfig struct {
+	Exporter     string  `yaml:"exporter" env:"TRACING_EXPORTER" env-default:"none"`
+	OtlpEndpoint string  `yaml:"otlp-endpoint" env:"TRACING_OTLP_ENDPOINT" env-default:"localhost:4318"`
+	OtlpInsecure bool    `yaml:"otlp-insecure" env:"TRACING_OTLP_INSECURE" env-default:"true"`
+	ServiceName  string  `yaml:"service-name" env:"TRACING_SERVICE_NAME" env-default:"tender-service"`
+	SampleRatio  float64 `yaml:"sample-ratio" env:"TRACING_SAMPLE_RATIO" env-default:"1"`
+}
+
+type LogConfig struct {
+	Level  string   `yaml:"level" env:"LOG_LEVEL" env-default:"info"`
+	Redact []string `yaml:"redact" env:"LOG_REDACT" env-default:"authorization,password,token,description,feedback,bidFeedback"`
+}
+
+type RateLimitConfig struct {
+	Enabled              bool   `yaml:"enabled" env:"RATE_LIMIT_ENABLED" env-default:"true"`
+	Backend              string `yaml:"backend" env:"RATE_LIMIT_BACKEND" env-default:"memory"`
+	TrustProxy           bool   `yaml:"trust-proxy" env:"RATE_LIMIT_TRUST_PROXY" env-default:"false"`
+	ReadEmployee         string `yaml:"read-employee" env:"RATE_LIMIT_READ_EMPLOYEE" env-default:"600/m"`
+	ReadOrganization     string `yaml:"read-organization" env:"RATE_LIMIT_READ_ORGANIZATION" env-default:"3000/m"`
+	ReadIp               string `yaml:"read-ip" env:"RATE_LIMIT_READ_IP" env-default:"1200/m"`
+	WriteEmployee        string `yaml:"write-employee" env:"RATE_LIMIT_WRITE_EMPLOYEE" env-default:"60/m"`
+	WriteOrganization    string `yaml:"write-organization" env:"RATE_LIMIT_WRITE_ORGANIZATION" env-default:"300/m"`
+	WriteIp              string `yaml:"write-ip" env:"RATE_LIMIT_WRITE_IP" env-default:"120/m"`
+	DecisionEmployee     string `yaml:"decision-employee" env:"RATE_LIMIT_DECISION_EMPLOYEE" env-default:"20/m"`
+	DecisionOrganization string `yaml:"decision-organization" env:"RATE_LIMIT_DECISION_ORGANIZATION" env-default:"100/m"`
+	DecisionIp           string `yaml:"decision-ip" env:"RATE_LIMIT_DECISION_IP" env-default:"40/m"`
+}
+
+type IdempotencyConfig struct {
+	TTL         time.Duration `yaml:"ttl" env:"IDEMPOTENCY_TTL" env-default:"24h"`
+	MaxBodySize int64         `yaml:"max-body-size" env:"IDEMPOTENCY_MAX_BODY_SIZE" env-default:"1048576"`
+}
 
+type GrpcConfig struct {
+	Enabled     bool   `yaml:"enabled" env:"GRPC_ENABLED" env-default:"true"`
+	Address     string `yaml:"address" env:"GRPC_ADDRESS" env-default:":9090"`
+	EventBuffer int    `yaml:"event-buffer" env:"GRPC_EVENT_BUFFER" env-default:"64"`
+}
+
+type OpenApiConfig struct {
+	Path              string `yaml:"path" env:"OPENAPI_PATH" env-default:"./docs/openapi.yml"`
+	ValidateRequests  bool   `yaml:"validate-requests" env:"OPENAPI_VALIDATE_REQUESTS" env-default:"true"`
+	ValidateResponses bool   `yaml:"validate-responses" env:"OPENAPI_VALIDATE_RESPONSES" env-default:"false"`
+}
+
+// DefaultPath is used by the server and tenderctl when neither --config nor CONFIG_PATH is given
+const (
+	DefaultPath = "./config/config.yaml"
+	PathEnv     = "CONFIG_PATH"
+)
+
+func Path() string {
+	if path := os.Getenv(PathEnv); path != "" {
+		return path
+	}
+	return DefaultPath
+}
+
+func Load(configPath string) (Config, error) {
 	if _, err := os.Stat(configPath); os.IsNotExist(err) {
-		log.Fatal("Cannot find config file")
+		return Config{}, fmt.Errorf("cannot find config file %s", configPath)
 	}
 
 	var cfg Config
 
 	if err := cleanenv.ReadConfig(configPath, &cfg); err != nil {
-		log.Fatal("Error while reading config")
+		return Config{}, fmt.Errorf("error while reading config: %w", err)
+	}
+
+	return cfg, nil
+}
+
+func MustLoad(configPath string) Config {
+	cfg, err := Load(configPath)
+	if err != nil {
+		log.Fatal(err.Error())
 	}
 
 	return cfg
@@ -1,18 +1,23 @@
 package bid
 
 import (
-	"fmt"
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	"net/http"
+	"strconv"
 	"tender-service/internal/httperr"
+	"tender-service/internal/i18n"
+	"tender-service/internal/model/dto"
 	"tender-service/internal/service"
+	"tender-service/internal/util"
+	"tender-service/internal/validation"
 )
 
 type controller struct {
 	bidService service.BidService
 	errHandler httperr.ApiErrorHandler
 	validator  *validator.Validate
+	pageLimits util.PageLimits
 }
 
 const (
@@ -25,23 +30,28 @@ const (
 	bidFeedbackQueryParam       = "bidFeedback"
 	authorUsernameQueryParam    = "authorUsername"
 	requesterUsernameQueryParam = "requesterUsername"
+	qualityQueryParam           = "quality"
+	timelinessQueryParam        = "timeliness"
+	communicationQueryParam     = "communication"
 )
 
 var (
-	errTenderPathValueNotFound      = fmt.Errorf("path value tenderId is not presented")
-	errBidPathValueNotFound         = fmt.Errorf("path value bidId is not presented")
-	errNoUsernameQueryPresented     = fmt.Errorf("request param username is not presented")
-	errNoAuthorUsernamePresented    = fmt.Errorf("request param authorUsername is not presented")
-	errNoRequesterUsernamePresented = fmt.Errorf("request param requesterUsername is not presented")
-	errNoBidFeedbackPresented       = fmt.Errorf("request param bidFeedback is not presented")
-	errIncorrectBidDecision         = fmt.Errorf("incorrect bid decision")
+	errTenderPathValueNotFound      = i18n.NewError(i18n.RequestPathValueMissing, "tenderId")
+	errBidPathValueNotFound         = i18n.NewError(i18n.RequestPathValueMissing, "bidId")
+	errNoUsernameQueryPresented     = i18n.NewError(i18n.RequestQueryParamMissing, "username")
+	errNoAuthorUsernamePresented    = i18n.NewError(i18n.RequestQueryParamMissing, "authorUsername")
+	errNoRequesterUsernamePresented = i18n.NewError(i18n.RequestQueryParamMissing, "requesterUsername")
+	errNoBidFeedbackPresented       = i18n.NewError(i18n.RequestQueryParamMissing, "bidFeedback")
+	errIncorrectBidDecision         = i18n.NewError(i18n.BidIncorrectDecision)
+	errRatingIncomplete             = i18n.NewError(i18n.RatingIncomplete)
 )
 
-func NewBidController(bidService service.BidService, errHandler httperr.ApiErrorHandler) *controller {
+func NewBidController(bidService service.BidService, errHandler httperr.ApiErrorHandler, pageLimits util.PageLimits) *controller {
 	return &controller{
 		bidService: bidService,
 		errHandler: errHandler,
-		validator:  validator.New(validator.WithRequiredStructEnabled()),
+		pageLimits: pageLimits,
+		validator:  validation.New(),
 	}
 }
 
@@ -68,3 +78,33 @@ func getTenderIdFromRequest(request *http.Request) (uuid.UUID, error) {
 	}
 	return tenderUuid, nil
 }
+
+// getRatingScoresFromRequest returns nil when the feedback is not rated
+func getRatingScoresFromRequest(request *http.Request) (*dto.RatingScoresDto, error) {
+	query := request.URL.Query()
+	if !query.Has(qualityQueryParam) && !query.Has(timelinessQueryParam) && !query.Has(communicationQueryParam) {
+		return nil, nil
+	}
+
+	var scores dto.RatingScoresDto
+	params := []struct {
+		name  string
+		score *int
+	}{
+		{qualityQueryParam, &scores.Quality},
+		{timelinessQueryParam, &scores.Timeliness},
+		{communicationQueryParam, &scores.Communication},
+	}
+	for _, param := range params {
+		if !query.Has(param.name) {
+			return nil, errRatingIncomplete
+		}
+		value, err := strconv.Atoi(query.Get(param.name))
+		if err != nil || value < 1 || value > 5 {
+			return nil, i18n.NewError(i18n.RatingIncorrectScore, param.name)
+		}
+		*param.score = value
+	}
+
+	return &scores, nil
+}
@@ -0,0 +1,38 @@
+package bid
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"tender-service/internal/model"
+)
+
+func (c *controller) GetBidDecisions(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		op := "tender_controller/get_bid_decisions"
+		writer.Header().Set("Content-Type", "application/json")
+
+		bidId, err := getBidIdFromRequest(request)
+		if err != nil {
+			c.errHandler.Handler(model.NewNotFoundError(op, err), writer, request)
+			return
+		}
+
+		username := request.URL.Query().Get(usernameQueryParam)
+		if username == "" {
+			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoUsernameQueryPresented), writer, request)
+			return
+		}
+
+		decisions, err := c.bidService.GetBidDecisions(request.Context(), bidId, username)
+		if err != nil {
+			c.errHandler.Handler(err, writer, request)
+			return
+		}
+
+		if err = json.NewEncoder(writer).Encode(decisions); err != nil {
+			c.errHandler.Handler(model.NewInternalServerError(op, err), writer, request)
+			return
+		}
+	}
+}
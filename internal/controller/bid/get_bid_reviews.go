@@ -13,34 +13,40 @@ func (c *controller) GetBidReviews(ctx context.Context) http.HandlerFunc {
 		op := "bid_controller/get_bid_reviews"
 		writer.Header().Set("Content-Type", "application/json")
 
-		p := util.NewPageFromRequest(request)
+		p, err := util.NewPageFromRequest(request, c.pageLimits, nil)
+		if err != nil {
+			c.errHandler.Handler(model.NewBadRequestError(op, err), writer, request)
+			return
+		}
 
 		tenderId, err := getTenderIdFromRequest(request)
 		if err != nil {
-			c.errHandler.Handler(model.NewNotFoundError(op, err), writer)
+			c.errHandler.Handler(model.NewNotFoundError(op, err), writer, request)
 			return
 		}
 
 		authorUsername := request.URL.Query().Get(authorUsernameQueryParam)
 		if authorUsername == "" {
-			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoAuthorUsernamePresented), writer)
+			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoAuthorUsernamePresented), writer, request)
 			return
 		}
 
 		requesterUsername := request.URL.Query().Get(requesterUsernameQueryParam)
 		if requesterUsername == "" {
-			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoRequesterUsernamePresented), writer)
+			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoRequesterUsernamePresented), writer, request)
 			return
 		}
 
-		reviews, err := c.bidService.GetBidReviews(ctx, p, tenderId, authorUsername, requesterUsername)
+		reviews, info, err := c.bidService.GetBidReviews(request.Context(), p, tenderId, authorUsername, requesterUsername)
 		if err != nil {
-			c.errHandler.Handler(err, writer)
+			c.errHandler.Handler(err, writer, request)
 			return
 		}
 
+		util.WritePageInfo(writer, info)
+
 		if err = json.NewEncoder(writer).Encode(reviews); err != nil {
-			c.errHandler.Handler(model.NewInternalServerError(op, err), writer)
+			c.errHandler.Handler(model.NewInternalServerError(op, err), writer, request)
 			return
 		}
 	}
@@ -14,24 +14,24 @@ func (c *controller) GetBidStatus(ctx context.Context) http.HandlerFunc {
 
 		bidId, err := getBidIdFromRequest(request)
 		if err != nil {
-			c.errHandler.Handler(model.NewNotFoundError(op, err), writer)
+			c.errHandler.Handler(model.NewNotFoundError(op, err), writer, request)
 			return
 		}
 
 		username := request.URL.Query().Get(usernameQueryParam)
 		if username == "" {
-			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoUsernameQueryPresented), writer)
+			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoUsernameQueryPresented), writer, request)
 			return
 		}
 
-		status, err := c.bidService.GetBidStatus(ctx, bidId, username)
+		status, err := c.bidService.GetBidStatus(request.Context(), bidId, username)
 		if err != nil {
-			c.errHandler.Handler(err, writer)
+			c.errHandler.Handler(err, writer, request)
 			return
 		}
 
 		if err = json.NewEncoder(writer).Encode(status); err != nil {
-			c.errHandler.Handler(model.NewInternalServerError(op, err), writer)
+			c.errHandler.Handler(model.NewInternalServerError(op, err), writer, request)
 			return
 		}
 	}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"tender-service/internal/model"
+	"tender-service/internal/model/entity/bid"
 	"tender-service/internal/util"
 )
 
@@ -13,28 +14,34 @@ func (c *controller) GetTenderBids(ctx context.Context) http.HandlerFunc {
 		op := "tender_controller/get_tender_bids"
 		writer.Header().Set("Content-Type", "application/json")
 
-		p := util.NewPageFromRequest(request)
+		p, err := util.NewPageFromRequest(request, c.pageLimits, bid.SortFields)
+		if err != nil {
+			c.errHandler.Handler(model.NewBadRequestError(op, err), writer, request)
+			return
+		}
 
 		tenderId, err := getTenderIdFromRequest(request)
 		if err != nil {
-			c.errHandler.Handler(model.NewNotFoundError(op, err), writer)
+			c.errHandler.Handler(model.NewNotFoundError(op, err), writer, request)
 			return
 		}
 
 		username := request.URL.Query().Get(usernameQueryParam)
 		if username == "" {
-			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoUsernameQueryPresented), writer)
+			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoUsernameQueryPresented), writer, request)
 			return
 		}
 
-		bids, err := c.bidService.GetTenderBids(ctx, p, tenderId, username)
+		bids, info, err := c.bidService.GetTenderBids(request.Context(), p, tenderId, username)
 		if err != nil {
-			c.errHandler.Handler(err, writer)
+			c.errHandler.Handler(err, writer, request)
 			return
 		}
 
+		util.WritePageInfo(writer, info)
+
 		if err = json.NewEncoder(writer).Encode(bids); err != nil {
-			c.errHandler.Handler(model.NewInternalServerError(op, err), writer)
+			c.errHandler.Handler(model.NewInternalServerError(op, err), writer, request)
 			return
 		}
 	}
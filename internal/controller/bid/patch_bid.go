@@ -15,30 +15,35 @@ func (c *controller) PatchBid(ctx context.Context) http.HandlerFunc {
 
 		bidId, err := getBidIdFromRequest(request)
 		if err != nil {
-			c.errHandler.Handler(model.NewNotFoundError(op, err), writer)
+			c.errHandler.Handler(model.NewNotFoundError(op, err), writer, request)
 			return
 		}
 
 		username := request.URL.Query().Get(usernameQueryParam)
 		if username == "" {
-			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoUsernameQueryPresented), writer)
+			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoUsernameQueryPresented), writer, request)
 			return
 		}
 
 		var dto dto2.UpdateBidDto
 		if err := json.NewDecoder(request.Body).Decode(&dto); err != nil {
-			c.errHandler.Handler(model.NewUnprocessableEntityError(op, err), writer)
+			c.errHandler.Handler(model.NewUnprocessableEntityError(op, err), writer, request)
 			return
 		}
 
-		updated, err := c.bidService.EditBid(ctx, bidId, username, dto)
+		if err := c.validator.Struct(dto); err != nil {
+			c.errHandler.Handler(model.NewBadRequestError(op, err), writer, request)
+			return
+		}
+
+		updated, err := c.bidService.EditBid(request.Context(), bidId, username, dto)
 		if err != nil {
-			c.errHandler.Handler(err, writer)
+			c.errHandler.Handler(err, writer, request)
 			return
 		}
 
 		if err = json.NewEncoder(writer).Encode(updated); err != nil {
-			c.errHandler.Handler(model.NewInternalServerError(op, err), writer)
+			c.errHandler.Handler(model.NewInternalServerError(op, err), writer, request)
 			return
 		}
 	}
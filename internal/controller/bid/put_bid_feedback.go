@@ -14,30 +14,36 @@ func (c *controller) PutBidFeedback(ctx context.Context) http.HandlerFunc {
 
 		bidId, err := getBidIdFromRequest(request)
 		if err != nil {
-			c.errHandler.Handler(model.NewNotFoundError(op, err), writer)
+			c.errHandler.Handler(model.NewNotFoundError(op, err), writer, request)
 			return
 		}
 
 		username := request.URL.Query().Get(usernameQueryParam)
 		if username == "" {
-			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoUsernameQueryPresented), writer)
+			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoUsernameQueryPresented), writer, request)
 			return
 		}
 
 		bidFeedback := request.URL.Query().Get(bidFeedbackQueryParam)
 		if bidFeedback == "" {
-			c.errHandler.Handler(model.NewBadRequestError(op, errNoBidFeedbackPresented), writer)
+			c.errHandler.Handler(model.NewBadRequestError(op, errNoBidFeedbackPresented), writer, request)
 			return
 		}
 
-		bid, err := c.bidService.CreateBidFeedback(ctx, bidId, bidFeedback, username)
+		scores, err := getRatingScoresFromRequest(request)
 		if err != nil {
-			c.errHandler.Handler(err, writer)
+			c.errHandler.Handler(model.NewBadRequestError(op, err), writer, request)
+			return
+		}
+
+		bid, err := c.bidService.CreateBidFeedback(request.Context(), bidId, bidFeedback, username, scores)
+		if err != nil {
+			c.errHandler.Handler(err, writer, request)
 			return
 		}
 
 		if err = json.NewEncoder(writer).Encode(bid); err != nil {
-			c.errHandler.Handler(model.NewInternalServerError(op, err), writer)
+			c.errHandler.Handler(model.NewInternalServerError(op, err), writer, request)
 			return
 		}
 	}
@@ -15,31 +15,31 @@ func (c *controller) PutBidRollback(ctx context.Context) http.HandlerFunc {
 
 		bidId, err := getBidIdFromRequest(request)
 		if err != nil {
-			c.errHandler.Handler(model.NewNotFoundError(op, err), writer)
+			c.errHandler.Handler(model.NewNotFoundError(op, err), writer, request)
 			return
 		}
 
 		versionString := request.PathValue(versionPathValue)
 		version, err := strconv.Atoi(versionString)
 		if err != nil {
-			c.errHandler.Handler(model.NewBadRequestError(op, err), writer)
+			c.errHandler.Handler(model.NewBadRequestError(op, err), writer, request)
 			return
 		}
 
 		username := request.URL.Query().Get(usernameQueryParam)
 		if username == "" {
-			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoUsernameQueryPresented), writer)
+			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoUsernameQueryPresented), writer, request)
 			return
 		}
 
-		bid, err := c.bidService.RollbackBid(ctx, bidId, username, version)
+		bid, err := c.bidService.RollbackBid(request.Context(), bidId, username, version)
 		if err != nil {
-			c.errHandler.Handler(err, writer)
+			c.errHandler.Handler(err, writer, request)
 			return
 		}
 
 		if err = json.NewEncoder(writer).Encode(bid); err != nil {
-			c.errHandler.Handler(model.NewInternalServerError(op, err), writer)
+			c.errHandler.Handler(model.NewInternalServerError(op, err), writer, request)
 			return
 		}
 	}
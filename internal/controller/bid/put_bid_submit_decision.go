@@ -3,7 +3,6 @@ package bid
 import (
 	"context"
 	"encoding/json"
-	"log"
 	"net/http"
 	"tender-service/internal/model"
 	"tender-service/internal/model/entity/decision"
@@ -16,32 +15,30 @@ func (c *controller) PutBidSubmitDecision(ctx context.Context) http.HandlerFunc
 
 		bidId, err := getBidIdFromRequest(request)
 		if err != nil {
-			c.errHandler.Handler(model.NewNotFoundError(op, err), writer)
+			c.errHandler.Handler(model.NewNotFoundError(op, err), writer, request)
 			return
 		}
 
 		username := request.URL.Query().Get(usernameQueryParam)
 		if username == "" {
-			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoUsernameQueryPresented), writer)
+			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoUsernameQueryPresented), writer, request)
 			return
 		}
 
 		des := request.URL.Query().Get(decisionQueryParam)
 		if !decision.IsDecisionVerdict(des) {
-			c.errHandler.Handler(model.NewNotAuthorizedError(op, errIncorrectBidDecision), writer)
+			c.errHandler.Handler(model.NewNotAuthorizedError(op, errIncorrectBidDecision), writer, request)
 			return
 		}
 
-		log.Println(des)
-
-		bid, err := c.bidService.SubmitBidDecision(ctx, bidId, username, decision.Verdict(des))
+		bid, err := c.bidService.SubmitBidDecision(request.Context(), bidId, username, decision.Verdict(des))
 		if err != nil {
-			c.errHandler.Handler(err, writer)
+			c.errHandler.Handler(err, writer, request)
 			return
 		}
 
 		if err = json.NewEncoder(writer).Encode(bid); err != nil {
-			c.errHandler.Handler(model.NewInternalServerError(op, err), writer)
+			c.errHandler.Handler(model.NewInternalServerError(op, err), writer, request)
 			return
 		}
 	}
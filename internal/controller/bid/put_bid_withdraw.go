@@ -0,0 +1,50 @@
+package bid
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"tender-service/internal/model"
+	dto2 "tender-service/internal/model/dto"
+)
+
+func (c *controller) PutBidWithdraw(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		op := "tender_controller/put_bid_withdraw"
+		writer.Header().Set("Content-Type", "application/json")
+
+		bidId, err := getBidIdFromRequest(request)
+		if err != nil {
+			c.errHandler.Handler(model.NewNotFoundError(op, err), writer, request)
+			return
+		}
+
+		username := request.URL.Query().Get(usernameQueryParam)
+		if username == "" {
+			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoUsernameQueryPresented), writer, request)
+			return
+		}
+
+		var dto dto2.WithdrawBidDto
+		if err := json.NewDecoder(request.Body).Decode(&dto); err != nil {
+			c.errHandler.Handler(model.NewUnprocessableEntityError(op, err), writer, request)
+			return
+		}
+
+		if err := c.validator.Struct(dto); err != nil {
+			c.errHandler.Handler(model.NewBadRequestError(op, err), writer, request)
+			return
+		}
+
+		withdrawn, err := c.bidService.WithdrawBid(request.Context(), bidId, username, dto.Reason)
+		if err != nil {
+			c.errHandler.Handler(err, writer, request)
+			return
+		}
+
+		if err = json.NewEncoder(writer).Encode(withdrawn); err != nil {
+			c.errHandler.Handler(model.NewInternalServerError(op, err), writer, request)
+			return
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package category
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"net/http"
+	"tender-service/internal/httperr"
+	"tender-service/internal/i18n"
+	"tender-service/internal/service"
+	"tender-service/internal/validation"
+)
+
+type controller struct {
+	categoryService service.CategoryService
+	errHandler      httperr.ApiErrorHandler
+	validator       *validator.Validate
+}
+
+const (
+	categoryIdPathValue = "categoryId"
+)
+
+var (
+	errCategoryPathValueNotFound = i18n.NewError(i18n.RequestPathValueMissing, "categoryId")
+)
+
+func NewCategoryController(categoryService service.CategoryService, errHandler httperr.ApiErrorHandler) *controller {
+	return &controller{
+		categoryService: categoryService,
+		errHandler:      errHandler,
+		validator:       validation.New(),
+	}
+}
+
+func getCategoryIdFromRequest(request *http.Request) (uuid.UUID, error) {
+	categoryId := request.PathValue(categoryIdPathValue)
+	if categoryId == "" {
+		return uuid.Nil, errCategoryPathValueNotFound
+	}
+	categoryUuid, err := uuid.Parse(categoryId)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return categoryUuid, nil
+}
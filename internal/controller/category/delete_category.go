@@ -0,0 +1,27 @@
+package category
+
+import (
+	"context"
+	"net/http"
+	"tender-service/internal/model"
+)
+
+func (c *controller) DeleteCategory(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		op := "category_controller/delete_category"
+		writer.Header().Set("Content-Type", "application/json")
+
+		categoryId, err := getCategoryIdFromRequest(request)
+		if err != nil {
+			c.errHandler.Handler(model.NewNotFoundError(op, err), writer, request)
+			return
+		}
+
+		if err = c.categoryService.DeleteCategory(request.Context(), categoryId); err != nil {
+			c.errHandler.Handler(err, writer, request)
+			return
+		}
+
+		writer.WriteHeader(http.StatusNoContent)
+	}
+}
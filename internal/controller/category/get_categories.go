@@ -0,0 +1,26 @@
+package category
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"tender-service/internal/model"
+)
+
+func (c *controller) GetCategories(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		op := "category_controller/get_categories"
+		writer.Header().Set("Content-Type", "application/json")
+
+		categories, err := c.categoryService.GetCategories(request.Context())
+		if err != nil {
+			c.errHandler.Handler(err, writer, request)
+			return
+		}
+
+		if err = json.NewEncoder(writer).Encode(categories); err != nil {
+			c.errHandler.Handler(model.NewInternalServerError(op, err), writer, request)
+			return
+		}
+	}
+}
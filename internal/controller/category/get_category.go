@@ -0,0 +1,32 @@
+package category
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"tender-service/internal/model"
+)
+
+func (c *controller) GetCategory(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		op := "category_controller/get_category"
+		writer.Header().Set("Content-Type", "application/json")
+
+		categoryId, err := getCategoryIdFromRequest(request)
+		if err != nil {
+			c.errHandler.Handler(model.NewNotFoundError(op, err), writer, request)
+			return
+		}
+
+		category, err := c.categoryService.GetCategory(request.Context(), categoryId)
+		if err != nil {
+			c.errHandler.Handler(err, writer, request)
+			return
+		}
+
+		if err = json.NewEncoder(writer).Encode(category); err != nil {
+			c.errHandler.Handler(model.NewInternalServerError(op, err), writer, request)
+			return
+		}
+	}
+}
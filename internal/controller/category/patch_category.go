@@ -0,0 +1,44 @@
+package category
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"tender-service/internal/model"
+	dto2 "tender-service/internal/model/dto"
+)
+
+func (c *controller) PatchCategory(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		op := "category_controller/patch_category"
+		writer.Header().Set("Content-Type", "application/json")
+
+		categoryId, err := getCategoryIdFromRequest(request)
+		if err != nil {
+			c.errHandler.Handler(model.NewNotFoundError(op, err), writer, request)
+			return
+		}
+
+		var dto dto2.UpdateCategoryDto
+		if err := json.NewDecoder(request.Body).Decode(&dto); err != nil {
+			c.errHandler.Handler(model.NewUnprocessableEntityError(op, err), writer, request)
+			return
+		}
+
+		if err := c.validator.Struct(dto); err != nil {
+			c.errHandler.Handler(model.NewBadRequestError(op, err), writer, request)
+			return
+		}
+
+		updated, err := c.categoryService.UpdateCategory(request.Context(), categoryId, dto)
+		if err != nil {
+			c.errHandler.Handler(err, writer, request)
+			return
+		}
+
+		if err = json.NewEncoder(writer).Encode(updated); err != nil {
+			c.errHandler.Handler(model.NewInternalServerError(op, err), writer, request)
+			return
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package category
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"tender-service/internal/model"
+	dto2 "tender-service/internal/model/dto"
+)
+
+func (c *controller) PostNewCategory(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		op := "category_controller/post_new_category"
+		writer.Header().Set("Content-Type", "application/json")
+
+		var dto dto2.CreateCategoryDto
+		if err := json.NewDecoder(request.Body).Decode(&dto); err != nil {
+			c.errHandler.Handler(model.NewUnprocessableEntityError(op, err), writer, request)
+			return
+		}
+
+		if err := c.validator.Struct(dto); err != nil {
+			c.errHandler.Handler(model.NewBadRequestError(op, err), writer, request)
+			return
+		}
+
+		saved, err := c.categoryService.CreateCategory(request.Context(), dto)
+		if err != nil {
+			c.errHandler.Handler(err, writer, request)
+			return
+		}
+
+		if err = json.NewEncoder(writer).Encode(saved); err != nil {
+			c.errHandler.Handler(model.NewInternalServerError(op, err), writer, request)
+			return
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package contract
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"net/http"
+	"tender-service/internal/httperr"
+	"tender-service/internal/i18n"
+	"tender-service/internal/service"
+	"tender-service/internal/validation"
+)
+
+type controller struct {
+	contractService service.ContractService
+	errHandler      httperr.ApiErrorHandler
+	validator       *validator.Validate
+}
+
+const (
+	usernameQueryParam   = "username"
+	contractIdPathValue  = "contractId"
+	milestoneIdPathValue = "milestoneId"
+)
+
+var (
+	errContractPathValueNotFound  = i18n.NewError(i18n.RequestPathValueMissing, "contractId")
+	errMilestonePathValueNotFound = i18n.NewError(i18n.RequestPathValueMissing, "milestoneId")
+	errNoUsernameQueryPresented   = i18n.NewError(i18n.RequestQueryParamMissing, "username")
+)
+
+func NewContractController(contractService service.ContractService, errHandler httperr.ApiErrorHandler) *controller {
+	return &controller{
+		contractService: contractService,
+		errHandler:      errHandler,
+		validator:       validation.New(),
+	}
+}
+
+func getContractIdFromRequest(request *http.Request) (uuid.UUID, error) {
+	return getUuidPathValue(request, contractIdPathValue, errContractPathValueNotFound)
+}
+
+func getMilestoneIdFromRequest(request *http.Request) (uuid.UUID, error) {
+	return getUuidPathValue(request, milestoneIdPathValue, errMilestonePathValueNotFound)
+}
+
+func getUuidPathValue(request *http.Request, name string, errMissing error) (uuid.UUID, error) {
+	value := request.PathValue(name)
+	if value == "" {
+		return uuid.Nil, errMissing
+	}
+	parsed, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return parsed, nil
+}
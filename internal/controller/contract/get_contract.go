@@ -0,0 +1,38 @@
+package contract
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"tender-service/internal/model"
+)
+
+func (c *controller) GetContract(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		op := "contract_controller/get_contract"
+		writer.Header().Set("Content-Type", "application/json")
+
+		contractId, err := getContractIdFromRequest(request)
+		if err != nil {
+			c.errHandler.Handler(model.NewNotFoundError(op, err), writer, request)
+			return
+		}
+
+		username := request.URL.Query().Get(usernameQueryParam)
+		if username == "" {
+			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoUsernameQueryPresented), writer, request)
+			return
+		}
+
+		found, err := c.contractService.GetContract(request.Context(), contractId, username)
+		if err != nil {
+			c.errHandler.Handler(err, writer, request)
+			return
+		}
+
+		if err = json.NewEncoder(writer).Encode(found); err != nil {
+			c.errHandler.Handler(model.NewInternalServerError(op, err), writer, request)
+			return
+		}
+	}
+}
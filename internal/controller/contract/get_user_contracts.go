@@ -0,0 +1,32 @@
+package contract
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"tender-service/internal/model"
+)
+
+func (c *controller) GetUserContracts(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		op := "contract_controller/get_user_contracts"
+		writer.Header().Set("Content-Type", "application/json")
+
+		username := request.URL.Query().Get(usernameQueryParam)
+		if username == "" {
+			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoUsernameQueryPresented), writer, request)
+			return
+		}
+
+		contracts, err := c.contractService.GetUserContracts(request.Context(), username)
+		if err != nil {
+			c.errHandler.Handler(err, writer, request)
+			return
+		}
+
+		if err = json.NewEncoder(writer).Encode(contracts); err != nil {
+			c.errHandler.Handler(model.NewInternalServerError(op, err), writer, request)
+			return
+		}
+	}
+}
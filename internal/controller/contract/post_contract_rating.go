@@ -0,0 +1,50 @@
+package contract
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"tender-service/internal/model"
+	dto2 "tender-service/internal/model/dto"
+)
+
+func (c *controller) PostContractRating(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		op := "contract_controller/post_contract_rating"
+		writer.Header().Set("Content-Type", "application/json")
+
+		contractId, err := getContractIdFromRequest(request)
+		if err != nil {
+			c.errHandler.Handler(model.NewNotFoundError(op, err), writer, request)
+			return
+		}
+
+		username := request.URL.Query().Get(usernameQueryParam)
+		if username == "" {
+			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoUsernameQueryPresented), writer, request)
+			return
+		}
+
+		var dto dto2.RatingScoresDto
+		if err = json.NewDecoder(request.Body).Decode(&dto); err != nil {
+			c.errHandler.Handler(model.NewUnprocessableEntityError(op, err), writer, request)
+			return
+		}
+
+		if err = c.validator.Struct(dto); err != nil {
+			c.errHandler.Handler(model.NewBadRequestError(op, err), writer, request)
+			return
+		}
+
+		saved, err := c.contractService.RateContract(request.Context(), contractId, username, dto)
+		if err != nil {
+			c.errHandler.Handler(err, writer, request)
+			return
+		}
+
+		if err = json.NewEncoder(writer).Encode(saved); err != nil {
+			c.errHandler.Handler(model.NewInternalServerError(op, err), writer, request)
+			return
+		}
+	}
+}
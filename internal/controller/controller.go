@@ -9,12 +9,20 @@ type PingController interface {
 	GetPing(ctx context.Context) http.HandlerFunc
 }
 
+type HealthController interface {
+	GetHealthz(ctx context.Context) http.HandlerFunc
+	GetReadyz(ctx context.Context) http.HandlerFunc
+}
+
 type TenderController interface {
 	GetTenders(ctx context.Context) http.HandlerFunc
+	SearchTenders(ctx context.Context) http.HandlerFunc
 	PostNewTender(ctx context.Context) http.HandlerFunc
 	GetUserTenders(ctx context.Context) http.HandlerFunc
 	GetTenderStatus(ctx context.Context) http.HandlerFunc
 	PutTenderStatus(ctx context.Context) http.HandlerFunc
+	PutTenderCancel(ctx context.Context) http.HandlerFunc
+	GetTenderAward(ctx context.Context) http.HandlerFunc
 	PatchTender(ctx context.Context) http.HandlerFunc
 	PutTenderRollback(ctx context.Context) http.HandlerFunc
 }
@@ -27,7 +35,39 @@ type BidController interface {
 	PutBidStatus(ctx context.Context) http.HandlerFunc
 	PatchBid(ctx context.Context) http.HandlerFunc
 	PutBidSubmitDecision(ctx context.Context) http.HandlerFunc
+	DeleteBidSubmitDecision(ctx context.Context) http.HandlerFunc
+	GetBidDecisions(ctx context.Context) http.HandlerFunc
 	PutBidFeedback(ctx context.Context) http.HandlerFunc
 	PutBidRollback(ctx context.Context) http.HandlerFunc
 	GetBidReviews(ctx context.Context) http.HandlerFunc
+	PutBidWithdraw(ctx context.Context) http.HandlerFunc
+}
+
+type SavedSearchController interface {
+	PostNewSavedSearch(ctx context.Context) http.HandlerFunc
+	GetUserSavedSearches(ctx context.Context) http.HandlerFunc
+	DeleteSavedSearch(ctx context.Context) http.HandlerFunc
+}
+
+type ContractController interface {
+	PostNewContract(ctx context.Context) http.HandlerFunc
+	GetUserContracts(ctx context.Context) http.HandlerFunc
+	GetContract(ctx context.Context) http.HandlerFunc
+	PostContractMilestone(ctx context.Context) http.HandlerFunc
+	PutContractMilestoneComplete(ctx context.Context) http.HandlerFunc
+	PostContractRating(ctx context.Context) http.HandlerFunc
+}
+
+type RatingController interface {
+	GetEmployeeReputation(ctx context.Context) http.HandlerFunc
+	GetEmployeeRatingHistory(ctx context.Context) http.HandlerFunc
+	GetOrganizationReputation(ctx context.Context) http.HandlerFunc
+}
+
+type CategoryController interface {
+	GetCategories(ctx context.Context) http.HandlerFunc
+	GetCategory(ctx context.Context) http.HandlerFunc
+	PostNewCategory(ctx context.Context) http.HandlerFunc
+	PatchCategory(ctx context.Context) http.HandlerFunc
+	DeleteCategory(ctx context.Context) http.HandlerFunc
 }
@@ -0,0 +1,15 @@
+package health
+
+import (
+	"tender-service/internal/health"
+)
+
+type controller struct {
+	health *health.Health
+}
+
+func NewHealthController(health *health.Health) *controller {
+	return &controller{
+		health: health,
+	}
+}
@@ -0,0 +1,15 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+func (c *controller) GetHealthz(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+
+		_ = json.NewEncoder(writer).Encode(c.health.Live())
+	}
+}
@@ -0,0 +1,20 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+func (c *controller) GetReadyz(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+
+		result, ready := c.health.Ready(request.Context())
+		if !ready {
+			writer.WriteHeader(http.StatusServiceUnavailable)
+		}
+
+		_ = json.NewEncoder(writer).Encode(result)
+	}
+}
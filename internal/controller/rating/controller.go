@@ -0,0 +1,60 @@
+package rating
+
+import (
+	"github.com/google/uuid"
+	"net/http"
+	"tender-service/internal/httperr"
+	"tender-service/internal/i18n"
+	"tender-service/internal/service"
+	"tender-service/internal/util"
+)
+
+type controller struct {
+	ratingService service.RatingService
+	errHandler    httperr.ApiErrorHandler
+	pageLimits    util.PageLimits
+}
+
+const (
+	usernameQueryParam      = "username"
+	employeeIdPathValue     = "employeeId"
+	organizationIdPathValue = "organizationId"
+)
+
+var (
+	errEmployeePathValueNotFound     = i18n.NewError(i18n.RequestPathValueMissing, "employeeId")
+	errOrganizationPathValueNotFound = i18n.NewError(i18n.RequestPathValueMissing, "organizationId")
+	errNoUsernameQueryPresented      = i18n.NewError(i18n.RequestQueryParamMissing, "username")
+)
+
+func NewRatingController(ratingService service.RatingService, errHandler httperr.ApiErrorHandler, pageLimits util.PageLimits) *controller {
+	return &controller{
+		ratingService: ratingService,
+		errHandler:    errHandler,
+		pageLimits:    pageLimits,
+	}
+}
+
+func getEmployeeIdFromRequest(request *http.Request) (uuid.UUID, error) {
+	employeeId := request.PathValue(employeeIdPathValue)
+	if employeeId == "" {
+		return uuid.Nil, errEmployeePathValueNotFound
+	}
+	employeeUuid, err := uuid.Parse(employeeId)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return employeeUuid, nil
+}
+
+func getOrganizationIdFromRequest(request *http.Request) (uuid.UUID, error) {
+	organizationId := request.PathValue(organizationIdPathValue)
+	if organizationId == "" {
+		return uuid.Nil, errOrganizationPathValueNotFound
+	}
+	organizationUuid, err := uuid.Parse(organizationId)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return organizationUuid, nil
+}
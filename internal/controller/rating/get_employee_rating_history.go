@@ -0,0 +1,47 @@
+package rating
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"tender-service/internal/model"
+	"tender-service/internal/util"
+)
+
+func (c *controller) GetEmployeeRatingHistory(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		op := "rating_controller/get_employee_rating_history"
+		writer.Header().Set("Content-Type", "application/json")
+
+		p, err := util.NewPageFromRequest(request, c.pageLimits, nil)
+		if err != nil {
+			c.errHandler.Handler(model.NewBadRequestError(op, err), writer, request)
+			return
+		}
+
+		employeeId, err := getEmployeeIdFromRequest(request)
+		if err != nil {
+			c.errHandler.Handler(model.NewNotFoundError(op, err), writer, request)
+			return
+		}
+
+		username := request.URL.Query().Get(usernameQueryParam)
+		if username == "" {
+			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoUsernameQueryPresented), writer, request)
+			return
+		}
+
+		ratings, info, err := c.ratingService.GetEmployeeRatingHistory(request.Context(), p, employeeId, username)
+		if err != nil {
+			c.errHandler.Handler(err, writer, request)
+			return
+		}
+
+		util.WritePageInfo(writer, info)
+
+		if err = json.NewEncoder(writer).Encode(ratings); err != nil {
+			c.errHandler.Handler(model.NewInternalServerError(op, err), writer, request)
+			return
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package savedsearch
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"net/http"
+	"tender-service/internal/httperr"
+	"tender-service/internal/i18n"
+	"tender-service/internal/service"
+	"tender-service/internal/validation"
+)
+
+type controller struct {
+	savedSearchService service.SavedSearchService
+	errHandler         httperr.ApiErrorHandler
+	validator          *validator.Validate
+}
+
+const (
+	usernameQueryParam = "username"
+	searchIdPathValue  = "searchId"
+)
+
+var (
+	errSearchPathValueNotFound  = i18n.NewError(i18n.RequestPathValueMissing, "searchId")
+	errNoUsernameQueryPresented = i18n.NewError(i18n.RequestQueryParamMissing, "username")
+)
+
+func NewSavedSearchController(savedSearchService service.SavedSearchService, errHandler httperr.ApiErrorHandler) *controller {
+	return &controller{
+		savedSearchService: savedSearchService,
+		errHandler:         errHandler,
+		validator:          validation.New(),
+	}
+}
+
+func getSearchIdFromRequest(request *http.Request) (uuid.UUID, error) {
+	searchId := request.PathValue(searchIdPathValue)
+	if searchId == "" {
+		return uuid.Nil, errSearchPathValueNotFound
+	}
+	searchUuid, err := uuid.Parse(searchId)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return searchUuid, nil
+}
@@ -0,0 +1,33 @@
+package savedsearch
+
+import (
+	"context"
+	"net/http"
+	"tender-service/internal/model"
+)
+
+func (c *controller) DeleteSavedSearch(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		op := "saved_search_controller/delete_saved_search"
+		writer.Header().Set("Content-Type", "application/json")
+
+		searchId, err := getSearchIdFromRequest(request)
+		if err != nil {
+			c.errHandler.Handler(model.NewNotFoundError(op, err), writer, request)
+			return
+		}
+
+		username := request.URL.Query().Get(usernameQueryParam)
+		if username == "" {
+			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoUsernameQueryPresented), writer, request)
+			return
+		}
+
+		if err = c.savedSearchService.DeleteSavedSearch(request.Context(), searchId, username); err != nil {
+			c.errHandler.Handler(err, writer, request)
+			return
+		}
+
+		writer.WriteHeader(http.StatusNoContent)
+	}
+}
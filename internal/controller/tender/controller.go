@@ -1,40 +1,61 @@
 package tender
 
 import (
-	"fmt"
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	"net/http"
+	"strconv"
 	"tender-service/internal/httperr"
+	"tender-service/internal/i18n"
 	"tender-service/internal/service"
+	"tender-service/internal/util"
+	"tender-service/internal/validation"
+	"time"
 )
 
 type controller struct {
 	errHandler    httperr.ApiErrorHandler
 	tenderService service.TenderService
 	validator     *validator.Validate
+	pageLimits    util.PageLimits
 }
 
 const (
 	usernameQueryParam    = "username"
 	tenderIdPathValue     = "tenderId"
 	serviceTypeQueryParam = "service_type"
+	categoryIdQueryParam  = "category_id"
 	versionPathValue      = "version"
 	statusQueryParam      = "status"
+	outcomeQueryParam     = "outcome"
+	searchQueryParam      = "q"
+	organizationIdParam   = "organization_id"
+	createdFromQueryParam = "created_from"
+	createdToQueryParam   = "created_to"
+	budgetFromQueryParam  = "budget_from"
+	budgetToQueryParam    = "budget_to"
+	dateLayout            = "2006-01-02"
 )
 
 var (
-	errTenderPathValueNotFound  = fmt.Errorf("path value tenderId is not presented")
-	errNoUsernameQueryPresented = fmt.Errorf("request param username is not presented")
-	errIncorrectServiceType     = fmt.Errorf("provided incorrect service type")
-	errIncorrectTenderStatus    = fmt.Errorf("incorrect tender status")
+	errTenderPathValueNotFound     = i18n.NewError(i18n.RequestPathValueMissing, "tenderId")
+	errNoUsernameQueryPresented    = i18n.NewError(i18n.RequestQueryParamMissing, "username")
+	errIncorrectServiceType        = i18n.NewError(i18n.FilterIncorrectServiceType)
+	errIncorrectCategoryId         = i18n.NewError(i18n.FilterIncorrectCategoryId)
+	errIncorrectTenderStatus       = i18n.NewError(i18n.FilterIncorrectTenderStatus)
+	errIncorrectTenderOutcome      = i18n.NewError(i18n.FilterIncorrectTenderOutcome)
+	errIncorrectOrganizationId     = i18n.NewError(i18n.FilterIncorrectOrganizationId)
+	errIncorrectDate               = i18n.NewError(i18n.FilterIncorrectDate)
+	errIncorrectBudget             = i18n.NewError(i18n.FilterIncorrectBudget)
+	errCursorNotSupportedForSearch = i18n.NewError(i18n.FilterCursorNotSupportedSearch)
 )
 
-func NewTenderController(tenderService service.TenderService, errHandler httperr.ApiErrorHandler) *controller {
+func NewTenderController(tenderService service.TenderService, errHandler httperr.ApiErrorHandler, pageLimits util.PageLimits) *controller {
 	return &controller{
 		tenderService: tenderService,
 		errHandler:    errHandler,
-		validator:     validator.New(validator.WithRequiredStructEnabled()),
+		pageLimits:    pageLimits,
+		validator:     validation.New(),
 	}
 }
 
@@ -49,3 +70,30 @@ func getTenderIdFromRequest(request *http.Request) (uuid.UUID, error) {
 	}
 	return tenderUuid, nil
 }
+
+func parseDateQueryParam(request *http.Request, name string) (time.Time, error) {
+	value := request.URL.Query().Get(name)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed, nil
+	}
+	parsed, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return time.Time{}, errIncorrectDate
+	}
+	return parsed, nil
+}
+
+func parseBudgetQueryParam(request *http.Request, name string) (*float64, error) {
+	value := request.URL.Query().Get(name)
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil || parsed < 0 {
+		return nil, errIncorrectBudget
+	}
+	return &parsed, nil
+}
@@ -3,6 +3,7 @@ package tender
 import (
 	"context"
 	"encoding/json"
+	"github.com/google/uuid"
 	"net/http"
 	"strings"
 	"tender-service/internal/model"
@@ -15,7 +16,11 @@ func (c *controller) GetTenders(ctx context.Context) http.HandlerFunc {
 		op := "tender_controller/get_tenders"
 		writer.Header().Set("Content-Type", "application/json")
 
-		p := util.NewPageFromRequest(request)
+		p, err := util.NewPageFromRequest(request, c.pageLimits, tender.SortFields)
+		if err != nil {
+			c.errHandler.Handler(model.NewBadRequestError(op, err), writer, request)
+			return
+		}
 
 		rowServiceTypesString := request.URL.Query().Get(serviceTypeQueryParam)
 		var serviceTypes []tender.ServiceType
@@ -25,21 +30,39 @@ func (c *controller) GetTenders(ctx context.Context) http.HandlerFunc {
 			serviceTypes = make([]tender.ServiceType, len(rowServiceTypes))
 			for i := 0; i < len(rowServiceTypes); i++ {
 				if !tender.IsServiceType(rowServiceTypes[i]) {
-					c.errHandler.Handler(model.NewBadRequestError(op, errIncorrectServiceType), writer)
+					c.errHandler.Handler(model.NewBadRequestError(op, errIncorrectServiceType), writer, request)
 					return
 				}
 				serviceTypes[i] = tender.ServiceType(rowServiceTypes[i])
 			}
 		}
 
-		tenders, err := c.tenderService.GetTenders(ctx, p, serviceTypes)
+		var categoryIds []uuid.UUID
+
+		if rowCategoryIdsString := request.URL.Query().Get(categoryIdQueryParam); rowCategoryIdsString != "" {
+			rowCategoryIds := strings.Split(rowCategoryIdsString, ",")
+			categoryIds = make([]uuid.UUID, len(rowCategoryIds))
+			for i := 0; i < len(rowCategoryIds); i++ {
+				categoryIds[i], err = uuid.Parse(rowCategoryIds[i])
+				if err != nil {
+					c.errHandler.Handler(model.NewBadRequestError(op, errIncorrectCategoryId), writer, request)
+					return
+				}
+			}
+		}
+
+		filter := tender.ListFilter{ServiceTypes: serviceTypes, CategoryIds: categoryIds}
+
+		tenders, info, err := c.tenderService.GetTenders(request.Context(), p, filter)
 		if err != nil {
-			c.errHandler.Handler(err, writer)
+			c.errHandler.Handler(err, writer, request)
 			return
 		}
 
+		util.WritePageInfo(writer, info)
+
 		if err = json.NewEncoder(writer).Encode(tenders); err != nil {
-			c.errHandler.Handler(model.NewInternalServerError(op, err), writer)
+			c.errHandler.Handler(model.NewInternalServerError(op, err), writer, request)
 			return
 		}
 	}
@@ -0,0 +1,82 @@
+package tender
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	"net/http"
+	"strings"
+	"tender-service/internal/model"
+	"tender-service/internal/model/entity/tender"
+	"tender-service/internal/util"
+)
+
+func (c *controller) SearchTenders(ctx context.Context) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		op := "tender_controller/search_tenders"
+		writer.Header().Set("Content-Type", "application/json")
+
+		p, err := util.NewPageFromRequest(request, c.pageLimits, nil)
+		if err != nil {
+			c.errHandler.Handler(model.NewBadRequestError(op, err), writer, request)
+			return
+		}
+		if p.Cursor != nil {
+			c.errHandler.Handler(model.NewBadRequestError(op, errCursorNotSupportedForSearch), writer, request)
+			return
+		}
+
+		filter := tender.SearchFilter{
+			Query: strings.TrimSpace(request.URL.Query().Get(searchQueryParam)),
+		}
+
+		if rawOrganizationId := request.URL.Query().Get(organizationIdParam); rawOrganizationId != "" {
+			organizationId, err := uuid.Parse(rawOrganizationId)
+			if err != nil {
+				c.errHandler.Handler(model.NewBadRequestError(op, errIncorrectOrganizationId), writer, request)
+				return
+			}
+			filter.OrganizationId = organizationId
+		}
+
+		if rawStatuses := request.URL.Query().Get(statusQueryParam); rawStatuses != "" {
+			for _, status := range strings.Split(rawStatuses, ",") {
+				if !tender.IsTenderStatus(status) {
+					c.errHandler.Handler(model.NewBadRequestError(op, errIncorrectTenderStatus), writer, request)
+					return
+				}
+				filter.Statuses = append(filter.Statuses, tender.Status(status))
+			}
+		}
+
+		if filter.CreatedFrom, err = parseDateQueryParam(request, createdFromQueryParam); err != nil {
+			c.errHandler.Handler(model.NewBadRequestError(op, err), writer, request)
+			return
+		}
+		if filter.CreatedTo, err = parseDateQueryParam(request, createdToQueryParam); err != nil {
+			c.errHandler.Handler(model.NewBadRequestError(op, err), writer, request)
+			return
+		}
+		if filter.BudgetFrom, err = parseBudgetQueryParam(request, budgetFromQueryParam); err != nil {
+			c.errHandler.Handler(model.NewBadRequestError(op, err), writer, request)
+			return
+		}
+		if filter.BudgetTo, err = parseBudgetQueryParam(request, budgetToQueryParam); err != nil {
+			c.errHandler.Handler(model.NewBadRequestError(op, err), writer, request)
+			return
+		}
+
+		username := request.URL.Query().Get(usernameQueryParam)
+
+		results, err := c.tenderService.SearchTenders(request.Context(), p, filter, username)
+		if err != nil {
+			c.errHandler.Handler(err, writer, request)
+			return
+		}
+
+		if err = json.NewEncoder(writer).Encode(results); err != nil {
+			c.errHandler.Handler(model.NewInternalServerError(op, err), writer, request)
+			return
+		}
+	}
+}
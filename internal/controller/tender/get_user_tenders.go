@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strings"
 	"tender-service/internal/model"
+	"tender-service/internal/model/entity/tender"
 	"tender-service/internal/util"
 )
 
@@ -13,22 +15,42 @@ func (c *controller) GetUserTenders(ctx context.Context) http.HandlerFunc {
 		op := "tender_controller/get_user_tenders"
 		writer.Header().Set("Content-Type", "application/json")
 
-		p := util.NewPageFromRequest(request)
+		p, err := util.NewPageFromRequest(request, c.pageLimits, tender.SortFields)
+		if err != nil {
+			c.errHandler.Handler(model.NewBadRequestError(op, err), writer, request)
+			return
+		}
 
 		username := request.URL.Query().Get(usernameQueryParam)
 		if username == "" {
-			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoUsernameQueryPresented), writer)
+			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoUsernameQueryPresented), writer, request)
 			return
 		}
 
-		tenders, err := c.tenderService.GetUserTenders(ctx, p, username)
+		var outcomes []tender.Outcome
+
+		if rowOutcomesString := request.URL.Query().Get(outcomeQueryParam); rowOutcomesString != "" {
+			rowOutcomes := strings.Split(rowOutcomesString, ",")
+			outcomes = make([]tender.Outcome, len(rowOutcomes))
+			for i := 0; i < len(rowOutcomes); i++ {
+				if !tender.IsOutcome(rowOutcomes[i]) {
+					c.errHandler.Handler(model.NewBadRequestError(op, errIncorrectTenderOutcome), writer, request)
+					return
+				}
+				outcomes[i] = tender.Outcome(rowOutcomes[i])
+			}
+		}
+
+		tenders, info, err := c.tenderService.GetUserTenders(request.Context(), p, username, outcomes)
 		if err != nil {
-			c.errHandler.Handler(err, writer)
+			c.errHandler.Handler(err, writer, request)
 			return
 		}
 
+		util.WritePageInfo(writer, info)
+
 		if err = json.NewEncoder(writer).Encode(tenders); err != nil {
-			c.errHandler.Handler(model.NewInternalServerError(op, err), writer)
+			c.errHandler.Handler(model.NewInternalServerError(op, err), writer, request)
 			return
 		}
 	}
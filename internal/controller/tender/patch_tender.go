@@ -15,30 +15,35 @@ func (c *controller) PatchTender(ctx context.Context) http.HandlerFunc {
 
 		tenderId, err := getTenderIdFromRequest(request)
 		if err != nil {
-			c.errHandler.Handler(model.NewNotFoundError(op, err), writer)
+			c.errHandler.Handler(model.NewNotFoundError(op, err), writer, request)
 			return
 		}
 
 		username := request.URL.Query().Get(usernameQueryParam)
 		if username == "" {
-			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoUsernameQueryPresented), writer)
+			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoUsernameQueryPresented), writer, request)
 			return
 		}
 
 		var dto dto2.UpdateTenderDto
 		if err := json.NewDecoder(request.Body).Decode(&dto); err != nil {
-			c.errHandler.Handler(model.NewUnprocessableEntityError(op, err), writer)
+			c.errHandler.Handler(model.NewUnprocessableEntityError(op, err), writer, request)
 			return
 		}
 
-		updated, err := c.tenderService.EditTender(ctx, dto, tenderId, username)
+		if err := c.validator.Struct(dto); err != nil {
+			c.errHandler.Handler(model.NewBadRequestError(op, err), writer, request)
+			return
+		}
+
+		updated, err := c.tenderService.EditTender(request.Context(), dto, tenderId, username)
 		if err != nil {
-			c.errHandler.Handler(err, writer)
+			c.errHandler.Handler(err, writer, request)
 			return
 		}
 
 		if err = json.NewEncoder(writer).Encode(updated); err != nil {
-			c.errHandler.Handler(model.NewInternalServerError(op, err), writer)
+			c.errHandler.Handler(model.NewInternalServerError(op, err), writer, request)
 			return
 		}
 	}
@@ -15,23 +15,23 @@ func (c *controller) PostNewTender(ctx context.Context) http.HandlerFunc {
 
 		var dto dto2.CreateTenderDto
 		if err := json.NewDecoder(request.Body).Decode(&dto); err != nil {
-			c.errHandler.Handler(model.NewUnprocessableEntityError(op, err), writer)
+			c.errHandler.Handler(model.NewUnprocessableEntityError(op, err), writer, request)
 			return
 		}
 
 		if err := c.validator.Struct(dto); err != nil {
-			c.errHandler.Handler(model.NewBadRequestError(op, err), writer)
+			c.errHandler.Handler(model.NewBadRequestError(op, err), writer, request)
 			return
 		}
 
-		saved, err := c.tenderService.CreateNewTender(ctx, dto)
+		saved, err := c.tenderService.CreateNewTender(request.Context(), dto)
 		if err != nil {
-			c.errHandler.Handler(err, writer)
+			c.errHandler.Handler(err, writer, request)
 			return
 		}
 
 		if err = json.NewEncoder(writer).Encode(saved); err != nil {
-			c.errHandler.Handler(model.NewInternalServerError(op, err), writer)
+			c.errHandler.Handler(model.NewInternalServerError(op, err), writer, request)
 			return
 		}
 	}
@@ -15,30 +15,30 @@ func (c *controller) PutTenderStatus(ctx context.Context) http.HandlerFunc {
 
 		tenderId, err := getTenderIdFromRequest(request)
 		if err != nil {
-			c.errHandler.Handler(model.NewNotFoundError(op, err), writer)
+			c.errHandler.Handler(model.NewNotFoundError(op, err), writer, request)
 			return
 		}
 
 		username := request.URL.Query().Get(usernameQueryParam)
 		if username == "" {
-			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoUsernameQueryPresented), writer)
+			c.errHandler.Handler(model.NewNotAuthorizedError(op, errNoUsernameQueryPresented), writer, request)
 			return
 		}
 
 		status := request.URL.Query().Get(statusQueryParam)
 		if ok := tender.IsTenderStatus(status); !ok {
-			c.errHandler.Handler(model.NewBadRequestError(op, errIncorrectTenderStatus), writer)
+			c.errHandler.Handler(model.NewBadRequestError(op, errIncorrectTenderStatus), writer, request)
 			return
 		}
 
-		updated, err := c.tenderService.UpdateTenderStatus(ctx, tenderId, username, tender.Status(status))
+		updated, err := c.tenderService.UpdateTenderStatus(request.Context(), tenderId, username, tender.Status(status))
 		if err != nil {
-			c.errHandler.Handler(err, writer)
+			c.errHandler.Handler(err, writer, request)
 			return
 		}
 
 		if err = json.NewEncoder(writer).Encode(updated); err != nil {
-			c.errHandler.Handler(model.NewInternalServerError(op, err), writer)
+			c.errHandler.Handler(model.NewInternalServerError(op, err), writer, request)
 			return
 		}
 	}
@@ -0,0 +1,82 @@
+package events
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"tender-service/internal/model/dto"
+	"time"
+)
+
+const fallbackBuffer = 64
+
+type TenderEventType string
+
+const (
+	TenderCreated       TenderEventType = "created"
+	TenderEdited        TenderEventType = "edited"
+	TenderStatusChanged TenderEventType = "status_changed"
+	TenderRolledBack    TenderEventType = "rolled_back"
+)
+
+type TenderEvent struct {
+	Type       TenderEventType
+	Tender     dto.TenderDto
+	OccurredAt time.Time
+}
+
+// TenderBroker fans tender events out to subscribers of this instance, slow subscribers lose events instead of blocking
+type TenderBroker struct {
+	mu          sync.RWMutex
+	subscribers map[chan TenderEvent]func(TenderEvent) bool
+	buffer      int
+}
+
+func NewTenderBroker(buffer int) *TenderBroker {
+	if buffer <= 0 {
+		buffer = fallbackBuffer
+	}
+	return &TenderBroker{
+		subscribers: make(map[chan TenderEvent]func(TenderEvent) bool),
+		buffer:      buffer,
+	}
+}
+
+func (b *TenderBroker) Publish(ctx context.Context, eventType TenderEventType, tender dto.TenderDto) {
+	event := TenderEvent{Type: eventType, Tender: tender, OccurredAt: time.Now()}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for ch, filter := range b.subscribers {
+		if !filter(event) {
+			continue
+		}
+		select {
+		case ch <- event:
+		default:
+			slog.WarnContext(ctx, "tender event dropped for slow subscriber", slog.String("tender_id", tender.Id.String()))
+		}
+	}
+}
+
+// Subscribe returns events accepted by filter, the channel is closed when ctx is done
+func (b *TenderBroker) Subscribe(ctx context.Context, filter func(TenderEvent) bool) <-chan TenderEvent {
+	ch := make(chan TenderEvent, b.buffer)
+
+	b.mu.Lock()
+	b.subscribers[ch] = filter
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		b.mu.Lock()
+		delete(b.subscribers, ch)
+		b.mu.Unlock()
+
+		close(ch)
+	}()
+
+	return ch
+}
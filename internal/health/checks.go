@@ -0,0 +1,54 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pressly/goose/v3"
+)
+
+const (
+	PostgresComponent   = "postgres"
+	MigrationsComponent = "migrations"
+	WorkersComponent    = "workers"
+)
+
+const selectMigrationVersion = "SELECT COALESCE(MAX(version_id), 0) FROM goose_db_version WHERE is_applied"
+
+func NewPostgresCheck(pool *pgxpool.Pool) Check {
+	return func(ctx context.Context) error {
+		return pool.Ping(ctx)
+	}
+}
+
+func NewMigrationsCheck(pool *pgxpool.Pool, migrationsDir string) (Check, error) {
+	migrations, err := goose.CollectMigrations(migrationsDir, 0, goose.MaxVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	var expected int64
+	if len(migrations) > 0 {
+		expected = migrations[len(migrations)-1].Version
+	}
+
+	return func(ctx context.Context) error {
+		var current int64
+		if err := pool.QueryRow(ctx, selectMigrationVersion).Scan(&current); err != nil {
+			return err
+		}
+		if current != expected {
+			return fmt.Errorf("database is at migration version %d, expected %d", current, expected)
+		}
+		return nil
+	}, nil
+}
+
+func NewWorkersCheck(expected int, running func() int) Check {
+	return func(_ context.Context) error {
+		if count := running(); count != expected {
+			return fmt.Errorf("%d of %d workers are running", count, expected)
+		}
+		return nil
+	}
+}
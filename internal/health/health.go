@@ -0,0 +1,106 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"tender-service/internal/model/dto"
+	"time"
+)
+
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+
+	ShutdownComponent = "shutdown"
+
+	checkTimeout = 2 * time.Second
+)
+
+var errShuttingDown = fmt.Errorf("service is shutting down")
+
+type Check func(ctx context.Context) error
+
+type Health struct {
+	mu       sync.RWMutex
+	checks   map[string]Check
+	draining atomic.Bool
+}
+
+func NewHealth() *Health {
+	return &Health{
+		checks: make(map[string]Check),
+	}
+}
+
+func (h *Health) Register(name string, check Check) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.checks[name] = check
+}
+
+func (h *Health) Drain() {
+	h.draining.Store(true)
+}
+
+func (h *Health) Live() dto.HealthDto {
+	return dto.HealthDto{Status: StatusUp}
+}
+
+func (h *Health) Ready(ctx context.Context) (dto.HealthDto, bool) {
+	h.mu.RLock()
+	checks := make(map[string]Check, len(h.checks))
+	for name, check := range h.checks {
+		checks[name] = check
+	}
+	h.mu.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	var (
+		mu         sync.Mutex
+		wg         sync.WaitGroup
+		components = make(map[string]dto.ComponentHealthDto, len(checks)+1)
+	)
+
+	for name, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			component := componentHealth(check(ctx))
+
+			mu.Lock()
+			components[name] = component
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if h.draining.Load() {
+		components[ShutdownComponent] = componentHealth(errShuttingDown)
+	}
+
+	ready := true
+	for _, component := range components {
+		if component.Status != StatusUp {
+			ready = false
+		}
+	}
+
+	status := StatusUp
+	if !ready {
+		status = StatusDown
+	}
+
+	return dto.HealthDto{Status: status, Components: components}, ready
+}
+
+func componentHealth(err error) dto.ComponentHealthDto {
+	if err != nil {
+		return dto.ComponentHealthDto{Status: StatusDown, Error: err.Error()}
+	}
+	return dto.ComponentHealthDto{Status: StatusUp}
+}
@@ -1,5 +1,17 @@
 package httperr
 
 type ErrorDto struct {
-	Reason string `json:"reason"`
+	Type      string          `json:"type"`
+	Title     string          `json:"title"`
+	Status    int             `json:"status"`
+	Detail    string          `json:"detail,omitempty"`
+	Instance  string          `json:"instance,omitempty"`
+	RequestId string          `json:"requestId,omitempty"`
+	Errors    []FieldErrorDto `json:"errors,omitempty"`
+}
+
+type FieldErrorDto struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
 }
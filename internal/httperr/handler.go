@@ -2,37 +2,39 @@ package httperr
 
 import (
 	"encoding/json"
+	"log/slog"
 	"net/http"
-	"tender-service/internal/model"
+	"strings"
+	"tender-service/internal/i18n"
+	"tender-service/internal/reqinfo"
 )
 
 type ApiErrorHandler interface {
-	Handler(err error, w http.ResponseWriter)
+	Handler(err error, w http.ResponseWriter, r *http.Request)
 }
 
 type handler struct {
-	messageCodes map[model.ApiErrorCode]int
 }
 
 func NewApiErrorHandler() *handler {
-	return &handler{messageCodes: map[model.ApiErrorCode]int{
-		model.NotAuthorizedCode:       401,
-		model.InternalServerErrorCode: 500,
-		model.ForbiddenCode:           403,
-		model.BadRequestCode:          400,
-		model.NotFoundCode:            404,
-		model.UnprocessableEntityCode: 422,
-	}}
+	return &handler{}
 }
 
-func (h *handler) Handler(err error, w http.ResponseWriter) {
-	status := http.StatusInternalServerError
+func (h *handler) Handler(err error, w http.ResponseWriter, r *http.Request) {
+	lang := i18n.FromAcceptLanguage(r.Header.Get(i18n.AcceptLanguageHeader))
 
-	apiErr, ok := err.(model.ApiError)
-	if ok {
-		status = h.messageCodes[apiErr.Code]
+	problem, unexpected := NewProblem(err, lang)
+	if unexpected {
+		slog.ErrorContext(r.Context(), "request failed", slog.Any("error", err))
 	}
 
-	w.WriteHeader(status)
-	json.NewEncoder(w).Encode(ErrorDto{Reason: err.Error()})
+	problem.Instance, _, _ = strings.Cut(r.RequestURI, "?")
+	if info := reqinfo.FromContext(r.Context()); info != nil {
+		problem.RequestId = info.Id
+	}
+
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.Header().Set(i18n.ContentLanguageHeader, string(lang))
+	w.WriteHeader(problem.Status)
+	json.NewEncoder(w).Encode(problem)
 }
@@ -0,0 +1,265 @@
+package httperr
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/go-playground/validator/v10"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"io"
+	"net/http"
+	"strings"
+	"tender-service/internal/i18n"
+	"tender-service/internal/model"
+	"tender-service/internal/openapi"
+	"tender-service/internal/repository/pgerr"
+)
+
+const (
+	ProblemContentType = "application/problem+json"
+	problemTypePrefix  = "urn:tender-service:problem:"
+
+	TypeNotAuthorized    = problemTypePrefix + "not-authorized"
+	TypeForbidden        = problemTypePrefix + "forbidden"
+	TypeBadRequest       = problemTypePrefix + "bad-request"
+	TypeValidationFailed = problemTypePrefix + "validation-failed"
+	TypeNotFound         = problemTypePrefix + "not-found"
+	TypeConflict         = problemTypePrefix + "conflict"
+	TypeAlreadyExists    = problemTypePrefix + "already-exists"
+	TypeInvalidReference = problemTypePrefix + "invalid-reference"
+	TypeUnprocessable    = problemTypePrefix + "unprocessable-entity"
+	TypeTooManyRequests  = problemTypePrefix + "too-many-requests"
+	TypePayloadTooLarge  = problemTypePrefix + "payload-too-large"
+	TypeInternal         = problemTypePrefix + "internal"
+)
+
+type problemKind struct {
+	status      int
+	problemType string
+}
+
+var titles = map[int]i18n.Code{
+	http.StatusBadRequest:            i18n.TitleBadRequest,
+	http.StatusUnauthorized:          i18n.TitleNotAuthorized,
+	http.StatusForbidden:             i18n.TitleForbidden,
+	http.StatusNotFound:              i18n.TitleNotFound,
+	http.StatusConflict:              i18n.TitleConflict,
+	http.StatusUnprocessableEntity:   i18n.TitleUnprocessableEntity,
+	http.StatusTooManyRequests:       i18n.TitleTooManyRequests,
+	http.StatusRequestEntityTooLarge: i18n.TitlePayloadTooLarge,
+	http.StatusInternalServerError:   i18n.TitleInternal,
+}
+
+// details are used for errors without a code
+var details = map[int]i18n.Code{
+	http.StatusBadRequest:            i18n.CommonBadRequest,
+	http.StatusUnauthorized:          i18n.CommonNotAuthorized,
+	http.StatusForbidden:             i18n.CommonForbidden,
+	http.StatusNotFound:              i18n.CommonNotFound,
+	http.StatusConflict:              i18n.CommonConflict,
+	http.StatusUnprocessableEntity:   i18n.CommonUnprocessable,
+	http.StatusTooManyRequests:       i18n.CommonTooManyRequests,
+	http.StatusRequestEntityTooLarge: i18n.CommonPayloadTooLarge,
+}
+
+var apiErrorKinds = map[model.ApiErrorCode]problemKind{
+	model.NotAuthorizedCode:       {http.StatusUnauthorized, TypeNotAuthorized},
+	model.ForbiddenCode:           {http.StatusForbidden, TypeForbidden},
+	model.BadRequestCode:          {http.StatusBadRequest, TypeBadRequest},
+	model.NotFoundCode:            {http.StatusNotFound, TypeNotFound},
+	model.UnprocessableEntityCode: {http.StatusUnprocessableEntity, TypeUnprocessable},
+	model.TooManyRequestsCode:     {http.StatusTooManyRequests, TypeTooManyRequests},
+	model.ConflictCode:            {http.StatusConflict, TypeConflict},
+	model.PayloadTooLargeCode:     {http.StatusRequestEntityTooLarge, TypePayloadTooLarge},
+	model.InternalServerErrorCode: {http.StatusInternalServerError, TypeInternal},
+}
+
+// NewProblem maps err to a problem document, the second result tells whether err is unexpected and must be logged
+func NewProblem(err error, lang i18n.Language) (ErrorDto, bool) {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		if problem, ok := pgProblem(pgErr, lang); ok {
+			return problem, false
+		}
+		return internalProblem(lang), true
+	}
+
+	var apiErr model.ApiError
+	if errors.As(err, &apiErr) {
+		kind, ok := apiErrorKinds[apiErr.Code]
+		if !ok || kind.status == http.StatusInternalServerError {
+			return internalProblem(lang), true
+		}
+
+		var validationErrors validator.ValidationErrors
+		if errors.As(apiErr.Err, &validationErrors) {
+			return ErrorDto{
+				Type:   TypeValidationFailed,
+				Title:  title(kind.status, lang),
+				Status: kind.status,
+				Detail: i18n.Translate(lang, i18n.ValidationFailed),
+				Errors: fieldErrors(validationErrors, lang),
+			}, false
+		}
+
+		var openApiErr *openapi.ValidationError
+		if errors.As(apiErr.Err, &openApiErr) {
+			return ErrorDto{
+				Type:   TypeValidationFailed,
+				Title:  title(kind.status, lang),
+				Status: kind.status,
+				Detail: i18n.Translate(lang, i18n.ValidationFailed),
+				Errors: violationErrors(openApiErr.Violations, lang),
+			}, false
+		}
+
+		detail := i18n.Localize(lang, apiErr.Err, details[kind.status])
+		if errors.Is(apiErr.Err, pgx.ErrNoRows) {
+			detail = i18n.Translate(lang, i18n.CommonNotFound)
+		}
+		if isDecodeError(apiErr.Err) {
+			detail = i18n.Translate(lang, i18n.CommonMalformedBody)
+		}
+
+		return ErrorDto{
+			Type:   kind.problemType,
+			Title:  title(kind.status, lang),
+			Status: kind.status,
+			Detail: detail,
+		}, false
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrorDto{
+			Type:   TypeNotFound,
+			Title:  title(http.StatusNotFound, lang),
+			Status: http.StatusNotFound,
+			Detail: i18n.Translate(lang, i18n.CommonNotFound),
+		}, false
+	}
+
+	return internalProblem(lang), true
+}
+
+func isDecodeError(err error) bool {
+	var (
+		syntaxErr *json.SyntaxError
+		typeErr   *json.UnmarshalTypeError
+	)
+	return errors.As(err, &syntaxErr) || errors.As(err, &typeErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+func pgProblem(pgErr *pgconn.PgError, lang i18n.Language) (ErrorDto, bool) {
+	var (
+		status      int
+		problemType string
+		detailCode  i18n.Code
+	)
+
+	switch pgErr.Code {
+	case pgerr.UniqueViolation:
+		status, problemType, detailCode = http.StatusConflict, TypeAlreadyExists, i18n.CommonAlreadyExists
+	case pgerr.ForeignKeyViolation:
+		status, problemType, detailCode = http.StatusUnprocessableEntity, TypeInvalidReference, i18n.CommonInvalidReference
+	case pgerr.NotNullViolation, pgerr.CheckViolation, pgerr.InvalidTextRepr, pgerr.StringDataRightTrunc, pgerr.NumericValueOutOfRange:
+		status, problemType, detailCode = http.StatusBadRequest, TypeBadRequest, i18n.CommonInvalidValue
+	default:
+		return ErrorDto{}, false
+	}
+
+	detail := i18n.Translate(lang, detailCode)
+	problem := ErrorDto{
+		Type:   problemType,
+		Title:  title(status, lang),
+		Status: status,
+		Detail: detail,
+	}
+	if pgErr.ColumnName != "" {
+		problem.Errors = []FieldErrorDto{{Field: pgErr.ColumnName, Rule: pgErr.Code, Message: detail}}
+	}
+
+	return problem, true
+}
+
+func internalProblem(lang i18n.Language) ErrorDto {
+	return ErrorDto{
+		Type:   TypeInternal,
+		Title:  title(http.StatusInternalServerError, lang),
+		Status: http.StatusInternalServerError,
+		Detail: i18n.Translate(lang, i18n.CommonInternal),
+	}
+}
+
+func title(status int, lang i18n.Language) string {
+	code, ok := titles[status]
+	if !ok {
+		return http.StatusText(status)
+	}
+	return i18n.Translate(lang, code)
+}
+
+func fieldErrors(validationErrors validator.ValidationErrors, lang i18n.Language) []FieldErrorDto {
+	result := make([]FieldErrorDto, len(validationErrors))
+
+	for i, fieldErr := range validationErrors {
+		result[i] = FieldErrorDto{
+			Field:   fieldPath(fieldErr),
+			Rule:    fieldErr.Tag(),
+			Message: fieldMessage(fieldErr, lang),
+		}
+	}
+
+	return result
+}
+
+// fieldPath drops the struct name from the namespace, e.g. CreateBidDto.name becomes name
+func fieldPath(fieldErr validator.FieldError) string {
+	_, path, found := strings.Cut(fieldErr.Namespace(), ".")
+	if !found {
+		return fieldErr.Field()
+	}
+	return path
+}
+
+func violationErrors(violations []openapi.Violation, lang i18n.Language) []FieldErrorDto {
+	result := make([]FieldErrorDto, len(violations))
+
+	for i, violation := range violations {
+		result[i] = FieldErrorDto{
+			Field:   violation.Field,
+			Rule:    violation.Rule,
+			Message: ruleMessage(violation.Rule, violation.Param, lang),
+		}
+	}
+
+	return result
+}
+
+func fieldMessage(fieldErr validator.FieldError, lang i18n.Language) string {
+	return ruleMessage(fieldErr.Tag(), fieldErr.Param(), lang)
+}
+
+func ruleMessage(rule, param string, lang i18n.Language) string {
+	switch rule {
+	case "required":
+		return i18n.Translate(lang, i18n.ValidationRequired)
+	case "required_without":
+		return i18n.Translate(lang, i18n.ValidationRequiredWithout, param)
+	case "max":
+		return i18n.Translate(lang, i18n.ValidationMax, param)
+	case "min":
+		return i18n.Translate(lang, i18n.ValidationMin, param)
+	case "gte":
+		return i18n.Translate(lang, i18n.ValidationGte, param)
+	case "lte":
+		return i18n.Translate(lang, i18n.ValidationLte, param)
+	case "oneof":
+		return i18n.Translate(lang, i18n.ValidationOneOf, param)
+	case "type":
+		return i18n.Translate(lang, i18n.ValidationType, param)
+	case "format":
+		return i18n.Translate(lang, i18n.ValidationFormat, param)
+	default:
+		return i18n.Translate(lang, i18n.ValidationUnknownRule, rule)
+	}
+}
@@ -0,0 +1,142 @@
+package i18n
+
+// Code identifies a message in the catalog, codes are stable and must not be renamed
+type Code string
+
+const (
+	CommonNotFound         Code = "common.not_found"
+	CommonAlreadyExists    Code = "common.already_exists"
+	CommonInvalidReference Code = "common.invalid_reference"
+	CommonInvalidValue     Code = "common.invalid_value"
+	CommonInternal         Code = "common.internal"
+	CommonTooManyRequests  Code = "common.too_many_requests"
+	CommonBadRequest       Code = "common.bad_request"
+	CommonNotAuthorized    Code = "common.not_authorized"
+	CommonForbidden        Code = "common.forbidden"
+	CommonConflict         Code = "common.conflict"
+	CommonUnprocessable    Code = "common.unprocessable"
+	CommonMalformedBody    Code = "common.malformed_body"
+	CommonPayloadTooLarge  Code = "common.payload_too_large"
+
+	TitleBadRequest          Code = "title.bad_request"
+	TitleNotAuthorized       Code = "title.not_authorized"
+	TitleForbidden           Code = "title.forbidden"
+	TitleNotFound            Code = "title.not_found"
+	TitleConflict            Code = "title.conflict"
+	TitleUnprocessableEntity Code = "title.unprocessable_entity"
+	TitleTooManyRequests     Code = "title.too_many_requests"
+	TitlePayloadTooLarge     Code = "title.payload_too_large"
+	TitleInternal            Code = "title.internal"
+
+	ValidationFailed          Code = "validation.failed"
+	ValidationRequired        Code = "validation.required"
+	ValidationRequiredWithout Code = "validation.required_without"
+	ValidationMax             Code = "validation.max"
+	ValidationMin             Code = "validation.min"
+	ValidationGte             Code = "validation.gte"
+	ValidationLte             Code = "validation.lte"
+	ValidationOneOf           Code = "validation.oneof"
+	ValidationType            Code = "validation.type"
+	ValidationFormat          Code = "validation.format"
+	ValidationUnknownRule     Code = "validation.unknown_rule"
+
+	RequestPathValueMissing  Code = "request.path_value_missing"
+	RequestQueryParamMissing Code = "request.query_param_missing"
+	RequestFieldMissing      Code = "request.field_missing"
+	RequestIncorrectUuid     Code = "request.incorrect_uuid"
+
+	PageIncorrectOffset    Code = "page.incorrect_offset"
+	PageIncorrectLimit     Code = "page.incorrect_limit"
+	PageIncorrectCursor    Code = "page.incorrect_cursor"
+	PageIncorrectWithTotal Code = "page.incorrect_with_total"
+	PageCursorWithOffset   Code = "page.cursor_with_offset"
+
+	SortIncorrect      Code = "sort.incorrect"
+	SortNotSupported   Code = "sort.not_supported"
+	SortCursorMismatch Code = "sort.cursor_mismatch"
+	SortUnknownField   Code = "sort.unknown_field"
+
+	FilterIncorrectServiceType     Code = "filter.incorrect_service_type"
+	FilterIncorrectCategoryId      Code = "filter.incorrect_category_id"
+	FilterIncorrectTenderStatus    Code = "filter.incorrect_tender_status"
+	FilterIncorrectTenderOutcome   Code = "filter.incorrect_tender_outcome"
+	FilterIncorrectOrganizationId  Code = "filter.incorrect_organization_id"
+	FilterIncorrectDate            Code = "filter.incorrect_date"
+	FilterIncorrectBudget          Code = "filter.incorrect_budget"
+	FilterIncorrectBudgetRange     Code = "filter.incorrect_budget_range"
+	FilterCursorNotSupportedSearch Code = "filter.cursor_not_supported_for_search"
+
+	AdminApiDisabled    Code = "admin.api_disabled"
+	AdminTokenMissing   Code = "admin.token_missing"
+	AdminTokenIncorrect Code = "admin.token_incorrect"
+
+	IdempotencyKeyNotFound     Code = "idempotency.key_not_found"
+	IdempotencyKeyIncorrect    Code = "idempotency.key_incorrect"
+	IdempotencyKeyReused       Code = "idempotency.key_reused"
+	IdempotencyKeyInProgress   Code = "idempotency.key_in_progress"
+	EmployeeNotFound           Code = "employee.not_found"
+	OrganizationNotFound       Code = "organization.not_found"
+	OrganizationNotMember      Code = "organization.not_member"
+	OrganizationNotResponsible Code = "organization.not_responsible"
+	OrganizationAlreadyMember  Code = "organization.already_member"
+	OrganizationIncorrectType  Code = "organization.incorrect_type"
+
+	CategoryNotFound            Code = "category.not_found"
+	CategoryCodeTaken           Code = "category.code_taken"
+	CategoryUnknown             Code = "category.unknown"
+	CategoryParentNotFound      Code = "category.parent_not_found"
+	CategoryCycle               Code = "category.cycle"
+	CategoryHasChildren         Code = "category.has_children"
+	CategoryInUse               Code = "category.in_use"
+	CategoryServiceTypeMismatch Code = "category.service_type_mismatch"
+	CategoryLegacyNotRemoved    Code = "category.legacy_not_removed"
+
+	TenderNotFound               Code = "tender.not_found"
+	TenderVersionNotFound        Code = "tender.version_not_found"
+	TenderPrivateStatusesSearch  Code = "tender.private_statuses_search"
+	TenderBidDeadlineInPast      Code = "tender.bid_deadline_in_past"
+	TenderPublishWithoutDeadline Code = "tender.publish_without_deadline"
+	TenderPendingVotes           Code = "tender.pending_votes"
+	TenderTransitionNotAllowed   Code = "tender.transition_not_allowed"
+	TenderCancelWithoutReason    Code = "tender.cancel_without_reason"
+	TenderAwardNotFound          Code = "tender.award_not_found"
+	TenderReopenCancelled        Code = "tender.reopen_cancelled"
+	TenderReopenAwarded          Code = "tender.reopen_awarded"
+	SavedSearchNotFound          Code = "saved_search.not_found"
+	SavedSearchNotOwner          Code = "saved_search.not_owner"
+
+	BidStatusNotAllowedForAuthor Code = "bid.status_not_allowed_for_author"
+	BidCannotVote                Code = "bid.cannot_vote"
+	BidTenderClosed              Code = "bid.tender_closed"
+	BidCannotBidOnClosedTender   Code = "bid.closed_tender"
+	BidVersionNotFound           Code = "bid.version_not_found"
+	BidNotAuthor                 Code = "bid.not_author"
+	BidNotInAuthorOrganization   Code = "bid.not_in_author_organization"
+	BidNoReviews                 Code = "bid.no_reviews"
+	BidDecisionNotFound          Code = "bid.decision_not_found"
+	BidIncorrectDecision         Code = "bid.incorrect_decision"
+	BidDeadlinePassed            Code = "bid.deadline_passed"
+	BidWithdrawn                 Code = "bid.withdrawn"
+	BidHasVotes                  Code = "bid.has_votes"
+	BidHasDecision               Code = "bid.has_decision"
+	BidCannotWithdraw            Code = "bid.cannot_withdraw"
+	BidLateWithdrawalForbidden   Code = "bid.late_withdrawal_forbidden"
+	BidTenderNotOpen             Code = "bid.tender_not_open"
+	BidTransitionNotAllowed      Code = "bid.transition_not_allowed"
+	BidNotEditable               Code = "bid.not_editable"
+
+	ContractNotFound             Code = "contract.not_found"
+	ContractAlreadyExists        Code = "contract.already_exists"
+	ContractIncorrectTerm        Code = "contract.incorrect_term"
+	ContractNotParty             Code = "contract.not_party"
+	ContractNotCustomer          Code = "contract.not_customer"
+	ContractMilestoneNotFound    Code = "contract.milestone_not_found"
+	ContractMilestoneOutsideTerm Code = "contract.milestone_outside_term"
+	ContractNotCompleted         Code = "contract.not_completed"
+	ContractAlreadyRated         Code = "contract.already_rated"
+	ContractRatingNotCustomer    Code = "contract.rating_not_customer"
+
+	RatingIncomplete      Code = "rating.incomplete"
+	RatingIncorrectScore  Code = "rating.incorrect_score"
+	RatingBidAlreadyRated Code = "rating.bid_already_rated"
+)
@@ -0,0 +1,138 @@
+package i18n
+
+var en = map[Code]string{
+	CommonNotFound:         "requested entity not found",
+	CommonAlreadyExists:    "entity with given attributes already exists",
+	CommonInvalidReference: "referenced entity does not exist",
+	CommonInvalidValue:     "request contains invalid value",
+	CommonInternal:         "internal server error",
+	CommonTooManyRequests:  "too many requests, retry later",
+	CommonBadRequest:       "request is invalid",
+	CommonNotAuthorized:    "user is not authorized",
+	CommonForbidden:        "not enough rights to perform the action",
+	CommonConflict:         "request conflicts with the current state of the entity",
+	CommonUnprocessable:    "request cannot be processed",
+	CommonMalformedBody:    "request body is not valid json or does not match the expected structure",
+	CommonPayloadTooLarge:  "request body is too large",
+
+	TitleBadRequest:          "Bad Request",
+	TitleNotAuthorized:       "Unauthorized",
+	TitleForbidden:           "Forbidden",
+	TitleNotFound:            "Not Found",
+	TitleConflict:            "Conflict",
+	TitleUnprocessableEntity: "Unprocessable Entity",
+	TitleTooManyRequests:     "Too Many Requests",
+	TitlePayloadTooLarge:     "Payload Too Large",
+	TitleInternal:            "Internal Server Error",
+
+	ValidationFailed:          "request validation failed",
+	ValidationRequired:        "is required",
+	ValidationRequiredWithout: "is required when %s is not present",
+	ValidationMax:             "must be at most %s",
+	ValidationMin:             "must be at least %s",
+	ValidationGte:             "must be greater than or equal to %s",
+	ValidationLte:             "must be less than or equal to %s",
+	ValidationOneOf:           "must be one of: %s",
+	ValidationType:            "must be of type %s",
+	ValidationFormat:          "must be in %s format",
+	ValidationUnknownRule:     "does not satisfy %s validation",
+
+	RequestPathValueMissing:  "path value %s is missing",
+	RequestQueryParamMissing: "request param %s is missing",
+	RequestFieldMissing:      "field %s is missing",
+	RequestIncorrectUuid:     "field %s must be a valid uuid",
+
+	PageIncorrectOffset:    "offset must be a non negative integer",
+	PageIncorrectLimit:     "limit must be a non negative integer",
+	PageIncorrectCursor:    "cursor is malformed",
+	PageIncorrectWithTotal: "with_total must be a boolean",
+	PageCursorWithOffset:   "cursor and offset cannot be used together",
+
+	SortIncorrect:      "sort must be a comma separated list of fields, prefixed with - for descending order",
+	SortNotSupported:   "sorting is not supported",
+	SortCursorMismatch: "cursor does not match requested sort",
+	SortUnknownField:   "unknown sort field %q, expected one of: %s",
+
+	FilterIncorrectServiceType:     "provided incorrect service type",
+	FilterIncorrectCategoryId:      "provided incorrect category_id",
+	FilterIncorrectTenderStatus:    "incorrect tender status",
+	FilterIncorrectTenderOutcome:   "incorrect tender outcome",
+	FilterIncorrectOrganizationId:  "provided incorrect organization_id",
+	FilterIncorrectDate:            "date must be in RFC3339 or YYYY-MM-DD format",
+	FilterIncorrectBudget:          "budget must be a non negative number",
+	FilterIncorrectBudgetRange:     "budgetFrom must not be greater than budgetTo",
+	FilterCursorNotSupportedSearch: "cursor pagination is not supported for search",
+
+	AdminApiDisabled:    "admin api is disabled",
+	AdminTokenMissing:   "admin bearer token is not present",
+	AdminTokenIncorrect: "incorrect admin token",
+
+	IdempotencyKeyNotFound:     "idempotency key not found",
+	IdempotencyKeyIncorrect:    "idempotency key must be from 1 to %d characters long",
+	IdempotencyKeyReused:       "idempotency key was already used with a different request",
+	IdempotencyKeyInProgress:   "request with this idempotency key is still in progress",
+	EmployeeNotFound:           "employee not found",
+	OrganizationNotFound:       "organization not found",
+	OrganizationNotMember:      "employee is not a member of any organization",
+	OrganizationNotResponsible: "employee is not responsible for given organization",
+	OrganizationAlreadyMember:  "employee is already responsible for given organization",
+	OrganizationIncorrectType:  "incorrect organization type",
+
+	CategoryNotFound:            "category not found",
+	CategoryCodeTaken:           "category with given code already exists",
+	CategoryUnknown:             "provided unknown category",
+	CategoryParentNotFound:      "parent category not found",
+	CategoryCycle:               "category cannot be moved under itself or its descendant",
+	CategoryHasChildren:         "category has subcategories",
+	CategoryInUse:               "category is used by tenders",
+	CategoryServiceTypeMismatch: "serviceType does not match provided categories",
+	CategoryLegacyNotRemoved:    "categories mapped to service types cannot be deleted",
+
+	TenderNotFound:               "tender not found",
+	TenderVersionNotFound:        "given tender version does not exist",
+	TenderPrivateStatusesSearch:  "searching by not published statuses requires username and organization_id",
+	TenderBidDeadlineInPast:      "bidDeadline must be in the future",
+	TenderPublishWithoutDeadline: "tender cannot be published without a bid deadline in the future",
+	TenderPendingVotes:           "votes on the tender bids are still pending",
+	TenderTransitionNotAllowed:   "tender status cannot change from %s to %s",
+	TenderCancelWithoutReason:    "tender can only be cancelled with a reason through the cancel endpoint",
+	TenderAwardNotFound:          "tender has no published award",
+	TenderReopenCancelled:        "cancelled tender cannot be reopened",
+	TenderReopenAwarded:          "tender has an award, reopen it with the award cleared",
+	SavedSearchNotFound:          "saved search not found",
+	SavedSearchNotOwner:          "saved search belongs to another employee",
+
+	BidStatusNotAllowedForAuthor: "given status cannot be set by bid author",
+	BidCannotVote:                "cannot vote on given bid",
+	BidTenderClosed:              "tender is already closed",
+	BidCannotBidOnClosedTender:   "cannot make bid on closed tender",
+	BidVersionNotFound:           "given bid version does not exist",
+	BidNotAuthor:                 "employee is not the author of the bid",
+	BidNotInAuthorOrganization:   "employee is not in the organization of the bid author",
+	BidNoReviews:                 "no reviews found",
+	BidDecisionNotFound:          "employee has not voted on given bid",
+	BidIncorrectDecision:         "incorrect bid decision",
+	BidDeadlinePassed:            "bid deadline of the tender has passed",
+	BidWithdrawn:                 "bid has been withdrawn",
+	BidHasVotes:                  "bid already has votes, it can only be withdrawn",
+	BidHasDecision:               "bid already has a decision",
+	BidCannotWithdraw:            "only created or published bids without a decision can be withdrawn",
+	BidLateWithdrawalForbidden:   "tender does not allow withdrawing bids after the deadline",
+	BidTenderNotOpen:             "tender does not accept bids",
+	BidTransitionNotAllowed:      "bid status cannot change from %s to %s",
+	BidNotEditable:               "only created or published bids without a decision can be edited",
+	ContractNotFound:             "contract not found",
+	ContractAlreadyExists:        "contract for this award already exists",
+	ContractIncorrectTerm:        "contract end date must be after its start date",
+	ContractNotParty:             "employee is not a party to the contract",
+	ContractNotCustomer:          "only the tender organization can plan contract milestones",
+	ContractMilestoneNotFound:    "milestone not found",
+	ContractMilestoneOutsideTerm: "milestone due date must be within the contract term",
+	ContractNotCompleted:         "contract can be rated only after all its milestones are completed",
+	ContractAlreadyRated:         "contract has already been rated",
+	ContractRatingNotCustomer:    "only the tender organization can rate the contract",
+
+	RatingIncomplete:      "quality, timeliness and communication must be rated together",
+	RatingIncorrectScore:  "%s must be an integer from 1 to 5",
+	RatingBidAlreadyRated: "employee has already rated this bid",
+}
@@ -0,0 +1,81 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"golang.org/x/text/language"
+)
+
+type Language string
+
+const (
+	English Language = "en"
+	Russian Language = "ru"
+
+	AcceptLanguageHeader  = "Accept-Language"
+	ContentLanguageHeader = "Content-Language"
+)
+
+// supported languages, the first one is the fallback
+var (
+	languages = []Language{English, Russian}
+	matcher   = language.NewMatcher([]language.Tag{language.English, language.Russian})
+)
+
+var catalogs = map[Language]map[Code]string{
+	English: en,
+	Russian: ru,
+}
+
+// Error is an error with a stable code, its text is resolved from the catalog for the client language
+type Error struct {
+	Code Code
+	Args []any
+}
+
+func NewError(code Code, args ...any) *Error {
+	return &Error{Code: code, Args: args}
+}
+
+func (e *Error) Error() string {
+	return Translate(English, e.Code, e.Args...)
+}
+
+// FromAcceptLanguage picks the best supported language for the Accept-Language header value
+func FromAcceptLanguage(header string) Language {
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err != nil || len(tags) == 0 {
+		return English
+	}
+
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return English
+	}
+	return languages[index]
+}
+
+// Translate formats the message for code, falling back to English and then to the code itself
+func Translate(lang Language, code Code, args ...any) string {
+	format, ok := catalogs[lang][code]
+	if !ok {
+		format, ok = en[code]
+	}
+	if !ok {
+		return string(code)
+	}
+
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// Localize returns the text of err in lang, errors without a code get the fallback text so raw errors never reach clients
+func Localize(lang Language, err error, fallback Code) string {
+	var coded *Error
+	if errors.As(err, &coded) {
+		return Translate(lang, coded.Code, coded.Args...)
+	}
+	return Translate(lang, fallback)
+}
@@ -0,0 +1,138 @@
+package i18n
+
+var ru = map[Code]string{
+	CommonNotFound:         "запрошенная сущность не найдена",
+	CommonAlreadyExists:    "сущность с такими атрибутами уже существует",
+	CommonInvalidReference: "связанная сущность не существует",
+	CommonInvalidValue:     "запрос содержит недопустимое значение",
+	CommonInternal:         "внутренняя ошибка сервера",
+	CommonTooManyRequests:  "слишком много запросов, повторите позже",
+	CommonBadRequest:       "некорректный запрос",
+	CommonNotAuthorized:    "пользователь не авторизован",
+	CommonForbidden:        "недостаточно прав для выполнения действия",
+	CommonConflict:         "запрос противоречит текущему состоянию сущности",
+	CommonUnprocessable:    "запрос не может быть обработан",
+	CommonMalformedBody:    "тело запроса не является корректным json или не соответствует ожидаемой структуре",
+	CommonPayloadTooLarge:  "тело запроса слишком большое",
+
+	TitleBadRequest:          "Некорректный запрос",
+	TitleNotAuthorized:       "Не авторизован",
+	TitleForbidden:           "Доступ запрещён",
+	TitleNotFound:            "Не найдено",
+	TitleConflict:            "Конфликт",
+	TitleUnprocessableEntity: "Необрабатываемый запрос",
+	TitleTooManyRequests:     "Слишком много запросов",
+	TitlePayloadTooLarge:     "Слишком большой запрос",
+	TitleInternal:            "Внутренняя ошибка сервера",
+
+	ValidationFailed:          "запрос не прошёл проверку",
+	ValidationRequired:        "обязательное поле",
+	ValidationRequiredWithout: "обязательно, если не указано %s",
+	ValidationMax:             "должно быть не больше %s",
+	ValidationMin:             "должно быть не меньше %s",
+	ValidationGte:             "должно быть больше или равно %s",
+	ValidationLte:             "должно быть меньше или равно %s",
+	ValidationOneOf:           "должно быть одним из: %s",
+	ValidationType:            "должно иметь тип %s",
+	ValidationFormat:          "должно быть в формате %s",
+	ValidationUnknownRule:     "не проходит проверку %s",
+
+	RequestPathValueMissing:  "в пути не указан параметр %s",
+	RequestQueryParamMissing: "не указан параметр запроса %s",
+	RequestFieldMissing:      "не указано поле %s",
+	RequestIncorrectUuid:     "поле %s должно содержать корректный uuid",
+
+	PageIncorrectOffset:    "offset должен быть неотрицательным целым числом",
+	PageIncorrectLimit:     "limit должен быть неотрицательным целым числом",
+	PageIncorrectCursor:    "некорректный курсор",
+	PageIncorrectWithTotal: "with_total должен быть логическим значением",
+	PageCursorWithOffset:   "cursor и offset нельзя использовать вместе",
+
+	SortIncorrect:      "sort должен быть списком полей через запятую, для сортировки по убыванию используйте префикс -",
+	SortNotSupported:   "сортировка не поддерживается",
+	SortCursorMismatch: "курсор не соответствует запрошенной сортировке",
+	SortUnknownField:   "неизвестное поле сортировки %q, допустимые значения: %s",
+
+	FilterIncorrectServiceType:     "указан некорректный тип услуги",
+	FilterIncorrectCategoryId:      "указан некорректный category_id",
+	FilterIncorrectTenderStatus:    "некорректный статус тендера",
+	FilterIncorrectTenderOutcome:   "некорректный итог тендера",
+	FilterIncorrectOrganizationId:  "указан некорректный organization_id",
+	FilterIncorrectDate:            "дата должна быть в формате RFC3339 или YYYY-MM-DD",
+	FilterIncorrectBudget:          "бюджет должен быть неотрицательным числом",
+	FilterIncorrectBudgetRange:     "budgetFrom не должен быть больше budgetTo",
+	FilterCursorNotSupportedSearch: "пагинация по курсору не поддерживается для поиска",
+
+	AdminApiDisabled:    "административный api отключён",
+	AdminTokenMissing:   "не передан bearer токен администратора",
+	AdminTokenIncorrect: "неверный токен администратора",
+
+	IdempotencyKeyNotFound:     "ключ идемпотентности не найден",
+	IdempotencyKeyIncorrect:    "ключ идемпотентности должен содержать от 1 до %d символов",
+	IdempotencyKeyReused:       "ключ идемпотентности уже использован с другим запросом",
+	IdempotencyKeyInProgress:   "запрос с этим ключом идемпотентности ещё выполняется",
+	EmployeeNotFound:           "сотрудник не найден",
+	OrganizationNotFound:       "организация не найдена",
+	OrganizationNotMember:      "сотрудник не состоит ни в одной организации",
+	OrganizationNotResponsible: "сотрудник не является ответственным за организацию",
+	OrganizationAlreadyMember:  "сотрудник уже является ответственным за организацию",
+	OrganizationIncorrectType:  "некорректный тип организации",
+
+	CategoryNotFound:            "категория не найдена",
+	CategoryCodeTaken:           "категория с таким кодом уже существует",
+	CategoryUnknown:             "указана неизвестная категория",
+	CategoryParentNotFound:      "родительская категория не найдена",
+	CategoryCycle:               "категорию нельзя переместить в неё саму или в её потомка",
+	CategoryHasChildren:         "у категории есть подкатегории",
+	CategoryInUse:               "категория используется в тендерах",
+	CategoryServiceTypeMismatch: "serviceType не соответствует указанным категориям",
+	CategoryLegacyNotRemoved:    "категории, связанные с типами услуг, нельзя удалить",
+
+	TenderNotFound:               "тендер не найден",
+	TenderVersionNotFound:        "указанная версия тендера не существует",
+	TenderPrivateStatusesSearch:  "для поиска по неопубликованным статусам нужны username и organization_id",
+	TenderBidDeadlineInPast:      "срок подачи предложений должен быть в будущем",
+	TenderPublishWithoutDeadline: "тендер нельзя опубликовать без срока подачи предложений в будущем",
+	TenderPendingVotes:           "по предложениям тендера еще идет голосование",
+	TenderTransitionNotAllowed:   "статус тендера нельзя сменить с %s на %s",
+	TenderCancelWithoutReason:    "тендер можно отменить только с указанием причины через эндпоинт отмены",
+	TenderAwardNotFound:          "у тендера нет опубликованных итогов",
+	TenderReopenCancelled:        "отмененный тендер нельзя открыть повторно",
+	TenderReopenAwarded:          "у тендера есть победитель, открыть его можно только со сбросом итогов",
+	SavedSearchNotFound:          "сохранённый поиск не найден",
+	SavedSearchNotOwner:          "сохранённый поиск принадлежит другому сотруднику",
+
+	BidStatusNotAllowedForAuthor: "автор предложения не может установить этот статус",
+	BidCannotVote:                "нельзя голосовать по этому предложению",
+	BidTenderClosed:              "тендер уже закрыт",
+	BidCannotBidOnClosedTender:   "нельзя подать предложение на закрытый тендер",
+	BidVersionNotFound:           "указанная версия предложения не существует",
+	BidNotAuthor:                 "сотрудник не является автором предложения",
+	BidNotInAuthorOrganization:   "сотрудник не состоит в организации автора предложения",
+	BidNoReviews:                 "отзывы не найдены",
+	BidDecisionNotFound:          "сотрудник не голосовал по этому предложению",
+	BidIncorrectDecision:         "некорректное решение по предложению",
+	BidDeadlinePassed:            "срок подачи предложений по тендеру истёк",
+	BidWithdrawn:                 "предложение отозвано",
+	BidHasVotes:                  "по предложению уже есть голоса, его можно только отозвать",
+	BidHasDecision:               "по предложению уже принято решение",
+	BidCannotWithdraw:            "отозвать можно только созданное или опубликованное предложение без решения",
+	BidLateWithdrawalForbidden:   "тендер не разрешает отзывать предложения после окончания срока подачи",
+	BidTenderNotOpen:             "тендер не принимает предложения",
+	BidTransitionNotAllowed:      "статус предложения нельзя сменить с %s на %s",
+	BidNotEditable:               "изменить можно только созданное или опубликованное предложение без решения",
+	ContractNotFound:             "контракт не найден",
+	ContractAlreadyExists:        "контракт по этим итогам уже заключен",
+	ContractIncorrectTerm:        "дата окончания контракта должна быть позже даты начала",
+	ContractNotParty:             "сотрудник не является стороной контракта",
+	ContractNotCustomer:          "этапы контракта может планировать только организация тендера",
+	ContractMilestoneNotFound:    "этап контракта не найден",
+	ContractMilestoneOutsideTerm: "срок этапа должен попадать в срок действия контракта",
+	ContractNotCompleted:         "оценить контракт можно только после выполнения всех его этапов",
+	ContractAlreadyRated:         "контракт уже оценен",
+	ContractRatingNotCustomer:    "оценить контракт может только организация тендера",
+
+	RatingIncomplete:      "качество, сроки и коммуникацию нужно оценивать вместе",
+	RatingIncorrectScore:  "%s должно быть целым числом от 1 до 5",
+	RatingBidAlreadyRated: "сотрудник уже оценил это предложение",
+}
@@ -0,0 +1,123 @@
+package logger
+
+import (
+	"context"
+	"encoding/json"
+	"go.opentelemetry.io/otel/trace"
+	"log/slog"
+	"os"
+	"reflect"
+	"strings"
+	"tender-service/internal/config"
+	"tender-service/internal/reqinfo"
+)
+
+const redacted = "[REDACTED]"
+
+func NewLogger(cfg config.LogConfig) *slog.Logger {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(cfg.Level)); err != nil {
+		level = slog.LevelInfo
+	}
+
+	redact := make(map[string]bool, len(cfg.Redact))
+	for _, key := range cfg.Redact {
+		if key = strings.ToLower(strings.TrimSpace(key)); key != "" {
+			redact[key] = true
+		}
+	}
+
+	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		Level: level,
+		ReplaceAttr: func(_ []string, attr slog.Attr) slog.Attr {
+			if redact[strings.ToLower(attr.Key)] {
+				return slog.String(attr.Key, redacted)
+			}
+			if attr.Value.Kind() == slog.KindAny {
+				if value, ok := redactValue(attr.Value.Any(), redact); ok {
+					return slog.Any(attr.Key, value)
+				}
+			}
+			return attr
+		},
+	})
+
+	return slog.New(&contextHandler{Handler: handler})
+}
+
+// redactValue hides redacted keys inside structured values such as dtos, which are otherwise logged as a whole
+func redactValue(value any, redact map[string]bool) (any, bool) {
+	if _, isErr := value.(error); isErr {
+		return nil, false
+	}
+
+	switch reflect.Indirect(reflect.ValueOf(value)).Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+	default:
+		return nil, false
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, false
+	}
+
+	var decoded any
+	if err = json.Unmarshal(raw, &decoded); err != nil {
+		return nil, false
+	}
+
+	return decoded, redactKeys(decoded, redact)
+}
+
+func redactKeys(value any, redact map[string]bool) bool {
+	changed := false
+
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, nested := range typed {
+			if redact[strings.ToLower(key)] {
+				typed[key] = redacted
+				changed = true
+				continue
+			}
+			changed = redactKeys(nested, redact) || changed
+		}
+	case []any:
+		for _, nested := range typed {
+			changed = redactKeys(nested, redact) || changed
+		}
+	}
+
+	return changed
+}
+
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h *contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if info := reqinfo.FromContext(ctx); info != nil {
+		record.AddAttrs(
+			slog.String("request_id", info.Id),
+			slog.String("route", info.Route),
+		)
+		if info.User != "" {
+			record.AddAttrs(slog.String("user", info.User))
+		}
+	}
+
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		record.AddAttrs(slog.String("trace_id", spanContext.TraceID().String()))
+	}
+
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
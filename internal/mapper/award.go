@@ -0,0 +1,18 @@
+package mapper
+
+import (
+	"tender-service/internal/model/dto"
+	"tender-service/internal/model/entity/award"
+)
+
+func AwardToAwardDto(entity award.Award) dto.AwardDto {
+	return dto.AwardDto{
+		Id:         entity.Id,
+		TenderId:   entity.TenderId,
+		BidId:      entity.BidId,
+		BidVersion: entity.BidVersion,
+		Amount:     entity.Amount,
+		Approvers:  entity.Approvers,
+		AwardedAt:  entity.AwardedAt,
+	}
+}
@@ -9,6 +9,7 @@ func CreateBidDtoToBid(dto dto.CreateBidDto) bid.Bid {
 	return bid.Bid{
 		Name:        dto.Name,
 		Description: dto.Description,
+		Price:       dto.Price,
 		TenderId:    dto.TenderId,
 		AuthorType:  dto.AuthorType,
 		AuthorId:    dto.AuthorId,
@@ -22,12 +23,26 @@ func BidToBidDto(entity bid.Bid) dto.BidDto {
 		Id:          entity.Id,
 		Name:        entity.Name,
 		Description: entity.Description,
+		Price:       entity.Price,
 		Status:      entity.Status,
 		TenderId:    entity.TenderId,
 		AuthorType:  entity.AuthorType,
 		AuthorId:    entity.AuthorId,
 		Version:     entity.Version,
 		CreatedAt:   entity.CreatedAt,
+		Decision:    entity.Decision,
+		Withdrawal:  withdrawalToWithdrawalDto(entity.Withdrawal),
+		Transitions: entity.AllowedTransitions(),
+	}
+}
+
+func withdrawalToWithdrawalDto(withdrawal *bid.Withdrawal) *dto.WithdrawalDto {
+	if withdrawal == nil {
+		return nil
+	}
+	return &dto.WithdrawalDto{
+		Reason:      withdrawal.Reason,
+		WithdrawnAt: withdrawal.WithdrawnAt,
 	}
 }
 
@@ -0,0 +1,35 @@
+package mapper
+
+import (
+	"tender-service/internal/model/dto"
+	"tender-service/internal/model/entity/tender"
+)
+
+func CreateCategoryDtoToCategory(dto dto.CreateCategoryDto) tender.Category {
+	return tender.Category{
+		Code:     dto.Code,
+		Name:     dto.Name,
+		ParentId: dto.ParentId,
+	}
+}
+
+func CategoryToCategoryDto(entity tender.Category) dto.CategoryDto {
+	return dto.CategoryDto{
+		Id:          entity.Id,
+		Code:        entity.Code,
+		Name:        entity.Name,
+		ParentId:    entity.ParentId,
+		ServiceType: entity.ServiceType,
+		CreatedAt:   entity.CreatedAt,
+	}
+}
+
+func CategoryListToCategoryDtoList(list []tender.Category) []dto.CategoryDto {
+	dtoList := make([]dto.CategoryDto, len(list))
+
+	for i := 0; i < len(list); i++ {
+		dtoList[i] = CategoryToCategoryDto(list[i])
+	}
+
+	return dtoList
+}
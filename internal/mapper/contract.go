@@ -0,0 +1,73 @@
+package mapper
+
+import (
+	"tender-service/internal/model/dto"
+	"tender-service/internal/model/entity/contract"
+	"time"
+)
+
+func CreateContractDtoToContract(createDto dto.CreateContractDto) contract.Contract {
+	milestones := make([]contract.Milestone, len(createDto.Milestones))
+	for i, milestone := range createDto.Milestones {
+		milestones[i] = CreateMilestoneDtoToMilestone(milestone)
+	}
+
+	return contract.Contract{
+		TenderId:   createDto.TenderId,
+		Value:      createDto.Value,
+		StartDate:  createDto.StartDate,
+		EndDate:    createDto.EndDate,
+		Milestones: milestones,
+	}
+}
+
+func CreateMilestoneDtoToMilestone(createDto dto.CreateMilestoneDto) contract.Milestone {
+	return contract.Milestone{
+		Title:   createDto.Title,
+		DueDate: createDto.DueDate,
+	}
+}
+
+func ContractToContractDto(entity contract.Contract) dto.ContractDto {
+	milestones := make([]dto.MilestoneDto, len(entity.Milestones))
+	for i, milestone := range entity.Milestones {
+		milestones[i] = MilestoneToMilestoneDto(milestone)
+	}
+
+	return dto.ContractDto{
+		Id:                     entity.Id,
+		Number:                 entity.Number,
+		AwardId:                entity.AwardId,
+		TenderId:               entity.TenderId,
+		BidId:                  entity.BidId,
+		CustomerOrganizationId: entity.CustomerOrganizationId,
+		SupplierType:           entity.SupplierType,
+		SupplierId:             entity.SupplierId,
+		Value:                  entity.Value,
+		StartDate:              entity.StartDate,
+		EndDate:                entity.EndDate,
+		CreatedAt:              entity.CreatedAt,
+		Milestones:             milestones,
+	}
+}
+
+func MilestoneToMilestoneDto(entity contract.Milestone) dto.MilestoneDto {
+	return dto.MilestoneDto{
+		Id:          entity.Id,
+		Title:       entity.Title,
+		DueDate:     entity.DueDate,
+		Status:      entity.Status(time.Now()),
+		CompletedAt: entity.CompletedAt,
+		CompletedBy: entity.CompletedBy,
+	}
+}
+
+func ContractListToContractDtoList(list []contract.Contract) []dto.ContractDto {
+	dtoList := make([]dto.ContractDto, len(list))
+
+	for i := 0; i < len(list); i++ {
+		dtoList[i] = ContractToContractDto(list[i])
+	}
+
+	return dtoList
+}
@@ -0,0 +1,27 @@
+package mapper
+
+import (
+	"tender-service/internal/model/dto"
+	"tender-service/internal/model/entity/decision"
+)
+
+func DecisionToDecisionDto(dec decision.Decision) dto.DecisionDto {
+	return dto.DecisionDto{
+		Id:        dec.Id,
+		BidId:     dec.BidId,
+		Username:  dec.Username,
+		Verdict:   dec.Verdict,
+		CreatedAt: dec.CreatedAt,
+		UpdatedAt: dec.UpdatedAt,
+	}
+}
+
+func DecisionListToDecisionDtoList(list []decision.Decision) []dto.DecisionDto {
+	dtoList := make([]dto.DecisionDto, len(list))
+
+	for i := 0; i < len(list); i++ {
+		dtoList[i] = DecisionToDecisionDto(list[i])
+	}
+
+	return dtoList
+}
@@ -0,0 +1,16 @@
+package mapper
+
+import (
+	"tender-service/internal/model/dto"
+	"tender-service/internal/model/entity"
+)
+
+func EmployeeToEmployeeDto(employee entity.Employee) dto.EmployeeDto {
+	return dto.EmployeeDto{
+		Id:        employee.Id,
+		Username:  employee.Username,
+		FirstName: employee.FirstName,
+		LastName:  employee.LastName,
+		CreatedAt: employee.CreatedAt,
+	}
+}
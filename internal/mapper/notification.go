@@ -0,0 +1,16 @@
+package mapper
+
+import (
+	"tender-service/internal/model/dto"
+	"tender-service/internal/model/entity/notification"
+)
+
+func NotificationToNotificationDto(entity notification.Notification) dto.NotificationDto {
+	return dto.NotificationDto{
+		Id:        entity.Id,
+		Type:      entity.Type,
+		Recipient: entity.Recipient,
+		Payload:   entity.Payload,
+		CreatedAt: entity.CreatedAt,
+	}
+}
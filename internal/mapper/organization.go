@@ -0,0 +1,16 @@
+package mapper
+
+import (
+	"tender-service/internal/model/dto"
+	"tender-service/internal/model/entity/organization"
+)
+
+func OrganizationToOrganizationDto(org organization.Organization) dto.OrganizationDto {
+	return dto.OrganizationDto{
+		Id:          org.Id,
+		Name:        org.Name,
+		Description: org.Description,
+		Type:        org.Type,
+		CreatedAt:   org.CreatedAt,
+	}
+}
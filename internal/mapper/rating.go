@@ -0,0 +1,49 @@
+package mapper
+
+import (
+	"tender-service/internal/model/dto"
+	"tender-service/internal/model/entity/rating"
+)
+
+func RatingScoresDtoToRating(scoresDto dto.RatingScoresDto) rating.Rating {
+	return rating.Rating{
+		Quality:       scoresDto.Quality,
+		Timeliness:    scoresDto.Timeliness,
+		Communication: scoresDto.Communication,
+	}
+}
+
+func RatingToRatingDto(r rating.Rating) dto.RatingDto {
+	return dto.RatingDto{
+		Id:            r.Id,
+		TenderId:      r.TenderId,
+		BidId:         r.BidId,
+		FeedbackId:    r.FeedbackId,
+		ContractId:    r.ContractId,
+		Username:      r.Username,
+		Quality:       r.Quality,
+		Timeliness:    r.Timeliness,
+		Communication: r.Communication,
+		CreatedAt:     r.CreatedAt,
+	}
+}
+
+func RatingListToRatingDtoList(list []rating.Rating) []dto.RatingDto {
+	dtoList := make([]dto.RatingDto, len(list))
+
+	for i := 0; i < len(list); i++ {
+		dtoList[i] = RatingToRatingDto(list[i])
+	}
+
+	return dtoList
+}
+
+func ReputationToReputationDto(reputation rating.Reputation) dto.ReputationDto {
+	return dto.ReputationDto{
+		Count:         reputation.Count,
+		Score:         reputation.Score,
+		Quality:       reputation.Quality,
+		Timeliness:    reputation.Timeliness,
+		Communication: reputation.Communication,
+	}
+}
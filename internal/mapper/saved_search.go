@@ -0,0 +1,43 @@
+package mapper
+
+import (
+	"tender-service/internal/model/dto"
+	"tender-service/internal/model/entity/tender"
+)
+
+func CreateSavedSearchDtoToSavedSearch(dto dto.CreateSavedSearchDto, username string) tender.SavedSearch {
+	return tender.SavedSearch{
+		Name:            dto.Name,
+		Username:        username,
+		Query:           dto.Query,
+		ServiceTypes:    dto.ServiceTypes,
+		CategoryIds:     dto.CategoryIds,
+		OrganizationIds: dto.OrganizationIds,
+		BudgetFrom:      dto.BudgetFrom,
+		BudgetTo:        dto.BudgetTo,
+	}
+}
+
+func SavedSearchToSavedSearchDto(entity tender.SavedSearch) dto.SavedSearchDto {
+	return dto.SavedSearchDto{
+		Id:              entity.Id,
+		Name:            entity.Name,
+		Query:           entity.Query,
+		ServiceTypes:    entity.ServiceTypes,
+		CategoryIds:     entity.CategoryIds,
+		OrganizationIds: entity.OrganizationIds,
+		BudgetFrom:      entity.BudgetFrom,
+		BudgetTo:        entity.BudgetTo,
+		CreatedAt:       entity.CreatedAt,
+	}
+}
+
+func SavedSearchListToSavedSearchDtoList(list []tender.SavedSearch) []dto.SavedSearchDto {
+	dtoList := make([]dto.SavedSearchDto, len(list))
+
+	for i := 0; i < len(list); i++ {
+		dtoList[i] = SavedSearchToSavedSearchDto(list[i])
+	}
+
+	return dtoList
+}
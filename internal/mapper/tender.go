@@ -3,6 +3,7 @@ package mapper
 import (
 	"tender-service/internal/model/dto"
 	"tender-service/internal/model/entity/tender"
+	"time"
 )
 
 func CreateTenderDtoToTender(dto dto.CreateTenderDto) tender.Tender {
@@ -11,21 +12,44 @@ func CreateTenderDtoToTender(dto dto.CreateTenderDto) tender.Tender {
 		Description:     dto.Description,
 		Status:          tender.Created,
 		ServiceType:     dto.ServiceType,
+		CategoryIds:     dto.CategoryIds,
+		Budget:          dto.Budget,
 		Version:         1,
 		OrganizationId:  dto.OrganizationId,
 		CreatorUsername: dto.CreatorUsername,
+		Policy: tender.Policy{
+			BidDeadline:         dto.BidDeadline,
+			AllowLateWithdrawal: dto.AllowLateWithdrawal,
+		},
 	}
 }
 
 func TenderToTenderDto(entity tender.Tender) dto.TenderDto {
 	return dto.TenderDto{
-		Id:             entity.Id,
-		Name:           entity.Name,
-		Description:    entity.Description,
-		Status:         entity.Status,
-		ServiceType:    entity.ServiceType,
-		OrganizationId: entity.OrganizationId,
-		Version:        entity.Version,
+		Id:                  entity.Id,
+		Name:                entity.Name,
+		Description:         entity.Description,
+		Status:              entity.Status,
+		ServiceType:         entity.ServiceType,
+		CategoryIds:         entity.CategoryIds,
+		Budget:              entity.Budget,
+		BidDeadline:         entity.Policy.BidDeadline,
+		AllowLateWithdrawal: entity.Policy.AllowLateWithdrawal,
+		OrganizationId:      entity.OrganizationId,
+		Version:             entity.Version,
+		Outcome:             entity.Outcome(),
+		Cancellation:        cancellationToCancellationDto(entity.Cancellation),
+		Transitions:         entity.AllowedTransitions(time.Now()),
+	}
+}
+
+func cancellationToCancellationDto(cancellation *tender.Cancellation) *dto.CancellationDto {
+	if cancellation == nil {
+		return nil
+	}
+	return &dto.CancellationDto{
+		Reason:      cancellation.Reason,
+		CancelledAt: cancellation.CancelledAt,
 	}
 }
 
@@ -38,3 +62,24 @@ func TenderListToTenderDtoList(list []tender.Tender) []dto.TenderDto {
 
 	return dtoList
 }
+
+func SearchResultToTenderSearchResultDto(result tender.SearchResult) dto.TenderSearchResultDto {
+	return dto.TenderSearchResultDto{
+		TenderDto: TenderToTenderDto(result.Tender),
+		Rank:      result.Rank,
+		Highlights: dto.TenderSearchHighlights{
+			Name:        result.NameHighlight,
+			Description: result.DescriptionHighlight,
+		},
+	}
+}
+
+func SearchResultListToTenderSearchResultDtoList(list []tender.SearchResult) []dto.TenderSearchResultDto {
+	dtoList := make([]dto.TenderSearchResultDto, len(list))
+
+	for i := 0; i < len(list); i++ {
+		dtoList[i] = SearchResultToTenderSearchResultDto(list[i])
+	}
+
+	return dtoList
+}
@@ -0,0 +1,159 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+	"strconv"
+	"tender-service/internal/model/entity/decision"
+	"tender-service/internal/model/entity/tender"
+	"time"
+)
+
+const namespace = "tender_service"
+
+type Metrics struct {
+	registry         *prometheus.Registry
+	httpRequests     *prometheus.CounterVec
+	httpDuration     *prometheus.HistogramVec
+	tendersCreated   prometheus.Counter
+	tendersPublished prometheus.Counter
+	tendersClosed    prometheus.Counter
+	tendersCancelled prometheus.Counter
+	bidsCreated      prometheus.Counter
+	bidsWithdrawn    prometheus.Counter
+	decisions        *prometheus.CounterVec
+	quorumReached    prometheus.Counter
+	contractsCreated prometheus.Counter
+}
+
+func NewMetrics(pool *pgxpool.Pool) *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "Number of handled HTTP requests.",
+		}, []string{"method", "route", "status"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "HTTP request latency.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		tendersCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "tenders_created_total",
+			Help:      "Number of created tenders.",
+		}),
+		tendersPublished: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "tenders_published_total",
+			Help:      "Number of tender publications.",
+		}),
+		tendersClosed: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "tenders_closed_total",
+			Help:      "Number of closed tenders.",
+		}),
+		tendersCancelled: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "tenders_cancelled_total",
+			Help:      "Number of cancelled tenders.",
+		}),
+		bidsCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "bids_created_total",
+			Help:      "Number of created bids.",
+		}),
+		bidsWithdrawn: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "bids_withdrawn_total",
+			Help:      "Number of withdrawn bids.",
+		}),
+		decisions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "bid_decisions_total",
+			Help:      "Number of submitted bid decisions.",
+		}, []string{"verdict"}),
+		quorumReached: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "bid_quorum_reached_total",
+			Help:      "Number of bids approved by quorum.",
+		}),
+		contractsCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "contracts_created_total",
+			Help:      "Number of contracts created from awards.",
+		}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests,
+		m.httpDuration,
+		m.tendersCreated,
+		m.tendersPublished,
+		m.tendersClosed,
+		m.tendersCancelled,
+		m.bidsCreated,
+		m.bidsWithdrawn,
+		m.decisions,
+		m.quorumReached,
+		m.contractsCreated,
+	)
+
+	if pool != nil {
+		m.registry.MustRegister(newPoolCollector(pool))
+	}
+
+	return m
+}
+
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+func (m *Metrics) ObserveHttpRequest(method, route string, status int, duration time.Duration) {
+	code := strconv.Itoa(status)
+	m.httpRequests.WithLabelValues(method, route, code).Inc()
+	m.httpDuration.WithLabelValues(method, route, code).Observe(duration.Seconds())
+}
+
+func (m *Metrics) TenderCreated() {
+	m.tendersCreated.Inc()
+}
+
+func (m *Metrics) TenderStatusChanged(status tender.Status) {
+	switch status {
+	case tender.Published:
+		m.tendersPublished.Inc()
+	case tender.Closed:
+		m.tendersClosed.Inc()
+	case tender.Cancelled:
+		m.tendersCancelled.Inc()
+	}
+}
+
+func (m *Metrics) BidCreated() {
+	m.bidsCreated.Inc()
+}
+
+func (m *Metrics) BidWithdrawn() {
+	m.bidsWithdrawn.Inc()
+}
+
+func (m *Metrics) DecisionSubmitted(verdict decision.Verdict) {
+	m.decisions.WithLabelValues(string(verdict)).Inc()
+}
+
+func (m *Metrics) QuorumReached() {
+	m.quorumReached.Inc()
+}
+
+func (m *Metrics) ContractCreated() {
+	m.contractsCreated.Inc()
+}
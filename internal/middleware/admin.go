@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+	"tender-service/internal/httperr"
+	"tender-service/internal/i18n"
+	"tender-service/internal/model"
+)
+
+const (
+	authorizationHeader = "Authorization"
+	bearerPrefix        = "Bearer "
+)
+
+var (
+	errAdminApiDisabled = i18n.NewError(i18n.AdminApiDisabled)
+	errNoAdminToken     = i18n.NewError(i18n.AdminTokenMissing)
+	errIncorrectToken   = i18n.NewError(i18n.AdminTokenIncorrect)
+)
+
+func GetAdminMiddleware(token string, errHandler httperr.ApiErrorHandler, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		op := "admin_middleware"
+		w.Header().Set("Content-Type", "application/json")
+
+		if token == "" {
+			errHandler.Handler(model.NewForbiddenError(op, errAdminApiDisabled), w, r)
+			return
+		}
+
+		header := r.Header.Get(authorizationHeader)
+		if !strings.HasPrefix(header, bearerPrefix) {
+			errHandler.Handler(model.NewNotAuthorizedError(op, errNoAdminToken), w, r)
+			return
+		}
+
+		if subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(header, bearerPrefix)), []byte(token)) != 1 {
+			errHandler.Handler(model.NewForbiddenError(op, errIncorrectToken), w, r)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,136 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"tender-service/internal/httperr"
+	"tender-service/internal/i18n"
+	"tender-service/internal/model"
+	"tender-service/internal/service"
+)
+
+var errPayloadTooLarge = i18n.NewError(i18n.CommonPayloadTooLarge)
+
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+	fallbackMaxBodySize      = 1 << 20
+)
+
+type recordingWriter struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (w *recordingWriter) WriteHeader(statusCode int) {
+	w.statusCode = statusCode
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *recordingWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func GetIdempotencyMiddleware(idempotencyService service.IdempotencyService, maxBodySize int64, errHandler httperr.ApiErrorHandler, next http.Handler) http.Handler {
+	if maxBodySize <= 0 {
+		maxBodySize = fallbackMaxBodySize
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		op := "idempotency_middleware"
+
+		key := r.Header.Get(IdempotencyKeyHeader)
+		if key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			errHandler.Handler(model.NewPayloadTooLargeError(op, errPayloadTooLarge), w, r)
+			return
+		}
+		if err != nil {
+			errHandler.Handler(model.NewBadRequestError(op, err), w, r)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		caller := idempotencyCaller(r, body)
+
+		stored, replay, err := idempotencyService.BeginRequest(r.Context(), key, caller, requestHash(r, body))
+		if err != nil {
+			errHandler.Handler(err, w, r)
+			return
+		}
+
+		if replay {
+			if stored.ContentType != nil {
+				w.Header().Set("Content-Type", *stored.ContentType)
+			}
+			w.Header().Set(IdempotentReplayedHeader, "true")
+			w.WriteHeader(*stored.StatusCode)
+			_, _ = w.Write(stored.ResponseBody)
+			return
+		}
+
+		// the key is released if the request fails, so the client can retry with it
+		ctx := context.WithoutCancel(r.Context())
+		completed := false
+		defer func() {
+			if completed {
+				return
+			}
+			if err := idempotencyService.AbandonRequest(ctx, key, caller); err != nil {
+				slog.ErrorContext(ctx, "cannot release idempotency key", slog.Any("error", err))
+			}
+		}()
+
+		rw := &recordingWriter{ResponseWriter: w, statusCode: http.StatusOK}
+		next.ServeHTTP(rw, r)
+
+		if rw.statusCode >= http.StatusInternalServerError {
+			return
+		}
+
+		err = idempotencyService.CompleteRequest(ctx, key, caller, rw.statusCode, rw.Header().Get("Content-Type"), rw.body.Bytes())
+		if err != nil {
+			slog.ErrorContext(ctx, "cannot store idempotent response", slog.Any("error", err))
+			return
+		}
+		completed = true
+	})
+}
+
+func idempotencyCaller(r *http.Request, body []byte) string {
+	if username := r.URL.Query().Get(usernameQueryParam); username != "" {
+		return username
+	}
+
+	var claimed claimedIdentity
+	if json.Unmarshal(body, &claimed) != nil {
+		return ""
+	}
+
+	if claimed.CreatorUsername != "" {
+		return claimed.CreatorUsername
+	}
+	return string(claimed.AuthorType) + ":" + claimed.AuthorId.String()
+}
+
+func requestHash(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.Method + " " + r.URL.Path + "?" + r.URL.RawQuery + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -1,9 +1,7 @@
 package middleware
 
 import (
-	"bytes"
-	"io"
-	"log"
+	"log/slog"
 	"net/http"
 	"time"
 )
@@ -12,36 +10,20 @@ func GetLoggerMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
 
-		log.Printf("Started %s %s at %s", r.Method, r.URL.Path, start.String())
+		rw := &statusWriter{ResponseWriter: w, statusCode: http.StatusOK}
 
-		if r.Body != nil {
-			bodyBytes, _ := io.ReadAll(r.Body)
-			log.Printf("Request Body: %s", string(bodyBytes))
+		next.ServeHTTP(rw, r)
 
-			r.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
+		level := slog.LevelInfo
+		if rw.statusCode >= http.StatusInternalServerError {
+			level = slog.LevelError
 		}
 
-		rw := &responseWriter{w, http.StatusOK, &bytes.Buffer{}}
-
-		next.ServeHTTP(rw, r)
-
-		log.Printf("Response Body: %s", rw.body.String())
-		log.Printf("Completed %s %s with %d in %v", r.Method, r.URL.Path, rw.statusCode, time.Since(start))
+		slog.Log(r.Context(), level, "request completed",
+			slog.String("method", r.Method),
+			slog.String("path", r.URL.Path),
+			slog.Int("status", rw.statusCode),
+			slog.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+		)
 	})
 }
-
-type responseWriter struct {
-	http.ResponseWriter
-	statusCode int
-	body       *bytes.Buffer
-}
-
-func (rw *responseWriter) Write(b []byte) (int, error) {
-	rw.body.Write(b)
-	return rw.ResponseWriter.Write(b)
-}
-
-func (rw *responseWriter) WriteHeader(code int) {
-	rw.statusCode = code
-	rw.ResponseWriter.WriteHeader(code)
-}
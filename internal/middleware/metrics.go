@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"net/http"
+	"tender-service/internal/metrics"
+	"time"
+)
+
+func GetMetricsMiddleware(m *metrics.Metrics, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		rw := &statusWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+		next.ServeHTTP(rw, r)
+
+		m.ObserveHttpRequest(r.Method, RouteFromContext(r.Context()), rw.statusCode, time.Since(start))
+	})
+}
+
+type statusWriter struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (w *statusWriter) WriteHeader(code int) {
+	w.statusCode = code
+	w.ResponseWriter.WriteHeader(code)
+}
@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"tender-service/internal/httperr"
+	"tender-service/internal/model"
+	"tender-service/internal/openapi"
+)
+
+type bufferedWriter struct {
+	header     http.Header
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (w *bufferedWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *bufferedWriter) WriteHeader(statusCode int) {
+	if w.statusCode == 0 {
+		w.statusCode = statusCode
+	}
+}
+
+func (w *bufferedWriter) Write(b []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(b)
+}
+
+// GetOpenApiMiddleware rejects requests violating the openapi document before handlers run,
+// with validateResponses responses not matching the document are replaced with 500
+func GetOpenApiMiddleware(validator *openapi.Validator, validateRequests, validateResponses bool, errHandler httperr.ApiErrorHandler, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		op := "openapi_middleware"
+
+		input, err := validator.ValidateRequest(r)
+		if err != nil && validateRequests {
+			errHandler.Handler(model.NewBadRequestError(op, err), w, r)
+			return
+		}
+
+		if !validateResponses || input == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		bw := &bufferedWriter{header: w.Header()}
+		next.ServeHTTP(bw, r)
+		if bw.statusCode == 0 {
+			bw.statusCode = http.StatusOK
+		}
+
+		if err = validator.ValidateResponse(r.Context(), input, bw.statusCode, bw.header, bw.body.Bytes()); err != nil {
+			slog.ErrorContext(r.Context(), "response does not match openapi", slog.Any("error", err))
+			errHandler.Handler(model.NewInternalServerError(op, err), w, r)
+			return
+		}
+
+		w.WriteHeader(bw.statusCode)
+		_, _ = w.Write(bw.body.Bytes())
+	})
+}
@@ -0,0 +1,145 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"tender-service/internal/httperr"
+	"tender-service/internal/i18n"
+	"tender-service/internal/model"
+	"tender-service/internal/model/entity/bid"
+	"tender-service/internal/ratelimit"
+)
+
+const (
+	retryAfterHeader   = "Retry-After"
+	forwardedForHeader = "X-Forwarded-For"
+	decisionPathSuffix = "/submit_decision"
+)
+
+var errTooManyRequests = i18n.NewError(i18n.CommonTooManyRequests)
+
+type IdentityResolver interface {
+	Resolve(ctx context.Context, subject ratelimit.Subject) (ratelimit.Subject, error)
+}
+
+type rateLimitBucket struct {
+	scope ratelimit.Scope
+	value string
+}
+
+func GetRateLimitMiddleware(
+	limiter ratelimit.Limiter,
+	limits ratelimit.Limits,
+	resolver IdentityResolver,
+	trustProxy bool,
+	errHandler httperr.ApiErrorHandler,
+	next http.Handler,
+) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		op := "rate_limit_middleware"
+
+		class := routeClass(r)
+
+		buckets := []rateLimitBucket{{scope: ratelimit.Ip, value: clientIp(r, trustProxy)}}
+
+		subject, err := resolver.Resolve(r.Context(), subjectFromRequest(r))
+		if err != nil {
+			slog.DebugContext(r.Context(), "cannot resolve rate limit subject", slog.Any("error", err))
+		}
+		if subject.Username != "" {
+			buckets = append(buckets, rateLimitBucket{scope: ratelimit.Employee, value: subject.Username})
+		}
+		for _, organizationId := range subject.OrganizationIds {
+			buckets = append(buckets, rateLimitBucket{scope: ratelimit.Organization, value: organizationId.String()})
+		}
+
+		limited := make([]ratelimit.Bucket, 0, len(buckets))
+		for _, b := range buckets {
+			limit := limits[class][b.scope]
+			if !limit.Enabled() {
+				continue
+			}
+
+			limited = append(limited, ratelimit.Bucket{Key: string(class) + ":" + string(b.scope) + ":" + b.value, Limit: limit})
+		}
+
+		if len(limited) == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		allowed, retryAfter, err := limiter.Allow(r.Context(), limited)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "cannot check rate limit", slog.Any("error", err))
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if !allowed {
+			w.Header().Set(retryAfterHeader, strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			errHandler.Handler(model.NewTooManyRequestsError(op, errTooManyRequests), w, r)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func routeClass(r *http.Request) ratelimit.Class {
+	switch {
+	case strings.HasSuffix(r.URL.Path, decisionPathSuffix):
+		return ratelimit.Decision
+	case r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions:
+		return ratelimit.Read
+	default:
+		return ratelimit.Write
+	}
+}
+
+func clientIp(r *http.Request, trustProxy bool) string {
+	if trustProxy {
+		if forwarded := r.Header.Get(forwardedForHeader); forwarded != "" {
+			first, _, _ := strings.Cut(forwarded, ",")
+			return strings.TrimSpace(first)
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func subjectFromRequest(r *http.Request) ratelimit.Subject {
+	subject := ratelimit.Subject{Username: r.URL.Query().Get(usernameQueryParam)}
+
+	if r.Body == nil || r.Method == http.MethodGet {
+		return subject
+	}
+
+	peeked, err := peekBody(r, maxPeekedBodyLength)
+	if err != nil {
+		return subject
+	}
+
+	var body claimedIdentity
+	if json.Unmarshal(peeked, &body) != nil {
+		return subject
+	}
+
+	if subject.Username == "" {
+		subject.Username = body.CreatorUsername
+	}
+	if body.AuthorType == bid.AuthorUser {
+		subject.EmployeeId = body.AuthorId
+	}
+
+	return subject
+}
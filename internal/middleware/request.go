@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"bytes"
+	"github.com/google/uuid"
+	"io"
+	"net/http"
+	"tender-service/internal/model/entity/bid"
+	"tender-service/internal/reqinfo"
+)
+
+const (
+	RequestIdHeader     = "X-Request-ID"
+	usernameQueryParam  = "username"
+	maxPeekedBodyLength = 1 << 20
+)
+
+type claimedIdentity struct {
+	CreatorUsername string         `json:"creatorUsername"`
+	AuthorType      bid.AuthorType `json:"authorType"`
+	AuthorId        uuid.UUID      `json:"authorId"`
+}
+
+func GetRequestMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestId := r.Header.Get(RequestIdHeader)
+		if !reqinfo.IsValidId(requestId) {
+			requestId = uuid.NewString()
+		}
+
+		w.Header().Set(RequestIdHeader, requestId)
+
+		info := &reqinfo.Info{
+			Id:    requestId,
+			User:  r.URL.Query().Get(usernameQueryParam),
+			Route: reqinfo.UnmatchedRoute,
+		}
+
+		next.ServeHTTP(w, r.WithContext(reqinfo.NewContext(r.Context(), info)))
+	})
+}
+
+// peekBody reads up to limit bytes of the body and puts them back, so handlers still see the whole body
+func peekBody(r *http.Request, limit int64) ([]byte, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil, nil
+	}
+
+	peeked, err := io.ReadAll(io.LimitReader(r.Body, limit))
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(peeked), r.Body), r.Body}
+
+	return peeked, err
+}
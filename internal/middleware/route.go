@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"context"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+	"tender-service/internal/reqinfo"
+)
+
+func WithRoute(route string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if info := reqinfo.FromContext(r.Context()); info != nil {
+			info.Route = route
+		}
+
+		span := trace.SpanFromContext(r.Context())
+		span.SetName(r.Method + " " + route)
+		span.SetAttributes(attribute.String("http.route", route))
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func RouteFromContext(ctx context.Context) string {
+	if info := reqinfo.FromContext(ctx); info != nil {
+		return info.Route
+	}
+	return reqinfo.UnmatchedRoute
+}
@@ -0,0 +1,76 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+	"github.com/pressly/goose/v3/lock"
+	"os"
+)
+
+// lockId is the postgres advisory lock held while migrations run, replicas starting together wait for each other
+const lockId = 5887940537704921958
+
+type Migrator struct {
+	provider *goose.Provider
+}
+
+func NewMigrator(conn, dir string) (*Migrator, error) {
+	db, err := goose.OpenDBWithDriver("postgres", conn)
+	if err != nil {
+		return nil, err
+	}
+
+	locker, err := lock.NewPostgresSessionLocker(lock.WithLockID(lockId))
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	provider, err := goose.NewProvider(goose.DialectPostgres, db, os.DirFS(dir), goose.WithSessionLocker(locker))
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("cannot read migrations from %s: %w", dir, err)
+	}
+
+	return &Migrator{provider: provider}, nil
+}
+
+func (m *Migrator) Up(ctx context.Context) ([]*goose.MigrationResult, error) {
+	return m.provider.Up(ctx)
+}
+
+// Down rolls back the latest applied migration
+func (m *Migrator) Down(ctx context.Context) ([]*goose.MigrationResult, error) {
+	result, err := m.provider.Down(ctx)
+	if result == nil {
+		return nil, err
+	}
+	return []*goose.MigrationResult{result}, err
+}
+
+// To migrates up or down depending on the current version, 0 rolls back every migration
+func (m *Migrator) To(ctx context.Context, version int64) ([]*goose.MigrationResult, error) {
+	current, err := m.provider.GetDBVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if version < current {
+		return m.provider.DownTo(ctx, version)
+	}
+	return m.provider.UpTo(ctx, version)
+}
+
+func (m *Migrator) Status(ctx context.Context) ([]*goose.MigrationStatus, error) {
+	return m.provider.Status(ctx)
+}
+
+func (m *Migrator) Version(ctx context.Context) (int64, error) {
+	return m.provider.GetDBVersion(ctx)
+}
+
+func (m *Migrator) Close() error {
+	return m.provider.Close()
+}
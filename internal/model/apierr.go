@@ -9,6 +9,9 @@ const (
 	InternalServerErrorCode ApiErrorCode = "internal_server_error"
 	NotFoundCode            ApiErrorCode = "not_found"
 	UnprocessableEntityCode ApiErrorCode = "not_processable_entity"
+	TooManyRequestsCode     ApiErrorCode = "too_many_requests"
+	ConflictCode            ApiErrorCode = "conflict"
+	PayloadTooLargeCode     ApiErrorCode = "payload_too_large"
 )
 
 type ApiError struct {
@@ -21,6 +24,10 @@ func (e ApiError) Error() string {
 	return e.Source + ":" + string(e.Code) + ":" + e.Err.Error()
 }
 
+func (e ApiError) Unwrap() error {
+	return e.Err
+}
+
 func NewUnprocessableEntityError(source string, err error) ApiError {
 	return newApiError(source, err, UnprocessableEntityCode)
 }
@@ -41,6 +48,18 @@ func NewBadRequestError(source string, err error) ApiError {
 	return newApiError(source, err, BadRequestCode)
 }
 
+func NewTooManyRequestsError(source string, err error) ApiError {
+	return newApiError(source, err, TooManyRequestsCode)
+}
+
+func NewConflictError(source string, err error) ApiError {
+	return newApiError(source, err, ConflictCode)
+}
+
+func NewPayloadTooLargeError(source string, err error) ApiError {
+	return newApiError(source, err, PayloadTooLargeCode)
+}
+
 func NewInternalServerError(source string, err error) ApiError {
 	return newApiError(source, err, InternalServerErrorCode)
 }
@@ -0,0 +1,16 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type AwardDto struct {
+	Id         uuid.UUID `json:"id"`
+	TenderId   uuid.UUID `json:"tenderId"`
+	BidId      uuid.UUID `json:"bidId"`
+	BidVersion int       `json:"bidVersion"`
+	Amount     *float64  `json:"amount,omitempty"`
+	Approvers  []string  `json:"approvers"`
+	AwardedAt  time.Time `json:"awardedAt"`
+}
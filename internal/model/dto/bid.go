@@ -9,6 +9,7 @@ import (
 type CreateBidDto struct {
 	Name        string         `json:"name" validate:"required"`
 	Description string         `json:"description" validate:"required"`
+	Price       *float64       `json:"price,omitempty" validate:"omitempty,gte=0"`
 	TenderId    uuid.UUID      `json:"tenderId" validate:"required"`
 	AuthorType  bid.AuthorType `json:"authorType" validate:"required"`
 	AuthorId    uuid.UUID      `json:"authorId" validate:"required"`
@@ -18,15 +19,31 @@ type BidDto struct {
 	Id          uuid.UUID      `json:"id"`
 	Name        string         `json:"name"`
 	Description string         `json:"description"`
+	Price       *float64       `json:"price,omitempty"`
 	Status      bid.Status     `json:"status"`
 	TenderId    uuid.UUID      `json:"tenderId"`
 	AuthorType  bid.AuthorType `json:"authorType"`
 	AuthorId    uuid.UUID      `json:"authorId"`
 	Version     int            `json:"version"`
 	CreatedAt   time.Time      `json:"createdAt"`
+	Decision    bid.Decision   `json:"decision"`
+	Withdrawal  *WithdrawalDto `json:"withdrawal,omitempty"`
+	Transitions []bid.Status   `json:"transitions"`
+	// AuthorReputation is filled only in the tender organization's bid list
+	AuthorReputation *ReputationDto `json:"authorReputation,omitempty"`
+}
+
+type WithdrawalDto struct {
+	Reason      string    `json:"reason"`
+	WithdrawnAt time.Time `json:"withdrawnAt"`
 }
 
 type UpdateBidDto struct {
-	Name        string `json:"name"`
-	Description string `json:"description"`
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Price       *float64 `json:"price,omitempty" validate:"omitempty,gte=0"`
+}
+
+type WithdrawBidDto struct {
+	Reason string `json:"reason" validate:"required,max=1000"`
 }
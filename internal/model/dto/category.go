@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"tender-service/internal/model/entity/tender"
+	"time"
+)
+
+type CreateCategoryDto struct {
+	Code     string     `json:"code" validate:"required,max=50"`
+	Name     string     `json:"name" validate:"required,max=255"`
+	ParentId *uuid.UUID `json:"parentId,omitempty"`
+}
+
+type UpdateCategoryDto struct {
+	Code     string     `json:"code,omitempty" validate:"max=50"`
+	Name     string     `json:"name,omitempty" validate:"max=255"`
+	ParentId *uuid.UUID `json:"parentId,omitempty"`
+}
+
+type CategoryDto struct {
+	Id          uuid.UUID           `json:"id"`
+	Code        string              `json:"code"`
+	Name        string              `json:"name"`
+	ParentId    *uuid.UUID          `json:"parentId,omitempty"`
+	ServiceType *tender.ServiceType `json:"serviceType,omitempty"`
+	CreatedAt   time.Time           `json:"createdAt"`
+}
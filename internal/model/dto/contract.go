@@ -0,0 +1,46 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"tender-service/internal/model/entity/bid"
+	"tender-service/internal/model/entity/contract"
+	"time"
+)
+
+type CreateContractDto struct {
+	TenderId   uuid.UUID            `json:"tenderId" validate:"required"`
+	Value      *float64             `json:"value,omitempty" validate:"omitempty,gte=0"`
+	StartDate  time.Time            `json:"startDate" validate:"required"`
+	EndDate    time.Time            `json:"endDate" validate:"required"`
+	Milestones []CreateMilestoneDto `json:"milestones,omitempty" validate:"max=50,dive"`
+}
+
+type CreateMilestoneDto struct {
+	Title   string    `json:"title" validate:"required,max=100"`
+	DueDate time.Time `json:"dueDate" validate:"required"`
+}
+
+type ContractDto struct {
+	Id                     uuid.UUID      `json:"id"`
+	Number                 string         `json:"number"`
+	AwardId                uuid.UUID      `json:"awardId"`
+	TenderId               uuid.UUID      `json:"tenderId"`
+	BidId                  uuid.UUID      `json:"bidId"`
+	CustomerOrganizationId uuid.UUID      `json:"customerOrganizationId"`
+	SupplierType           bid.AuthorType `json:"supplierType"`
+	SupplierId             uuid.UUID      `json:"supplierId"`
+	Value                  *float64       `json:"value,omitempty"`
+	StartDate              time.Time      `json:"startDate"`
+	EndDate                time.Time      `json:"endDate"`
+	CreatedAt              time.Time      `json:"createdAt"`
+	Milestones             []MilestoneDto `json:"milestones"`
+}
+
+type MilestoneDto struct {
+	Id          uuid.UUID                `json:"id"`
+	Title       string                   `json:"title"`
+	DueDate     time.Time                `json:"dueDate"`
+	Status      contract.MilestoneStatus `json:"status"`
+	CompletedAt *time.Time               `json:"completedAt,omitempty"`
+	CompletedBy *string                  `json:"completedBy,omitempty"`
+}
@@ -0,0 +1,16 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"tender-service/internal/model/entity/decision"
+	"time"
+)
+
+type DecisionDto struct {
+	Id        uuid.UUID        `json:"id"`
+	BidId     uuid.UUID        `json:"bidId"`
+	Username  string           `json:"username"`
+	Verdict   decision.Verdict `json:"verdict"`
+	CreatedAt time.Time        `json:"createdAt"`
+	UpdatedAt time.Time        `json:"updatedAt"`
+}
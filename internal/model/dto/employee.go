@@ -0,0 +1,14 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type EmployeeDto struct {
+	Id        uuid.UUID `json:"id"`
+	Username  string    `json:"username"`
+	FirstName string    `json:"firstName,omitempty"`
+	LastName  string    `json:"lastName,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
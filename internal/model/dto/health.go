@@ -0,0 +1,11 @@
+package dto
+
+type HealthDto struct {
+	Status     string                        `json:"status"`
+	Components map[string]ComponentHealthDto `json:"components,omitempty"`
+}
+
+type ComponentHealthDto struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
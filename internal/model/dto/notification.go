@@ -0,0 +1,16 @@
+package dto
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"tender-service/internal/model/entity/notification"
+	"time"
+)
+
+type NotificationDto struct {
+	Id        uuid.UUID         `json:"id"`
+	Type      notification.Type `json:"type"`
+	Recipient string            `json:"recipient"`
+	Payload   json.RawMessage   `json:"payload"`
+	CreatedAt time.Time         `json:"createdAt"`
+}
@@ -0,0 +1,15 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"tender-service/internal/model/entity/organization"
+	"time"
+)
+
+type OrganizationDto struct {
+	Id          uuid.UUID         `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Type        organization.Type `json:"type,omitempty"`
+	CreatedAt   time.Time         `json:"createdAt"`
+}
@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type RatingScoresDto struct {
+	Quality       int `json:"quality" validate:"required,min=1,max=5"`
+	Timeliness    int `json:"timeliness" validate:"required,min=1,max=5"`
+	Communication int `json:"communication" validate:"required,min=1,max=5"`
+}
+
+type RatingDto struct {
+	Id            uuid.UUID  `json:"id"`
+	TenderId      uuid.UUID  `json:"tenderId"`
+	BidId         uuid.UUID  `json:"bidId"`
+	FeedbackId    *uuid.UUID `json:"feedbackId,omitempty"`
+	ContractId    *uuid.UUID `json:"contractId,omitempty"`
+	Username      string     `json:"username"`
+	Quality       int        `json:"quality"`
+	Timeliness    int        `json:"timeliness"`
+	Communication int        `json:"communication"`
+	CreatedAt     time.Time  `json:"createdAt"`
+}
+
+type ReputationDto struct {
+	Count         int      `json:"count"`
+	Score         *float64 `json:"score,omitempty"`
+	Quality       *float64 `json:"quality,omitempty"`
+	Timeliness    *float64 `json:"timeliness,omitempty"`
+	Communication *float64 `json:"communication,omitempty"`
+}
@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"tender-service/internal/model/entity/tender"
+	"time"
+)
+
+type CreateSavedSearchDto struct {
+	Name            string               `json:"name" validate:"required,max=100"`
+	Query           string               `json:"query" validate:"max=200"`
+	ServiceTypes    []tender.ServiceType `json:"serviceTypes,omitempty"`
+	CategoryIds     []uuid.UUID          `json:"categoryIds,omitempty"`
+	OrganizationIds []uuid.UUID          `json:"organizationIds,omitempty"`
+	BudgetFrom      *float64             `json:"budgetFrom,omitempty" validate:"omitempty,gte=0"`
+	BudgetTo        *float64             `json:"budgetTo,omitempty" validate:"omitempty,gte=0"`
+}
+
+type SavedSearchDto struct {
+	Id              uuid.UUID            `json:"id"`
+	Name            string               `json:"name"`
+	Query           string               `json:"query"`
+	ServiceTypes    []tender.ServiceType `json:"serviceTypes"`
+	CategoryIds     []uuid.UUID          `json:"categoryIds"`
+	OrganizationIds []uuid.UUID          `json:"organizationIds"`
+	BudgetFrom      *float64             `json:"budgetFrom,omitempty"`
+	BudgetTo        *float64             `json:"budgetTo,omitempty"`
+	CreatedAt       time.Time            `json:"createdAt"`
+}
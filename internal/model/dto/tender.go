@@ -3,28 +3,62 @@ package dto
 import (
 	"github.com/google/uuid"
 	"tender-service/internal/model/entity/tender"
+	"time"
 )
 
 type CreateTenderDto struct {
-	Name            string             `json:"name" validate:"required"`
-	Description     string             `json:"description" validate:"required"`
-	ServiceType     tender.ServiceType `json:"serviceType" validate:"required"`
-	OrganizationId  uuid.UUID          `json:"organizationId" validate:"required"`
-	CreatorUsername string             `json:"creatorUsername" validate:"required"`
+	Name                string             `json:"name" validate:"required"`
+	Description         string             `json:"description" validate:"required"`
+	ServiceType         tender.ServiceType `json:"serviceType,omitempty" validate:"required_without=CategoryIds"`
+	CategoryIds         []uuid.UUID        `json:"categoryIds,omitempty" validate:"required_without=ServiceType,max=10"`
+	Budget              *float64           `json:"budget,omitempty" validate:"omitempty,gte=0"`
+	BidDeadline         *time.Time         `json:"bidDeadline,omitempty"`
+	AllowLateWithdrawal bool               `json:"allowLateWithdrawal,omitempty"`
+	OrganizationId      uuid.UUID          `json:"organizationId" validate:"required"`
+	CreatorUsername     string             `json:"creatorUsername" validate:"required"`
 }
 
 type TenderDto struct {
-	Id             uuid.UUID          `json:"id"`
-	Name           string             `json:"name"`
-	Description    string             `json:"description"`
-	Status         tender.Status      `json:"status"`
-	ServiceType    tender.ServiceType `json:"serviceType"`
-	OrganizationId uuid.UUID          `json:"organizationId"`
-	Version        int                `json:"version"`
+	Id                  uuid.UUID          `json:"id"`
+	Name                string             `json:"name"`
+	Description         string             `json:"description"`
+	Status              tender.Status      `json:"status"`
+	ServiceType         tender.ServiceType `json:"serviceType,omitempty"`
+	CategoryIds         []uuid.UUID        `json:"categoryIds"`
+	Budget              *float64           `json:"budget,omitempty"`
+	BidDeadline         *time.Time         `json:"bidDeadline,omitempty"`
+	AllowLateWithdrawal bool               `json:"allowLateWithdrawal"`
+	OrganizationId      uuid.UUID          `json:"organizationId"`
+	Version             int                `json:"version"`
+	Outcome             tender.Outcome     `json:"outcome,omitempty"`
+	Cancellation        *CancellationDto   `json:"cancellation,omitempty"`
+	Transitions         []tender.Status    `json:"transitions"`
+}
+
+type CancellationDto struct {
+	Reason      string    `json:"reason"`
+	CancelledAt time.Time `json:"cancelledAt"`
+}
+
+type CancelTenderDto struct {
+	Reason string `json:"reason" validate:"required,max=1000"`
 }
 
 type UpdateTenderDto struct {
-	Name        string             `json:"name"`
-	Description string             `json:"description"`
-	ServiceType tender.ServiceType `json:"serviceType"`
+	Name        string             `json:"name,omitempty"`
+	Description string             `json:"description,omitempty"`
+	ServiceType tender.ServiceType `json:"serviceType,omitempty"`
+	CategoryIds []uuid.UUID        `json:"categoryIds,omitempty" validate:"max=10"`
+	Budget      *float64           `json:"budget,omitempty" validate:"omitempty,gte=0"`
+}
+
+type TenderSearchResultDto struct {
+	TenderDto
+	Rank       float64                `json:"rank"`
+	Highlights TenderSearchHighlights `json:"highlights"`
+}
+
+type TenderSearchHighlights struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
 }
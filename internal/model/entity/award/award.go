@@ -0,0 +1,16 @@
+package award
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type Award struct {
+	Id         uuid.UUID
+	TenderId   uuid.UUID
+	BidId      uuid.UUID
+	BidVersion int
+	Amount     *float64
+	Approvers  []string
+	AwardedAt  time.Time
+}
@@ -18,24 +18,33 @@ const (
 	Created   Status = "Created"
 	Published Status = "Published"
 	Canceled  Status = "Canceled"
+	Withdrawn Status = "Withdrawn"
 )
 
 type Decision string
 
 const (
-	Approved Decision = "Approved"
-	Rejected Decision = "Rejected"
-	None     Decision = "None"
+	Approved   Decision = "Approved"
+	Rejected   Decision = "Rejected"
+	None       Decision = "None"
+	NotAwarded Decision = "NotAwarded"
+	Lost       Decision = "Lost"
 )
 
 func IsSelectableByOwner(status Status) bool {
 	return status == Created || status == Published || status == Canceled
 }
 
+// Editable reports whether a new version of the bid can be created, the price must not change after a decision
+func (b Bid) Editable() bool {
+	return (b.Status == Created || b.Status == Published) && b.Decision == None
+}
+
 type Bid struct {
 	Id          uuid.UUID
 	Name        string
 	Description string
+	Price       *float64
 	Status      Status
 	TenderId    uuid.UUID
 	AuthorType  AuthorType
@@ -43,4 +52,11 @@ type Bid struct {
 	Version     int
 	CreatedAt   time.Time
 	Decision    Decision
+	Withdrawal  *Withdrawal
+	Facts       Facts
+}
+
+type Withdrawal struct {
+	Reason      string
+	WithdrawnAt time.Time
 }
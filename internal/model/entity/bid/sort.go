@@ -0,0 +1,33 @@
+package bid
+
+import (
+	"strconv"
+	"time"
+)
+
+const (
+	SortByName      = "name"
+	SortByCreatedAt = "created_at"
+	SortByVersion   = "version"
+	SortByPrice     = "price"
+)
+
+const noPriceSortValue = "-1"
+
+var SortFields = []string{SortByName, SortByCreatedAt, SortByVersion, SortByPrice}
+
+func (b Bid) SortValue(field string) string {
+	switch field {
+	case SortByName:
+		return b.Name
+	case SortByVersion:
+		return strconv.Itoa(b.Version)
+	case SortByPrice:
+		if b.Price == nil {
+			return noPriceSortValue
+		}
+		return strconv.FormatFloat(*b.Price, 'f', -1, 64)
+	default:
+		return b.CreatedAt.Format(time.RFC3339Nano)
+	}
+}
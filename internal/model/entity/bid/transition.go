@@ -0,0 +1,92 @@
+package bid
+
+import "tender-service/internal/i18n"
+
+// Facts are read together with the bid, transition guards depend on them
+type Facts struct {
+	HasVotes   bool
+	TenderOpen bool
+}
+
+type transition struct {
+	to    Status
+	guard func(b Bid) error
+}
+
+var transitions = map[Status][]transition{
+	Created: {
+		{to: Published, guard: tenderOpen},
+		{to: Canceled},
+		{to: Withdrawn, guard: undecided},
+	},
+	Published: {
+		{to: Created, guard: noVotes},
+		{to: Canceled, guard: noVotes},
+		{to: Withdrawn, guard: undecided},
+	},
+	Canceled:  {},
+	Withdrawn: {},
+}
+
+var (
+	errTenderNotOpen = i18n.NewError(i18n.BidTenderNotOpen)
+	errHasVotes      = i18n.NewError(i18n.BidHasVotes)
+	errHasDecision   = i18n.NewError(i18n.BidHasDecision)
+	errDecided       = i18n.NewError(i18n.BidCannotWithdraw)
+)
+
+func tenderOpen(b Bid) error {
+	if !b.Facts.TenderOpen {
+		return errTenderNotOpen
+	}
+	return nil
+}
+
+func noVotes(b Bid) error {
+	if b.Decision != None {
+		return errHasDecision
+	}
+	if b.Facts.HasVotes {
+		return errHasVotes
+	}
+	return nil
+}
+
+func undecided(b Bid) error {
+	if b.Decision != None {
+		return errDecided
+	}
+	return nil
+}
+
+// CheckTransition returns why the bid cannot move to status, staying in the current status is always allowed
+func (b Bid) CheckTransition(to Status) error {
+	if to == b.Status {
+		return nil
+	}
+
+	for _, tr := range transitions[b.Status] {
+		if tr.to != to {
+			continue
+		}
+		if tr.guard == nil {
+			return nil
+		}
+		return tr.guard(b)
+	}
+
+	return i18n.NewError(i18n.BidTransitionNotAllowed, b.Status, to)
+}
+
+// AllowedTransitions lists the statuses the bid can move to right now
+func (b Bid) AllowedTransitions() []Status {
+	allowed := make([]Status, 0, len(transitions[b.Status]))
+
+	for _, tr := range transitions[b.Status] {
+		if tr.guard == nil || tr.guard(b) == nil {
+			allowed = append(allowed, tr.to)
+		}
+	}
+
+	return allowed
+}
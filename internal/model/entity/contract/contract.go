@@ -0,0 +1,68 @@
+package contract
+
+import (
+	"github.com/google/uuid"
+	"tender-service/internal/model/entity/bid"
+	"time"
+)
+
+type MilestoneStatus string
+
+const (
+	MilestonePending   MilestoneStatus = "Pending"
+	MilestoneOverdue   MilestoneStatus = "Overdue"
+	MilestoneCompleted MilestoneStatus = "Completed"
+)
+
+// Contract tracks execution of an awarded tender between the tender organization and the bid author
+type Contract struct {
+	Id                     uuid.UUID
+	Number                 string
+	AwardId                uuid.UUID
+	TenderId               uuid.UUID
+	BidId                  uuid.UUID
+	CustomerOrganizationId uuid.UUID
+	SupplierType           bid.AuthorType
+	SupplierId             uuid.UUID
+	Value                  *float64
+	StartDate              time.Time
+	EndDate                time.Time
+	CreatedAt              time.Time
+	Milestones             []Milestone
+}
+
+type Milestone struct {
+	Id          uuid.UUID
+	ContractId  uuid.UUID
+	Title       string
+	DueDate     time.Time
+	CompletedAt *time.Time
+	CompletedBy *string
+	CreatedAt   time.Time
+}
+
+func (m Milestone) Status(now time.Time) MilestoneStatus {
+	switch {
+	case m.CompletedAt != nil:
+		return MilestoneCompleted
+	case now.After(m.DueDate):
+		return MilestoneOverdue
+	default:
+		return MilestonePending
+	}
+}
+
+// WithinTerm tells whether the date falls between the contract start and end dates
+func (c Contract) WithinTerm(date time.Time) bool {
+	return !date.Before(c.StartDate) && !date.After(c.EndDate)
+}
+
+// Completed tells whether all milestones are done, a contract without milestones completes at its end date
+func (c Contract) Completed(now time.Time) bool {
+	for _, milestone := range c.Milestones {
+		if milestone.CompletedAt == nil {
+			return false
+		}
+	}
+	return len(c.Milestones) > 0 || !now.Before(c.EndDate)
+}
@@ -1,6 +1,9 @@
 package decision
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"time"
+)
 
 type Verdict string
 
@@ -15,8 +18,10 @@ func IsDecisionVerdict(string2 string) bool {
 }
 
 type Decision struct {
-	Id       uuid.UUID
-	Verdict  Verdict
-	Username string
-	BidId    uuid.UUID
+	Id        uuid.UUID
+	Verdict   Verdict
+	Username  string
+	BidId     uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
@@ -0,0 +1,38 @@
+package dump
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Tables lists exported tables in the order they are imported, referenced tables go first
+var Tables = []string{
+	"employee",
+	"organization",
+	"organization_responsible",
+	"category",
+	"tender",
+	"tender_version",
+	"tender_search",
+	"bid",
+	"bid_version",
+	"decision",
+	"award",
+	"feedback",
+	"saved_search",
+}
+
+type Table struct {
+	Name string          `json:"name"`
+	Rows json.RawMessage `json:"rows"`
+}
+
+type Dump struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Tables    []Table   `json:"tables"`
+}
+
+type ImportedTable struct {
+	Name     string
+	Inserted int
+}
@@ -12,3 +12,7 @@ type Feedback struct {
 	Username    string
 	CreatedAt   time.Time
 }
+
+func (f Feedback) SortValue(string) string {
+	return f.CreatedAt.Format(time.RFC3339Nano)
+}
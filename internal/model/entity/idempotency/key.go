@@ -0,0 +1,18 @@
+package idempotency
+
+import "time"
+
+type Key struct {
+	Key          string
+	Caller       string
+	RequestHash  string
+	StatusCode   *int
+	ContentType  *string
+	ResponseBody []byte
+	CreatedAt    time.Time
+	ExpiresAt    time.Time
+}
+
+func (k Key) Completed() bool {
+	return k.StatusCode != nil
+}
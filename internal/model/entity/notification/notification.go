@@ -0,0 +1,43 @@
+package notification
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type Type string
+
+const (
+	TenderAlert     Type = "TenderAlert"
+	TenderCancelled Type = "TenderCancelled"
+)
+
+type Status string
+
+const (
+	Pending    Status = "Pending"
+	Processing Status = "Processing"
+	Sent       Status = "Sent"
+	Failed     Status = "Failed"
+)
+
+type Notification struct {
+	Id            uuid.UUID
+	Type          Type
+	Recipient     string
+	DedupKey      string
+	Payload       []byte
+	Status        Status
+	Attempts      int
+	LastError     *string
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	SentAt        *time.Time
+}
+
+// ReplayFilter selects outbox rows to deliver again, empty fields do not restrict the selection
+type ReplayFilter struct {
+	Ids      []uuid.UUID
+	Statuses []Status
+	Since    *time.Time
+}
@@ -0,0 +1,7 @@
+package ratelimit
+
+type Bucket struct {
+	Key   string
+	Rate  float64
+	Burst int
+}
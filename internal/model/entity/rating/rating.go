@@ -0,0 +1,34 @@
+package rating
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// Rating scores a supplier on a bid, it is attached either to a feedback or to a completed contract
+type Rating struct {
+	Id            uuid.UUID
+	SupplierId    uuid.UUID
+	TenderId      uuid.UUID
+	BidId         uuid.UUID
+	FeedbackId    *uuid.UUID
+	ContractId    *uuid.UUID
+	Username      string
+	Quality       int
+	Timeliness    int
+	Communication int
+	CreatedAt     time.Time
+}
+
+func (r Rating) SortValue(string) string {
+	return r.CreatedAt.Format(time.RFC3339Nano)
+}
+
+// Reputation aggregates supplier ratings, averages are nil until the first rating
+type Reputation struct {
+	Count         int
+	Score         *float64
+	Quality       *float64
+	Timeliness    *float64
+	Communication *float64
+}
@@ -0,0 +1,15 @@
+package tender
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type Category struct {
+	Id          uuid.UUID
+	Code        string
+	Name        string
+	ParentId    *uuid.UUID
+	ServiceType *ServiceType
+	CreatedAt   time.Time
+}
@@ -0,0 +1,11 @@
+package tender
+
+import "github.com/google/uuid"
+
+type ListFilter struct {
+	ServiceTypes  []ServiceType
+	CategoryIds   []uuid.UUID
+	Username      string
+	OnlyPublished bool
+	Outcomes      []Outcome
+}
@@ -0,0 +1,19 @@
+package tender
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type SavedSearch struct {
+	Id              uuid.UUID
+	Name            string
+	Username        string
+	Query           string
+	ServiceTypes    []ServiceType
+	CategoryIds     []uuid.UUID
+	OrganizationIds []uuid.UUID
+	BudgetFrom      *float64
+	BudgetTo        *float64
+	CreatedAt       time.Time
+}
@@ -0,0 +1,23 @@
+package tender
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type SearchFilter struct {
+	Query          string
+	OrganizationId uuid.UUID
+	Statuses       []Status
+	CreatedFrom    time.Time
+	CreatedTo      time.Time
+	BudgetFrom     *float64
+	BudgetTo       *float64
+}
+
+type SearchResult struct {
+	Tender               Tender
+	Rank                 float64
+	NameHighlight        string
+	DescriptionHighlight string
+}
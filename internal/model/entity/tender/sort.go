@@ -0,0 +1,25 @@
+package tender
+
+import (
+	"strconv"
+	"time"
+)
+
+const (
+	SortByName      = "name"
+	SortByCreatedAt = "created_at"
+	SortByVersion   = "version"
+)
+
+var SortFields = []string{SortByName, SortByCreatedAt, SortByVersion}
+
+func (t Tender) SortValue(field string) string {
+	switch field {
+	case SortByName:
+		return t.Name
+	case SortByVersion:
+		return strconv.Itoa(t.Version)
+	default:
+		return t.CreatedAt.Format(time.RFC3339Nano)
+	}
+}
@@ -11,11 +11,26 @@ const (
 	Closed    Status = "Closed"
 	Created   Status = "Created"
 	Published Status = "Published"
+	Cancelled Status = "Cancelled"
 )
 
 func IsTenderStatus(status string) bool {
 	mapped := Status(status)
-	return mapped == Closed || mapped == Created || mapped == Published
+	return mapped == Closed || mapped == Created || mapped == Published || mapped == Cancelled
+}
+
+// Outcome tells how a finished tender ended, it is empty while the tender is still running
+type Outcome string
+
+const (
+	OutcomeAwarded            Outcome = "Awarded"
+	OutcomeClosedWithoutAward Outcome = "ClosedWithoutAward"
+	OutcomeCancelled          Outcome = "Cancelled"
+)
+
+func IsOutcome(outcome string) bool {
+	mapped := Outcome(outcome)
+	return mapped == OutcomeAwarded || mapped == OutcomeClosedWithoutAward || mapped == OutcomeCancelled
 }
 
 type ServiceType string
@@ -37,8 +52,40 @@ type Tender struct {
 	Description     string
 	Status          Status
 	ServiceType     ServiceType
+	CategoryIds     []uuid.UUID
+	Budget          *float64
 	Version         int
 	CreatedAt       time.Time
 	OrganizationId  uuid.UUID
 	CreatorUsername string
+	Policy          Policy
+	Cancellation    *Cancellation
+	Facts           Facts
+}
+
+type Cancellation struct {
+	Reason      string
+	CancelledAt time.Time
+}
+
+func (t Tender) Outcome() Outcome {
+	switch {
+	case t.Status == Cancelled:
+		return OutcomeCancelled
+	case t.Status == Closed && t.Facts.ApprovedBid:
+		return OutcomeAwarded
+	case t.Status == Closed:
+		return OutcomeClosedWithoutAward
+	}
+	return ""
+}
+
+// Policy holds the rules bidders of the tender follow, bids are accepted until BidDeadline when it is set
+type Policy struct {
+	BidDeadline         *time.Time
+	AllowLateWithdrawal bool
+}
+
+func (p Policy) DeadlinePassed(now time.Time) bool {
+	return p.BidDeadline != nil && now.After(*p.BidDeadline)
 }
@@ -0,0 +1,91 @@
+package tender
+
+import (
+	"tender-service/internal/i18n"
+	"time"
+)
+
+// Facts are read together with the tender, transition guards depend on them
+type Facts struct {
+	PendingVotes bool
+	ApprovedBid  bool
+}
+
+type transition struct {
+	to    Status
+	guard func(t Tender, now time.Time) error
+}
+
+var transitions = map[Status][]transition{
+	Created: {
+		{to: Published, guard: deadlineAhead},
+		{to: Closed},
+		{to: Cancelled},
+	},
+	Published: {
+		{to: Created, guard: noVotes},
+		{to: Closed, guard: noPendingVotes},
+		{to: Cancelled},
+	},
+	Closed:    {},
+	Cancelled: {},
+}
+
+var (
+	errDeadlineRequired = i18n.NewError(i18n.TenderPublishWithoutDeadline)
+	errPendingVotes     = i18n.NewError(i18n.TenderPendingVotes)
+)
+
+func deadlineAhead(t Tender, now time.Time) error {
+	if t.Policy.BidDeadline == nil || t.Policy.DeadlinePassed(now) {
+		return errDeadlineRequired
+	}
+	return nil
+}
+
+func noVotes(t Tender, _ time.Time) error {
+	if t.Facts.PendingVotes || t.Facts.ApprovedBid {
+		return errPendingVotes
+	}
+	return nil
+}
+
+// noPendingVotes lets a tender with an approved bid close even if votes on other bids are still open
+func noPendingVotes(t Tender, _ time.Time) error {
+	if t.Facts.PendingVotes && !t.Facts.ApprovedBid {
+		return errPendingVotes
+	}
+	return nil
+}
+
+// CheckTransition returns why the tender cannot move to status, staying in the current status is always allowed
+func (t Tender) CheckTransition(to Status, now time.Time) error {
+	if to == t.Status {
+		return nil
+	}
+
+	for _, tr := range transitions[t.Status] {
+		if tr.to != to {
+			continue
+		}
+		if tr.guard == nil {
+			return nil
+		}
+		return tr.guard(t, now)
+	}
+
+	return i18n.NewError(i18n.TenderTransitionNotAllowed, t.Status, to)
+}
+
+// AllowedTransitions lists the statuses the tender can move to right now
+func (t Tender) AllowedTransitions(now time.Time) []Status {
+	allowed := make([]Status, 0, len(transitions[t.Status]))
+
+	for _, tr := range transitions[t.Status] {
+		if tr.guard == nil || tr.guard(t, now) == nil {
+			allowed = append(allowed, tr.to)
+		}
+	}
+
+	return allowed
+}
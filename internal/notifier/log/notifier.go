@@ -0,0 +1,24 @@
+package log
+
+import (
+	"context"
+	"log/slog"
+	"tender-service/internal/model/entity/notification"
+)
+
+type notifier struct {
+}
+
+func NewLogNotifier() *notifier {
+	return &notifier{}
+}
+
+func (n *notifier) Notify(ctx context.Context, notif notification.Notification) error {
+	slog.InfoContext(ctx, "notification",
+		slog.String("id", notif.Id.String()),
+		slog.String("type", string(notif.Type)),
+		slog.String("recipient", notif.Recipient),
+		slog.String("payload", string(notif.Payload)),
+	)
+	return nil
+}
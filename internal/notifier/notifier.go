@@ -0,0 +1,10 @@
+package notifier
+
+import (
+	"context"
+	"tender-service/internal/model/entity/notification"
+)
+
+type Notifier interface {
+	Notify(ctx context.Context, n notification.Notification) error
+}
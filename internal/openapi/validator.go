@@ -0,0 +1,107 @@
+package openapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"io"
+	"net/http"
+)
+
+// Validator checks requests and responses of the REST API against docs/openapi.yml,
+// paths are matched without the /api prefix
+type Validator struct {
+	router routers.Router
+}
+
+func NewValidator(path string) (*Validator, error) {
+	loader := openapi3.NewLoader()
+
+	doc, err := loader.LoadFromFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load openapi document: %w", err)
+	}
+
+	// servers hold the public url with the /api prefix, which is already stripped when the validator runs
+	doc.Servers = nil
+
+	router, err := legacy.NewRouter(doc)
+	if err != nil {
+		return nil, fmt.Errorf("incorrect openapi document: %w", err)
+	}
+
+	return &Validator{router: router}, nil
+}
+
+// ValidateRequest returns *ValidationError when the request violates the document, the returned input
+// is nil for routes the document does not describe, such requests are left to the handlers
+func (v *Validator) ValidateRequest(r *http.Request) (*openapi3filter.RequestValidationInput, error) {
+	route, pathParams, err := v.router.FindRoute(r)
+	if err != nil {
+		return nil, nil
+	}
+
+	input := &openapi3filter.RequestValidationInput{
+		Request:    r,
+		PathParams: pathParams,
+		Route:      route,
+		Options: &openapi3filter.Options{
+			MultiError:          true,
+			SkipSettingDefaults: true,
+			AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+		},
+	}
+
+	if err = openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+		if violations := requestViolations(err); len(violations) > 0 {
+			return input, &ValidationError{Violations: violations}
+		}
+	}
+
+	return input, nil
+}
+
+// ValidateResponse checks a documented response, status codes missing from the document are not reported
+func (v *Validator) ValidateResponse(ctx context.Context, input *openapi3filter.RequestValidationInput, status int, header http.Header, body []byte) error {
+	err := openapi3filter.ValidateResponse(ctx, &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: input,
+		Status:                 status,
+		Header:                 header,
+		Body:                   io.NopCloser(bytes.NewReader(body)),
+		Options:                &openapi3filter.Options{MultiError: true},
+	})
+	if err != nil {
+		return fmt.Errorf("%s %s responded %d not matching openapi: %w", input.Request.Method, input.Route.Path, status, err)
+	}
+	return nil
+}
+
+func requestViolations(err error) []Violation {
+	// wrapped errors are not unwrapped here, request errors wrap multi errors of their own
+	if multiErr, ok := err.(openapi3.MultiError); ok {
+		var violations []Violation
+		for _, e := range multiErr {
+			violations = append(violations, requestViolations(e)...)
+		}
+		return violations
+	}
+
+	var reqErr *openapi3filter.RequestError
+	if !errors.As(err, &reqErr) {
+		return nil
+	}
+
+	switch {
+	case reqErr.Parameter != nil:
+		return parameterViolations(reqErr.Parameter, reqErr.Err)
+	case reqErr.RequestBody != nil:
+		return bodyViolations(reqErr.Err)
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package openapi
+
+import (
+	"errors"
+	"fmt"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"strconv"
+	"strings"
+)
+
+// Rules use the same names as validator tags, so clients see one vocabulary for field errors
+const (
+	RuleRequired = "required"
+	RuleMax      = "max"
+	RuleMin      = "min"
+	RuleGte      = "gte"
+	RuleLte      = "lte"
+	RuleOneOf    = "oneof"
+	RuleType     = "type"
+	RuleFormat   = "format"
+
+	bodyField = "body"
+)
+
+type Violation struct {
+	Field string
+	Rule  string
+	Param string
+}
+
+type ValidationError struct {
+	Violations []Violation
+}
+
+func (e *ValidationError) Error() string {
+	parts := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		parts[i] = fmt.Sprintf("%s: %s %s", v.Field, v.Rule, v.Param)
+	}
+	return "request does not match openapi: " + strings.Join(parts, "; ")
+}
+
+func parameterViolations(parameter *openapi3.Parameter, err error) []Violation {
+	if errors.Is(err, openapi3filter.ErrInvalidRequired) || errors.Is(err, openapi3filter.ErrInvalidEmptyValue) {
+		return []Violation{{Field: parameter.Name, Rule: RuleRequired}}
+	}
+
+	var parseErr *openapi3filter.ParseError
+	if errors.As(err, &parseErr) {
+		var schema *openapi3.Schema
+		if parameter.Schema != nil {
+			schema = parameter.Schema.Value
+		}
+		return []Violation{{Field: parameter.Name, Rule: RuleType, Param: schemaType(schema)}}
+	}
+
+	violations := schemaViolations(err)
+	for i := range violations {
+		violations[i].Field = joinField(parameter.Name, violations[i].Field)
+	}
+	return violations
+}
+
+// bodyViolations skips bodies that are not valid json, handlers answer them with their own error
+func bodyViolations(err error) []Violation {
+	if errors.Is(err, openapi3filter.ErrInvalidRequired) {
+		return []Violation{{Field: bodyField, Rule: RuleRequired}}
+	}
+
+	var parseErr *openapi3filter.ParseError
+	if errors.As(err, &parseErr) {
+		return nil
+	}
+
+	return schemaViolations(err)
+}
+
+func schemaViolations(err error) []Violation {
+	// wrapped errors are not unwrapped here, request errors wrap multi errors of their own
+	if multiErr, ok := err.(openapi3.MultiError); ok {
+		var violations []Violation
+		for _, e := range multiErr {
+			violations = append(violations, schemaViolations(e)...)
+		}
+		return violations
+	}
+
+	var schemaErr *openapi3.SchemaError
+	if !errors.As(err, &schemaErr) {
+		return nil
+	}
+
+	rule, param := schemaRule(schemaErr)
+	return []Violation{{Field: fieldPath(schemaErr.JSONPointer()), Rule: rule, Param: param}}
+}
+
+func schemaRule(schemaErr *openapi3.SchemaError) (string, string) {
+	schema := schemaErr.Schema
+
+	switch schemaErr.SchemaField {
+	case "required":
+		return RuleRequired, ""
+	case "maxLength":
+		return RuleMax, formatUint(schema.MaxLength)
+	case "maxItems":
+		return RuleMax, formatUint(schema.MaxItems)
+	case "minLength":
+		return RuleMin, strconv.FormatUint(schema.MinLength, 10)
+	case "minItems":
+		return RuleMin, strconv.FormatUint(schema.MinItems, 10)
+	case "minimum":
+		return RuleGte, formatFloat(schema.Min)
+	case "maximum":
+		return RuleLte, formatFloat(schema.Max)
+	case "enum":
+		values := make([]string, len(schema.Enum))
+		for i, value := range schema.Enum {
+			values[i] = fmt.Sprint(value)
+		}
+		return RuleOneOf, strings.Join(values, " ")
+	case "type":
+		return RuleType, schemaType(schema)
+	case "format":
+		return RuleFormat, schema.Format
+	default:
+		return schemaErr.SchemaField, ""
+	}
+}
+
+func schemaType(schema *openapi3.Schema) string {
+	if schema == nil || schema.Type == nil {
+		return ""
+	}
+	return strings.Join(schema.Type.Slice(), ",")
+}
+
+// fieldPath renders a json pointer the way validator namespaces look, e.g. categoryIds[0].name
+func fieldPath(pointer []string) string {
+	var path strings.Builder
+	for _, part := range pointer {
+		if _, err := strconv.Atoi(part); err == nil {
+			path.WriteString("[" + part + "]")
+			continue
+		}
+		if path.Len() > 0 {
+			path.WriteString(".")
+		}
+		path.WriteString(part)
+	}
+	return path.String()
+}
+
+func joinField(parent, field string) string {
+	if field == "" || strings.HasPrefix(field, "[") {
+		return parent + field
+	}
+	return parent + "." + field
+}
+
+func formatUint(value *uint64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatUint(*value, 10)
+}
+
+func formatFloat(value *float64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*value, 'f', -1, 64)
+}
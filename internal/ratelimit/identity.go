@@ -0,0 +1,118 @@
+package ratelimit
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sync"
+	"tender-service/internal/service"
+	"time"
+)
+
+const (
+	identityCacheTTL  = time.Minute
+	identityCacheSize = 10000
+)
+
+type Subject struct {
+	Username        string
+	EmployeeId      uuid.UUID
+	OrganizationIds []uuid.UUID
+}
+
+type cachedIdentity struct {
+	username        string
+	organizationIds []uuid.UUID
+	expiresAt       time.Time
+}
+
+type identityResolver struct {
+	employeeService     service.EmployeeService
+	organizationService service.OrganizationService
+
+	mu           sync.Mutex
+	byUsername   map[string]cachedIdentity
+	byEmployeeId map[uuid.UUID]cachedIdentity
+}
+
+func NewIdentityResolver(employeeService service.EmployeeService, organizationService service.OrganizationService) *identityResolver {
+	return &identityResolver{
+		employeeService:     employeeService,
+		organizationService: organizationService,
+		byUsername:          make(map[string]cachedIdentity),
+		byEmployeeId:        make(map[uuid.UUID]cachedIdentity),
+	}
+}
+
+// Resolve fills the username and organizations of the subject from the employee claimed by the request
+func (r *identityResolver) Resolve(ctx context.Context, subject Subject) (Subject, error) {
+	if subject.Username == "" && subject.EmployeeId != uuid.Nil {
+		username, err := r.username(ctx, subject.EmployeeId)
+		if err != nil {
+			return subject, err
+		}
+		subject.Username = username
+	}
+
+	if subject.Username == "" {
+		return subject, nil
+	}
+
+	organizationIds, err := r.organizationIds(ctx, subject.Username)
+	if err != nil {
+		return subject, err
+	}
+
+	subject.OrganizationIds = organizationIds
+	return subject, nil
+}
+
+func (r *identityResolver) username(ctx context.Context, employeeId uuid.UUID) (string, error) {
+	if cached, ok := load(&r.mu, r.byEmployeeId, employeeId); ok {
+		return cached.username, nil
+	}
+
+	employee, err := r.employeeService.GetEmployeeByUsernameById(ctx, employeeId)
+	if err != nil {
+		return "", err
+	}
+
+	store(&r.mu, r.byEmployeeId, employeeId, cachedIdentity{username: employee.Username})
+	return employee.Username, nil
+}
+
+func (r *identityResolver) organizationIds(ctx context.Context, username string) ([]uuid.UUID, error) {
+	if cached, ok := load(&r.mu, r.byUsername, username); ok {
+		return cached.organizationIds, nil
+	}
+
+	organizationIds, err := r.organizationService.GetEmployeeOrganizationIds(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	store(&r.mu, r.byUsername, username, cachedIdentity{username: username, organizationIds: organizationIds})
+	return organizationIds, nil
+}
+
+func load[K comparable](mu *sync.Mutex, cache map[K]cachedIdentity, key K) (cachedIdentity, bool) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	cached, ok := cache[key]
+	if !ok || time.Now().After(cached.expiresAt) {
+		return cachedIdentity{}, false
+	}
+	return cached, true
+}
+
+func store[K comparable](mu *sync.Mutex, cache map[K]cachedIdentity, key K, identity cachedIdentity) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(cache) >= identityCacheSize {
+		clear(cache)
+	}
+
+	identity.expiresAt = time.Now().Add(identityCacheTTL)
+	cache[key] = identity
+}
@@ -0,0 +1,78 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+const sweepInterval = time.Minute
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+type memoryLimiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+func NewMemoryLimiter() *memoryLimiter {
+	return &memoryLimiter{
+		buckets:   make(map[string]*bucket),
+		lastSweep: time.Now(),
+	}
+}
+
+func (l *memoryLimiter) Allow(_ context.Context, buckets []Bucket) (bool, time.Duration, error) {
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+
+	refilled := make([]*bucket, len(buckets))
+	var retryAfter time.Duration
+	for i, requested := range buckets {
+		b, ok := l.buckets[requested.Key]
+		if !ok {
+			b = &bucket{tokens: float64(requested.Limit.Burst), updatedAt: now}
+			l.buckets[requested.Key] = b
+		}
+
+		b.tokens = math.Min(float64(requested.Limit.Burst), b.tokens+now.Sub(b.updatedAt).Seconds()*requested.Limit.Rate)
+		b.updatedAt = now
+		refilled[i] = b
+
+		if b.tokens < 1 {
+			retryAfter = max(retryAfter, requested.Limit.retryAfter(b.tokens))
+		}
+	}
+
+	if retryAfter > 0 {
+		return false, retryAfter, nil
+	}
+
+	for _, b := range refilled {
+		b.tokens--
+	}
+	return true, 0, nil
+}
+
+// sweep drops buckets that have not been touched for a while, they would be full by now anyway
+func (l *memoryLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+
+	for key, b := range l.buckets {
+		if now.Sub(b.updatedAt) > StaleBucketAge {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastSweep = now
+}
@@ -0,0 +1,42 @@
+package ratelimit
+
+import (
+	"context"
+	ratelimit2 "tender-service/internal/model/entity/ratelimit"
+	"tender-service/internal/repository"
+	"time"
+)
+
+type postgresLimiter struct {
+	rateLimitRepository repository.RateLimitRepository
+}
+
+func NewPostgresLimiter(rateLimitRepository repository.RateLimitRepository) *postgresLimiter {
+	return &postgresLimiter{
+		rateLimitRepository: rateLimitRepository,
+	}
+}
+
+func (l *postgresLimiter) Allow(ctx context.Context, buckets []Bucket) (bool, time.Duration, error) {
+	requested := make([]ratelimit2.Bucket, len(buckets))
+	for i, b := range buckets {
+		requested[i] = ratelimit2.Bucket{Key: b.Key, Rate: b.Limit.Rate, Burst: b.Limit.Burst}
+	}
+
+	allowed, tokens, err := l.rateLimitRepository.TakeTokens(ctx, requested)
+	if err != nil {
+		return false, 0, err
+	}
+
+	if allowed {
+		return true, 0, nil
+	}
+
+	var retryAfter time.Duration
+	for i, b := range buckets {
+		if tokens[i] < 1 {
+			retryAfter = max(retryAfter, b.Limit.retryAfter(tokens[i]))
+		}
+	}
+	return false, retryAfter, nil
+}
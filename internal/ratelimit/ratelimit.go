@@ -0,0 +1,125 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"tender-service/internal/config"
+	"time"
+)
+
+type Class string
+
+const (
+	Read     Class = "read"
+	Write    Class = "write"
+	Decision Class = "decision"
+)
+
+type Scope string
+
+const (
+	Employee     Scope = "employee"
+	Organization Scope = "organization"
+	Ip           Scope = "ip"
+)
+
+const StaleBucketAge = time.Hour
+
+const (
+	MemoryBackend   = "memory"
+	PostgresBackend = "postgres"
+)
+
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+func (l Limit) Enabled() bool {
+	return l.Rate > 0 && l.Burst > 0
+}
+
+func (l Limit) retryAfter(tokens float64) time.Duration {
+	return time.Duration(math.Ceil((1-tokens)/l.Rate*1000)) * time.Millisecond
+}
+
+type Limits map[Class]map[Scope]Limit
+
+type Bucket struct {
+	Key   string
+	Limit Limit
+}
+
+// Limiter takes a token from every bucket only when all of them allow the request
+type Limiter interface {
+	Allow(ctx context.Context, buckets []Bucket) (bool, time.Duration, error)
+}
+
+var (
+	errIncorrectLimit   = fmt.Errorf("rate limit must look like <requests>/<s|m|h>")
+	errIncorrectBackend = fmt.Errorf("rate limit backend must be one of: memory, postgres")
+)
+
+func NewLimits(cfg config.RateLimitConfig) (Limits, error) {
+	raw := map[Class]map[Scope]string{
+		Read:     {Employee: cfg.ReadEmployee, Organization: cfg.ReadOrganization, Ip: cfg.ReadIp},
+		Write:    {Employee: cfg.WriteEmployee, Organization: cfg.WriteOrganization, Ip: cfg.WriteIp},
+		Decision: {Employee: cfg.DecisionEmployee, Organization: cfg.DecisionOrganization, Ip: cfg.DecisionIp},
+	}
+
+	limits := make(Limits, len(raw))
+	for class, scopes := range raw {
+		limits[class] = make(map[Scope]Limit, len(scopes))
+		for scope, value := range scopes {
+			limit, err := parseLimit(value)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %w", class, scope, err)
+			}
+			limits[class][scope] = limit
+		}
+	}
+
+	return limits, nil
+}
+
+func ValidateBackend(backend string) error {
+	if backend != MemoryBackend && backend != PostgresBackend {
+		return errIncorrectBackend
+	}
+	return nil
+}
+
+// parseLimit accepts limits like "60/m": a bucket of 60 tokens refilled at 60 tokens per minute
+func parseLimit(value string) (Limit, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || value == "0" {
+		return Limit{}, nil
+	}
+
+	count, period, ok := strings.Cut(value, "/")
+	if !ok {
+		return Limit{}, errIncorrectLimit
+	}
+
+	requests, err := strconv.Atoi(count)
+	if err != nil || requests < 0 {
+		return Limit{}, errIncorrectLimit
+	}
+
+	var interval time.Duration
+	switch period {
+	case "s":
+		interval = time.Second
+	case "m":
+		interval = time.Minute
+	case "h":
+		interval = time.Hour
+	default:
+		return Limit{}, errIncorrectLimit
+	}
+
+	return Limit{Rate: float64(requests) / interval.Seconds(), Burst: requests}, nil
+}
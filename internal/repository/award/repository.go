@@ -0,0 +1,167 @@
+package award
+
+import (
+	"context"
+	"errors"
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"tender-service/internal/i18n"
+	model2 "tender-service/internal/model"
+	"tender-service/internal/model/entity/award"
+	"tender-service/internal/model/entity/bid"
+	"tender-service/internal/model/entity/tender"
+)
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+const (
+	tableName            = "award"
+	bidTableName         = "bid"
+	tenderTableName      = "tender"
+	idColumnName         = "id"
+	tenderIdColumnName   = "tender_id"
+	bidIdColumnName      = "bid_id"
+	bidVersionColumnName = "bid_version"
+	amountColumnName     = "amount"
+	approversColumnName  = "approvers"
+	decisionColumnName   = "decision"
+	statusColumnName     = "status"
+	returningAllSuffix   = "RETURNING *"
+	tenderOfBid          = "id = (SELECT bid.tender_id FROM bid WHERE bid.id = ?)"
+	awardSelect          = "bid.tender_id, bid.id, bid_version.version, bid_version.price, " +
+		"ARRAY(SELECT decision.username FROM decision WHERE decision.bid_id = bid.id AND decision.verdict = 'Approved' ORDER BY decision.username)"
+)
+
+var (
+	errAwardNotFound      = i18n.NewError(i18n.TenderAwardNotFound)
+	errTenderNotPublished = i18n.NewError(i18n.BidTenderClosed)
+)
+
+func NewAwardRepository(pool *pgxpool.Pool) *repository {
+	return &repository{pool: pool}
+}
+
+// AwardBid closes the published tender of the bid, approves the bid, records the award for its current version
+// and marks the other open bids of the tender lost, all in one transaction
+func (r *repository) AwardBid(ctx context.Context, bidId uuid.UUID) (award.Award, error) {
+	op := "award_repository.award_bid"
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return award.Award{}, err
+	}
+
+	defer tx.Rollback(ctx)
+
+	closeBuilder := squirrel.Update(tenderTableName).PlaceholderFormat(squirrel.Dollar).
+		Set(statusColumnName, tender.Closed).
+		Where(tenderOfBid, bidId.String()).
+		Where(squirrel.Eq{statusColumnName: tender.Published})
+
+	sql, args, err := closeBuilder.ToSql()
+	if err != nil {
+		return award.Award{}, err
+	}
+
+	closed, err := tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return award.Award{}, err
+	}
+	if closed.RowsAffected() == 0 {
+		return award.Award{}, model2.NewConflictError(op, errTenderNotPublished)
+	}
+
+	approveBuilder := squirrel.Update(bidTableName).PlaceholderFormat(squirrel.Dollar).
+		Set(decisionColumnName, bid.Approved).
+		Where(squirrel.Eq{idColumnName: bidId.String()})
+
+	sql, args, err = approveBuilder.ToSql()
+	if err != nil {
+		return award.Award{}, err
+	}
+
+	if _, err = tx.Exec(ctx, sql, args...); err != nil {
+		return award.Award{}, err
+	}
+
+	winner := squirrel.Select(awardSelect).
+		From(bidTableName).
+		Join("bid_version ON bid.bid_version_id = bid_version.id").
+		Where(squirrel.Eq{"bid.id": bidId.String()})
+
+	insertBuilder := squirrel.Insert(tableName).PlaceholderFormat(squirrel.Dollar).
+		Columns(tenderIdColumnName, bidIdColumnName, bidVersionColumnName, amountColumnName, approversColumnName).
+		Select(winner).
+		Suffix(returningAllSuffix)
+
+	sql, args, err = insertBuilder.ToSql()
+	if err != nil {
+		return award.Award{}, err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return award.Award{}, err
+	}
+
+	saved, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[award.Award])
+	if err != nil {
+		return award.Award{}, err
+	}
+
+	loseBuilder := squirrel.Update(bidTableName).PlaceholderFormat(squirrel.Dollar).
+		Set(decisionColumnName, bid.Lost).
+		Where(squirrel.Eq{
+			tenderIdColumnName: saved.TenderId.String(),
+			decisionColumnName: bid.None,
+			statusColumnName:   []bid.Status{bid.Created, bid.Published},
+		}).
+		Where(squirrel.NotEq{idColumnName: bidId.String()})
+
+	sql, args, err = loseBuilder.ToSql()
+	if err != nil {
+		return award.Award{}, err
+	}
+
+	if _, err = tx.Exec(ctx, sql, args...); err != nil {
+		return award.Award{}, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return award.Award{}, err
+	}
+
+	return saved, nil
+}
+
+func (r *repository) GetAwardByTenderId(ctx context.Context, tenderId uuid.UUID) (award.Award, error) {
+	op := "award_repository.get_by_tender_id"
+
+	builder := squirrel.Select("*").PlaceholderFormat(squirrel.Dollar).
+		From(tableName).
+		Where(squirrel.Eq{tenderIdColumnName: tenderId.String()})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return award.Award{}, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return award.Award{}, err
+	}
+
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[award.Award])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return award.Award{}, model2.NewNotFoundError(op, errAwardNotFound)
+		}
+		return award.Award{}, err
+	}
+
+	return result, nil
+}
@@ -7,14 +7,16 @@ import (
 )
 
 type Bid struct {
-	Id           uuid.UUID
-	Status       string
-	Decision     bid.Decision
-	TenderId     uuid.UUID
-	AuthorType   string
-	BidVersionId uuid.UUID
-	AuthorId     uuid.UUID
-	CreatedAt    time.Time
+	Id               uuid.UUID
+	Status           string
+	Decision         bid.Decision
+	TenderId         uuid.UUID
+	AuthorType       string
+	BidVersionId     uuid.UUID
+	AuthorId         uuid.UUID
+	CreatedAt        time.Time
+	WithdrawalReason *string
+	WithdrawnAt      *time.Time
 }
 
 type BidVersion struct {
@@ -23,34 +25,25 @@ type BidVersion struct {
 	Name        string
 	Description string
 	Version     int
+	Price       *float64
 }
 
 type BidSum struct {
-	Id          uuid.UUID
-	Name        string
-	Description string
-	Decision    bid.Decision
-	Version     int
-	Status      string
-	TenderId    uuid.UUID
-	AuthorType  string
-	AuthorId    uuid.UUID
-	CreatedAt   time.Time
-}
-
-func MergeBidAndVersionToBid(v BidVersion, b Bid) bid.Bid {
-	return bid.Bid{
-		Id:          b.Id,
-		Name:        v.Name,
-		Description: v.Description,
-		Decision:    b.Decision,
-		Status:      bid.Status(b.Status),
-		TenderId:    b.TenderId,
-		AuthorType:  bid.AuthorType(b.AuthorType),
-		AuthorId:    b.AuthorId,
-		Version:     v.Version,
-		CreatedAt:   b.CreatedAt,
-	}
+	Id               uuid.UUID
+	Name             string
+	Description      string
+	Price            *float64
+	Decision         bid.Decision
+	Version          int
+	Status           string
+	TenderId         uuid.UUID
+	AuthorType       string
+	AuthorId         uuid.UUID
+	CreatedAt        time.Time
+	WithdrawalReason *string
+	WithdrawnAt      *time.Time
+	HasVotes         bool
+	TenderOpen       bool
 }
 
 func BidSumToBid(sum BidSum) bid.Bid {
@@ -59,13 +52,31 @@ func BidSumToBid(sum BidSum) bid.Bid {
 		Name:        sum.Name,
 		Decision:    sum.Decision,
 		Description: sum.Description,
+		Price:       sum.Price,
 		Status:      bid.Status(sum.Status),
 		TenderId:    sum.TenderId,
 		AuthorType:  bid.AuthorType(sum.AuthorType),
 		AuthorId:    sum.AuthorId,
 		Version:     sum.Version,
 		CreatedAt:   sum.CreatedAt,
+		Withdrawal:  toWithdrawal(sum.WithdrawalReason, sum.WithdrawnAt),
+		Facts: bid.Facts{
+			HasVotes:   sum.HasVotes,
+			TenderOpen: sum.TenderOpen,
+		},
+	}
+}
+
+func toWithdrawal(reason *string, withdrawnAt *time.Time) *bid.Withdrawal {
+	if withdrawnAt == nil {
+		return nil
+	}
+
+	withdrawal := &bid.Withdrawal{WithdrawnAt: *withdrawnAt}
+	if reason != nil {
+		withdrawal.Reason = *reason
 	}
+	return withdrawal
 }
 
 func BidSumListToBidList(list []BidSum) []bid.Bid {
@@ -2,15 +2,14 @@ package bid
 
 import (
 	"context"
-	"fmt"
 	"github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
-	"log"
 	model2 "tender-service/internal/model"
 	"tender-service/internal/model/entity/bid"
 	"tender-service/internal/repository/bid/model"
+	"tender-service/internal/repository/keyset"
 	"tender-service/internal/util"
 )
 
@@ -25,6 +24,7 @@ const (
 	bidIdColumnName        = "bid_id"
 	nameColumnName         = "name"
 	descriptionColumnName  = "description"
+	priceColumnName        = "price"
 	statusColumnName       = "status"
 	tenderIdColumnName     = "tender_id"
 	authorTypeColumnName   = "author_type"
@@ -32,11 +32,24 @@ const (
 	versionColumnName      = "version"
 	bidVersionIdColumnName = "bid_version_id"
 	decisionColumnName     = "decision"
+	reasonColumnName       = "withdrawal_reason"
+	withdrawnAtColumnName  = "withdrawn_at"
+	decisionTableName      = "decision"
 	returningAllSuffix     = "RETURNING *"
 	bidAndVersionJoin      = "bid_version ON bid.bid_version_id = bid_version.id"
-	selectBidSum           = "bid.id, bid_version.name, bid_version.description, bid.status, bid.tender_id, bid.author_type, bid.author_id, bid_version.version, bid.created_at, bid.decision"
+	selectBidSum           = "bid.id, bid_version.name, bid_version.description, bid_version.price, bid.status, bid.tender_id, bid.author_type, bid.author_id, bid_version.version, bid.created_at, bid.decision, " +
+		"bid.withdrawal_reason, bid.withdrawn_at, " +
+		"EXISTS(SELECT 1 FROM decision WHERE decision.bid_id = bid.id) AS has_votes, " +
+		"EXISTS(SELECT 1 FROM tender WHERE tender.id = bid.tender_id AND tender.status = 'Published' AND (tender.bid_deadline IS NULL OR tender.bid_deadline > NOW())) AS tender_open"
 )
 
+var sortColumns = map[string]keyset.Column{
+	bid.SortByName:      {Name: "bid_version.name", Type: "varchar"},
+	bid.SortByCreatedAt: {Name: "bid.created_at", Type: "timestamptz"},
+	bid.SortByVersion:   {Name: "bid_version.version", Type: "int"},
+	bid.SortByPrice:     {Name: "COALESCE(bid_version.price, -1)", Type: "numeric"},
+}
+
 func NewBidRepository(pool *pgxpool.Pool) *repository {
 	return &repository{pool: pool}
 }
@@ -66,13 +79,12 @@ func (r *repository) SaveBid(ctx context.Context, b bid.Bid) (bid.Bid, error) {
 
 	savedBid, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[model.Bid])
 	if err != nil {
-		fmt.Println("x1")
 		return bid.Bid{}, err
 	}
 
 	versionBuilder := squirrel.Insert(versionTableName).PlaceholderFormat(squirrel.Dollar).
-		Columns(bidIdColumnName, nameColumnName, descriptionColumnName, versionColumnName).
-		Values(savedBid.Id.String(), b.Name, b.Description, 1).
+		Columns(bidIdColumnName, nameColumnName, descriptionColumnName, priceColumnName, versionColumnName).
+		Values(savedBid.Id.String(), b.Name, b.Description, b.Price, 1).
 		Suffix(returningAllSuffix)
 
 	sql, args, err = versionBuilder.ToSql()
@@ -111,7 +123,7 @@ func (r *repository) SaveBid(ctx context.Context, b bid.Bid) (bid.Bid, error) {
 		return bid.Bid{}, err
 	}
 
-	return model.MergeBidAndVersionToBid(savedVersion, savedBid), nil
+	return r.GetBidById(ctx, savedBid.Id)
 }
 
 func (r *repository) GetBidById(ctx context.Context, id uuid.UUID) (bid.Bid, error) {
@@ -141,25 +153,21 @@ func (r *repository) GetBidById(ctx context.Context, id uuid.UUID) (bid.Bid, err
 
 func (r *repository) GetBidList(ctx context.Context, page util.Page, tenderId uuid.UUID, userId uuid.UUID) ([]bid.Bid, error) {
 	builder := squirrel.Select(selectBidSum).PlaceholderFormat(squirrel.Dollar).
-		From(bidTableName).Join(bidAndVersionJoin).Offset(uint64(page.Offset)).Limit(uint64(page.Limit))
-
-	if tenderId != uuid.Nil {
-		builder = builder.Where(squirrel.Eq{tenderIdColumnName: tenderId.String()})
-	}
+		From(bidTableName).Join(bidAndVersionJoin)
 
-	log.Println(userId.String())
+	builder = applyBidListFilter(builder, tenderId, userId)
 
-	if userId != uuid.Nil {
-		builder = builder.Where(squirrel.Eq{"bid" + "." + AuthorIdColumnName: userId.String()})
+	builder, err := keyset.Apply(builder, page, sortColumns, bidTableName+"."+idColumnName)
+	if err != nil {
+		return nil, err
 	}
+	builder = builder.Offset(uint64(page.Offset)).Limit(uint64(page.Limit))
 
 	sql, args, err := builder.ToSql()
 	if err != nil {
 		return nil, err
 	}
 
-	log.Println("sql:" + sql)
-
 	rows, err := r.pool.Query(ctx, sql, args...)
 	if err != nil {
 		return nil, err
@@ -173,6 +181,37 @@ func (r *repository) GetBidList(ctx context.Context, page util.Page, tenderId uu
 	return model.BidSumListToBidList(sums), nil
 }
 
+func (r *repository) CountBidList(ctx context.Context, tenderId uuid.UUID, userId uuid.UUID) (int, error) {
+	builder := squirrel.Select("COUNT(*)").PlaceholderFormat(squirrel.Dollar).
+		From(bidTableName).Join(bidAndVersionJoin)
+
+	builder = applyBidListFilter(builder, tenderId, userId)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	if err = r.pool.QueryRow(ctx, sql, args...).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func applyBidListFilter(builder squirrel.SelectBuilder, tenderId uuid.UUID, userId uuid.UUID) squirrel.SelectBuilder {
+	if tenderId != uuid.Nil {
+		builder = builder.Where(squirrel.Eq{tenderIdColumnName: tenderId.String()})
+	}
+
+	if userId != uuid.Nil {
+		builder = builder.Where(squirrel.Eq{bidTableName + "." + AuthorIdColumnName: userId.String()})
+	}
+
+	return builder
+}
+
 func (r *repository) UpdateBidDecision(ctx context.Context, id uuid.UUID, dec bid.Decision) (bid.Bid, error) {
 	updateBuilder := squirrel.Update(bidTableName).PlaceholderFormat(squirrel.Dollar).Set(decisionColumnName, dec).
 		Where(squirrel.Eq{idColumnName: id.String()})
@@ -182,8 +221,6 @@ func (r *repository) UpdateBidDecision(ctx context.Context, id uuid.UUID, dec bi
 		return bid.Bid{}, err
 	}
 
-	log.Println("sql:" + sql)
-
 	rows, err := r.pool.Query(ctx, sql, args...)
 	if err != nil {
 		return bid.Bid{}, err
@@ -200,12 +237,9 @@ func (r *repository) UpdateBidStatus(ctx context.Context, id uuid.UUID, stat bid
 
 	sql, args, err := updateBuilder.ToSql()
 	if err != nil {
-		fmt.Println()
 		return bid.Bid{}, err
 	}
 
-	log.Println("sql:" + sql)
-
 	rows, err := r.pool.Query(ctx, sql, args...)
 	if err != nil {
 		return bid.Bid{}, err
@@ -216,18 +250,27 @@ func (r *repository) UpdateBidStatus(ctx context.Context, id uuid.UUID, stat bid
 	return r.GetBidById(ctx, id)
 }
 
-func (r *repository) UpdateBid(ctx context.Context, id uuid.UUID, name, description string) (bid.Bid, error) {
+// UpdateBid creates a new version of the bid and drops votes cast on the previous one in one transaction
+func (r *repository) UpdateBid(ctx context.Context, id uuid.UUID, name, description string, price *float64) (bid.Bid, error) {
 	oldVersion, err := r.GetBidById(ctx, id)
 	if err != nil {
 		return bid.Bid{}, err
 	}
 
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return bid.Bid{}, err
+	}
+
+	defer tx.Rollback(ctx)
+
 	setMap := make(map[string]interface{})
 
 	setMap[versionColumnName] = oldVersion.Version + 1
 	setMap[bidIdColumnName] = oldVersion.Id.String()
 	setMap[nameColumnName] = oldVersion.Name
 	setMap[descriptionColumnName] = oldVersion.Description
+	setMap[priceColumnName] = oldVersion.Price
 
 	if name != "" {
 		setMap[nameColumnName] = name
@@ -237,6 +280,10 @@ func (r *repository) UpdateBid(ctx context.Context, id uuid.UUID, name, descript
 		setMap[descriptionColumnName] = description
 	}
 
+	if price != nil {
+		setMap[priceColumnName] = price
+	}
+
 	newVersionBuilder := squirrel.Insert(versionTableName).PlaceholderFormat(squirrel.Dollar).
 		SetMap(setMap).
 		Suffix(returningAllSuffix)
@@ -246,9 +293,7 @@ func (r *repository) UpdateBid(ctx context.Context, id uuid.UUID, name, descript
 		return bid.Bid{}, err
 	}
 
-	log.Println("sql:" + sql)
-
-	rows, err := r.pool.Query(ctx, sql, args...)
+	rows, err := tx.Query(ctx, sql, args...)
 	if err != nil {
 		return bid.Bid{}, err
 	}
@@ -267,18 +312,23 @@ func (r *repository) UpdateBid(ctx context.Context, id uuid.UUID, name, descript
 		return bid.Bid{}, err
 	}
 
-	log.Println("sql:" + sql)
+	if _, err = tx.Exec(ctx, sql, args...); err != nil {
+		return bid.Bid{}, err
+	}
 
-	rows, err = r.pool.Query(ctx, sql, args...)
-	if err != nil {
+	if err = r.deleteDecisions(ctx, tx, id); err != nil {
 		return bid.Bid{}, err
 	}
 
-	rows.Close()
+	if err = tx.Commit(ctx); err != nil {
+		return bid.Bid{}, err
+	}
 
 	oldVersion.Version = newVersion.Version
 	oldVersion.Name = newVersion.Name
 	oldVersion.Description = newVersion.Description
+	oldVersion.Price = newVersion.Price
+	oldVersion.Facts.HasVotes = false
 
 	return oldVersion, nil
 }
@@ -289,12 +339,9 @@ func (r *repository) UpdateTenderStatus(ctx context.Context, id uuid.UUID, stat
 
 	sql, args, err := updateBuilder.ToSql()
 	if err != nil {
-		fmt.Println()
 		return bid.Bid{}, err
 	}
 
-	log.Println("sql:" + sql)
-
 	rows, err := r.pool.Query(ctx, sql, args...)
 	if err != nil {
 		return bid.Bid{}, err
@@ -305,6 +352,7 @@ func (r *repository) UpdateTenderStatus(ctx context.Context, id uuid.UUID, stat
 	return r.GetBidById(ctx, id)
 }
 
+// RollbackBid creates a new version from an old one, votes cast on the previous version are dropped as on an edit
 func (r *repository) RollbackBid(ctx context.Context, id uuid.UUID, ver int) (bid.Bid, error) {
 	tx, err := r.pool.Begin(ctx)
 	if err != nil {
@@ -327,9 +375,7 @@ func (r *repository) RollbackBid(ctx context.Context, id uuid.UUID, ver int) (bi
 		return bid.Bid{}, err
 	}
 
-	log.Println("sql1: " + sql)
-
-	rows, err := r.pool.Query(ctx, sql, args...)
+	rows, err := tx.Query(ctx, sql, args...)
 	if err != nil {
 		return bid.Bid{}, err
 	}
@@ -340,8 +386,8 @@ func (r *repository) RollbackBid(ctx context.Context, id uuid.UUID, ver int) (bi
 	}
 
 	versionBuilder := squirrel.Insert(versionTableName).PlaceholderFormat(squirrel.Dollar).
-		Columns(bidIdColumnName, nameColumnName, descriptionColumnName, versionColumnName).
-		Values(curBid.Id.String(), oldVersion.Name, oldVersion.Description, curBid.Version+1).
+		Columns(bidIdColumnName, nameColumnName, descriptionColumnName, priceColumnName, versionColumnName).
+		Values(curBid.Id.String(), oldVersion.Name, oldVersion.Description, oldVersion.Price, curBid.Version+1).
 		Suffix(returningAllSuffix)
 
 	sql, args, err = versionBuilder.ToSql()
@@ -349,9 +395,7 @@ func (r *repository) RollbackBid(ctx context.Context, id uuid.UUID, ver int) (bi
 		return bid.Bid{}, err
 	}
 
-	log.Println("sql2: " + sql)
-
-	rows, err = r.pool.Query(ctx, sql, args...)
+	rows, err = tx.Query(ctx, sql, args...)
 	if err != nil {
 		return bid.Bid{}, err
 	}
@@ -370,18 +414,19 @@ func (r *repository) RollbackBid(ctx context.Context, id uuid.UUID, ver int) (bi
 		return bid.Bid{}, err
 	}
 
-	log.Println("sql3: " + sql)
-
-	rows, err = r.pool.Query(ctx, sql, args...)
-	if err != nil {
+	if _, err = tx.Exec(ctx, sql, args...); err != nil {
 		return bid.Bid{}, err
 	}
 
-	rows.Close()
+	if err = r.deleteDecisions(ctx, tx, id); err != nil {
+		return bid.Bid{}, err
+	}
 
 	curBid.Name = oldVersion.Name
 	curBid.Description = oldVersion.Description
+	curBid.Price = oldVersion.Price
 	curBid.Version += 1
+	curBid.Facts.HasVotes = false
 
 	err = tx.Commit(ctx)
 	if err != nil {
@@ -390,3 +435,51 @@ func (r *repository) RollbackBid(ctx context.Context, id uuid.UUID, ver int) (bi
 
 	return curBid, nil
 }
+
+// WithdrawBid marks the bid withdrawn and drops votes cast on it in one transaction
+func (r *repository) WithdrawBid(ctx context.Context, id uuid.UUID, reason string) (bid.Bid, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return bid.Bid{}, err
+	}
+
+	defer tx.Rollback(ctx)
+
+	updateBuilder := squirrel.Update(bidTableName).PlaceholderFormat(squirrel.Dollar).
+		Set(statusColumnName, bid.Withdrawn).
+		Set(reasonColumnName, reason).
+		Set(withdrawnAtColumnName, squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{idColumnName: id.String()})
+
+	sql, args, err := updateBuilder.ToSql()
+	if err != nil {
+		return bid.Bid{}, err
+	}
+
+	if _, err = tx.Exec(ctx, sql, args...); err != nil {
+		return bid.Bid{}, err
+	}
+
+	if err = r.deleteDecisions(ctx, tx, id); err != nil {
+		return bid.Bid{}, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return bid.Bid{}, err
+	}
+
+	return r.GetBidById(ctx, id)
+}
+
+func (r *repository) deleteDecisions(ctx context.Context, tx pgx.Tx, bidId uuid.UUID) error {
+	deleteBuilder := squirrel.Delete(decisionTableName).PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{bidIdColumnName: bidId.String()})
+
+	sql, args, err := deleteBuilder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
+	return err
+}
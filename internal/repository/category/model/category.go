@@ -0,0 +1,43 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"tender-service/internal/model/entity/tender"
+	"time"
+)
+
+type Category struct {
+	Id          uuid.UUID
+	Code        string
+	Name        string
+	ParentId    *uuid.UUID
+	ServiceType *string
+	CreatedAt   time.Time
+}
+
+func DbCategoryToCategory(category Category) tender.Category {
+	var serviceType *tender.ServiceType
+	if category.ServiceType != nil {
+		mapped := tender.ServiceType(*category.ServiceType)
+		serviceType = &mapped
+	}
+
+	return tender.Category{
+		Id:          category.Id,
+		Code:        category.Code,
+		Name:        category.Name,
+		ParentId:    category.ParentId,
+		ServiceType: serviceType,
+		CreatedAt:   category.CreatedAt,
+	}
+}
+
+func DbCategoryListToCategoryList(list []Category) []tender.Category {
+	result := make([]tender.Category, len(list))
+
+	for i := 0; i < len(list); i++ {
+		result[i] = DbCategoryToCategory(list[i])
+	}
+
+	return result
+}
@@ -0,0 +1,221 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"tender-service/internal/i18n"
+	model2 "tender-service/internal/model"
+	"tender-service/internal/model/entity/tender"
+	"tender-service/internal/repository/category/model"
+	"tender-service/internal/repository/pgerr"
+)
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+const (
+	tableName             = "category"
+	idColumnName          = "id"
+	codeColumnName        = "code"
+	nameColumnName        = "name"
+	parentIdColumnName    = "parent_id"
+	serviceTypeColumnName = "service_type"
+	returningAllSuffix    = "RETURNING *"
+	selectAncestors       = "WITH RECURSIVE ancestor AS (" +
+		"SELECT id, parent_id, service_type, 0 AS depth FROM category WHERE id = $1 " +
+		"UNION ALL SELECT category.id, category.parent_id, category.service_type, ancestor.depth + 1 " +
+		"FROM category JOIN ancestor ON category.id = ancestor.parent_id) "
+	selectNearestServiceType = selectAncestors +
+		"SELECT service_type FROM ancestor WHERE service_type IS NOT NULL ORDER BY depth LIMIT 1"
+	selectIsAncestor    = selectAncestors + "SELECT EXISTS(SELECT 1 FROM ancestor WHERE id = $2)"
+	selectCountChildren = "SELECT COUNT(*) FROM category WHERE parent_id = $1"
+	selectCountTenders  = "SELECT COUNT(*) FROM tender_version WHERE $1 = ANY(category_ids)"
+)
+
+var (
+	errCategoryNotFound  = i18n.NewError(i18n.CategoryNotFound)
+	errCategoryCodeTaken = i18n.NewError(i18n.CategoryCodeTaken)
+)
+
+func NewCategoryRepository(pool *pgxpool.Pool) *repository {
+	return &repository{pool: pool}
+}
+
+func (r *repository) SaveCategory(ctx context.Context, category tender.Category) (tender.Category, error) {
+	op := "category_repository.save_category"
+
+	builder := squirrel.Insert(tableName).PlaceholderFormat(squirrel.Dollar).
+		Columns(codeColumnName, nameColumnName, parentIdColumnName).
+		Values(category.Code, category.Name, category.ParentId).
+		Suffix(returningAllSuffix)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return tender.Category{}, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return tender.Category{}, err
+	}
+
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[model.Category])
+	if err != nil {
+		if pgerr.IsUniqueViolation(err) {
+			return tender.Category{}, model2.NewBadRequestError(op, errCategoryCodeTaken)
+		}
+		return tender.Category{}, err
+	}
+
+	return model.DbCategoryToCategory(result), nil
+}
+
+func (r *repository) GetCategoryById(ctx context.Context, id uuid.UUID) (tender.Category, error) {
+	return r.getCategory(ctx, "category_repository.get_category_by_id", squirrel.Eq{idColumnName: id})
+}
+
+func (r *repository) GetCategoryByServiceType(ctx context.Context, serviceType tender.ServiceType) (tender.Category, error) {
+	return r.getCategory(ctx, "category_repository.get_category_by_service_type", squirrel.Eq{serviceTypeColumnName: string(serviceType)})
+}
+
+func (r *repository) getCategory(ctx context.Context, op string, condition squirrel.Eq) (tender.Category, error) {
+	builder := squirrel.Select("*").PlaceholderFormat(squirrel.Dollar).
+		From(tableName).
+		Where(condition)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return tender.Category{}, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return tender.Category{}, err
+	}
+
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[model.Category])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return tender.Category{}, model2.NewNotFoundError(op, errCategoryNotFound)
+		}
+		return tender.Category{}, err
+	}
+
+	return model.DbCategoryToCategory(result), nil
+}
+
+func (r *repository) GetCategoryList(ctx context.Context, ids []uuid.UUID) ([]tender.Category, error) {
+	builder := squirrel.Select("*").PlaceholderFormat(squirrel.Dollar).
+		From(tableName).
+		OrderBy(codeColumnName)
+
+	if ids != nil {
+		builder = builder.Where(squirrel.Eq{idColumnName: ids})
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := pgx.CollectRows(rows, pgx.RowToStructByName[model.Category])
+	if err != nil {
+		return nil, err
+	}
+
+	return model.DbCategoryListToCategoryList(result), nil
+}
+
+func (r *repository) UpdateCategory(ctx context.Context, category tender.Category) (tender.Category, error) {
+	op := "category_repository.update_category"
+
+	builder := squirrel.Update(tableName).PlaceholderFormat(squirrel.Dollar).
+		Set(codeColumnName, category.Code).
+		Set(nameColumnName, category.Name).
+		Set(parentIdColumnName, category.ParentId).
+		Where(squirrel.Eq{idColumnName: category.Id}).
+		Suffix(returningAllSuffix)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return tender.Category{}, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return tender.Category{}, err
+	}
+
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[model.Category])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return tender.Category{}, model2.NewNotFoundError(op, errCategoryNotFound)
+		}
+		if pgerr.IsUniqueViolation(err) {
+			return tender.Category{}, model2.NewBadRequestError(op, errCategoryCodeTaken)
+		}
+		return tender.Category{}, err
+	}
+
+	return model.DbCategoryToCategory(result), nil
+}
+
+func (r *repository) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	builder := squirrel.Delete(tableName).PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{idColumnName: id})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, sql, args...)
+	return err
+}
+
+func (r *repository) GetCategoryServiceType(ctx context.Context, id uuid.UUID) (tender.ServiceType, error) {
+	var serviceType string
+	err := r.pool.QueryRow(ctx, selectNearestServiceType, id).Scan(&serviceType)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return tender.ServiceType(serviceType), nil
+}
+
+func (r *repository) IsCategoryAncestor(ctx context.Context, id uuid.UUID, ancestorId uuid.UUID) (bool, error) {
+	var exists bool
+	if err := r.pool.QueryRow(ctx, selectIsAncestor, id, ancestorId).Scan(&exists); err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+func (r *repository) CountCategoryChildren(ctx context.Context, id uuid.UUID) (int, error) {
+	var count int
+	if err := r.pool.QueryRow(ctx, selectCountChildren, id).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *repository) CountCategoryTenders(ctx context.Context, id uuid.UUID) (int, error) {
+	var count int
+	if err := r.pool.QueryRow(ctx, selectCountTenders, id).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"tender-service/internal/model/entity/bid"
+	"tender-service/internal/model/entity/contract"
+	"time"
+)
+
+type Contract struct {
+	Id                     uuid.UUID
+	Number                 string
+	AwardId                uuid.UUID
+	TenderId               uuid.UUID
+	BidId                  uuid.UUID
+	CustomerOrganizationId uuid.UUID
+	SupplierType           string
+	SupplierId             uuid.UUID
+	Value                  *float64
+	StartDate              time.Time
+	EndDate                time.Time
+	CreatedAt              time.Time
+}
+
+func DbContractToContract(dbContract Contract, milestones []contract.Milestone) contract.Contract {
+	if milestones == nil {
+		milestones = []contract.Milestone{}
+	}
+
+	return contract.Contract{
+		Id:                     dbContract.Id,
+		Number:                 dbContract.Number,
+		AwardId:                dbContract.AwardId,
+		TenderId:               dbContract.TenderId,
+		BidId:                  dbContract.BidId,
+		CustomerOrganizationId: dbContract.CustomerOrganizationId,
+		SupplierType:           bid.AuthorType(dbContract.SupplierType),
+		SupplierId:             dbContract.SupplierId,
+		Value:                  dbContract.Value,
+		StartDate:              dbContract.StartDate,
+		EndDate:                dbContract.EndDate,
+		CreatedAt:              dbContract.CreatedAt,
+		Milestones:             milestones,
+	}
+}
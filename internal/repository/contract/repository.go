@@ -0,0 +1,267 @@
+package contract
+
+import (
+	"context"
+	"errors"
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"tender-service/internal/i18n"
+	model2 "tender-service/internal/model"
+	"tender-service/internal/model/entity/contract"
+	"tender-service/internal/repository/contract/model"
+	"tender-service/internal/repository/pgerr"
+)
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+const (
+	tableName                        = "contract"
+	milestoneTableName               = "contract_milestone"
+	idColumnName                     = "id"
+	awardIdColumnName                = "award_id"
+	tenderIdColumnName               = "tender_id"
+	bidIdColumnName                  = "bid_id"
+	customerOrganizationIdColumnName = "customer_organization_id"
+	supplierTypeColumnName           = "supplier_type"
+	supplierIdColumnName             = "supplier_id"
+	valueColumnName                  = "value"
+	startDateColumnName              = "start_date"
+	endDateColumnName                = "end_date"
+	createdAtColumnName              = "created_at"
+	contractIdColumnName             = "contract_id"
+	titleColumnName                  = "title"
+	dueDateColumnName                = "due_date"
+	completedAtColumnName            = "completed_at"
+	completedByColumnName            = "completed_by"
+	returningAllSuffix               = "RETURNING *"
+	partyCondition                   = "contract.customer_organization_id IN (SELECT organization_id FROM organization_responsible WHERE user_id = ?) OR " +
+		"contract.supplier_id = ? OR " +
+		"(contract.supplier_type = 'Organization' AND EXISTS(SELECT 1 FROM organization_responsible supplier " +
+		"JOIN organization_responsible member ON member.organization_id = supplier.organization_id " +
+		"WHERE supplier.user_id = contract.supplier_id AND member.user_id = ?))"
+)
+
+var (
+	errContractNotFound      = i18n.NewError(i18n.ContractNotFound)
+	errContractAlreadyExists = i18n.NewError(i18n.ContractAlreadyExists)
+	errMilestoneNotFound     = i18n.NewError(i18n.ContractMilestoneNotFound)
+)
+
+func NewContractRepository(pool *pgxpool.Pool) *repository {
+	return &repository{pool: pool}
+}
+
+// SaveContract stores the contract together with its initial milestones
+func (r *repository) SaveContract(ctx context.Context, c contract.Contract) (contract.Contract, error) {
+	op := "contract_repository.save_contract"
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return contract.Contract{}, err
+	}
+
+	defer tx.Rollback(ctx)
+
+	contractBuilder := squirrel.Insert(tableName).PlaceholderFormat(squirrel.Dollar).
+		Columns(awardIdColumnName, tenderIdColumnName, bidIdColumnName, customerOrganizationIdColumnName, supplierTypeColumnName, supplierIdColumnName, valueColumnName, startDateColumnName, endDateColumnName).
+		Values(c.AwardId, c.TenderId, c.BidId, c.CustomerOrganizationId, c.SupplierType, c.SupplierId, c.Value, c.StartDate, c.EndDate).
+		Suffix(returningAllSuffix)
+
+	sql, args, err := contractBuilder.ToSql()
+	if err != nil {
+		return contract.Contract{}, err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return contract.Contract{}, err
+	}
+
+	saved, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[model.Contract])
+	if err != nil {
+		if pgerr.IsUniqueViolation(err) {
+			return contract.Contract{}, model2.NewConflictError(op, errContractAlreadyExists)
+		}
+		return contract.Contract{}, err
+	}
+
+	if len(c.Milestones) > 0 {
+		milestoneBuilder := squirrel.Insert(milestoneTableName).PlaceholderFormat(squirrel.Dollar).
+			Columns(contractIdColumnName, titleColumnName, dueDateColumnName)
+
+		for _, milestone := range c.Milestones {
+			milestoneBuilder = milestoneBuilder.Values(saved.Id, milestone.Title, milestone.DueDate)
+		}
+
+		sql, args, err = milestoneBuilder.ToSql()
+		if err != nil {
+			return contract.Contract{}, err
+		}
+
+		if _, err = tx.Exec(ctx, sql, args...); err != nil {
+			return contract.Contract{}, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return contract.Contract{}, err
+	}
+
+	return r.GetContractById(ctx, saved.Id)
+}
+
+func (r *repository) GetContractById(ctx context.Context, id uuid.UUID) (contract.Contract, error) {
+	op := "contract_repository.get_contract_by_id"
+
+	builder := squirrel.Select("*").PlaceholderFormat(squirrel.Dollar).
+		From(tableName).
+		Where(squirrel.Eq{idColumnName: id})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return contract.Contract{}, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return contract.Contract{}, err
+	}
+
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[model.Contract])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return contract.Contract{}, model2.NewNotFoundError(op, errContractNotFound)
+		}
+		return contract.Contract{}, err
+	}
+
+	milestones, err := r.getMilestones(ctx, []uuid.UUID{id})
+	if err != nil {
+		return contract.Contract{}, err
+	}
+
+	return model.DbContractToContract(result, milestones[id]), nil
+}
+
+// GetContractList returns contracts where the employee represents the customer or the supplier
+func (r *repository) GetContractList(ctx context.Context, userId uuid.UUID) ([]contract.Contract, error) {
+	builder := squirrel.Select("*").PlaceholderFormat(squirrel.Dollar).
+		From(tableName).
+		Where(partyCondition, userId, userId, userId).
+		OrderBy(createdAtColumnName, idColumnName)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := pgx.CollectRows(rows, pgx.RowToStructByName[model.Contract])
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(result))
+	for i, c := range result {
+		ids[i] = c.Id
+	}
+
+	milestones, err := r.getMilestones(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	contracts := make([]contract.Contract, len(result))
+	for i, c := range result {
+		contracts[i] = model.DbContractToContract(c, milestones[c.Id])
+	}
+
+	return contracts, nil
+}
+
+func (r *repository) SaveMilestone(ctx context.Context, milestone contract.Milestone) (contract.Milestone, error) {
+	builder := squirrel.Insert(milestoneTableName).PlaceholderFormat(squirrel.Dollar).
+		Columns(contractIdColumnName, titleColumnName, dueDateColumnName).
+		Values(milestone.ContractId, milestone.Title, milestone.DueDate).
+		Suffix(returningAllSuffix)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return contract.Milestone{}, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return contract.Milestone{}, err
+	}
+
+	return pgx.CollectOneRow(rows, pgx.RowToStructByName[contract.Milestone])
+}
+
+// CompleteMilestone keeps the first completion, completing a milestone twice changes nothing
+func (r *repository) CompleteMilestone(ctx context.Context, contractId, milestoneId uuid.UUID, username string) (contract.Milestone, error) {
+	op := "contract_repository.complete_milestone"
+
+	builder := squirrel.Update(milestoneTableName).PlaceholderFormat(squirrel.Dollar).
+		Set(completedAtColumnName, squirrel.Expr("COALESCE(completed_at, NOW())")).
+		Set(completedByColumnName, squirrel.Expr("COALESCE(completed_by, ?)", username)).
+		Where(squirrel.Eq{idColumnName: milestoneId, contractIdColumnName: contractId}).
+		Suffix(returningAllSuffix)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return contract.Milestone{}, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return contract.Milestone{}, err
+	}
+
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[contract.Milestone])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return contract.Milestone{}, model2.NewNotFoundError(op, errMilestoneNotFound)
+		}
+		return contract.Milestone{}, err
+	}
+
+	return result, nil
+}
+
+func (r *repository) getMilestones(ctx context.Context, contractIds []uuid.UUID) (map[uuid.UUID][]contract.Milestone, error) {
+	builder := squirrel.Select("*").PlaceholderFormat(squirrel.Dollar).
+		From(milestoneTableName).
+		Where(squirrel.Eq{contractIdColumnName: contractIds}).
+		OrderBy(dueDateColumnName, idColumnName)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := pgx.CollectRows(rows, pgx.RowToStructByName[contract.Milestone])
+	if err != nil {
+		return nil, err
+	}
+
+	milestones := make(map[uuid.UUID][]contract.Milestone, len(contractIds))
+	for _, milestone := range result {
+		milestones[milestone.ContractId] = append(milestones[milestone.ContractId], milestone)
+	}
+
+	return milestones, nil
+}
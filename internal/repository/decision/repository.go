@@ -14,10 +14,13 @@ type repository struct {
 }
 
 const (
-	tableName          = "decision"
-	verdictColumnName  = "verdict"
-	usernameColumnName = "username"
-	bidIdColumnName    = "bid_id"
+	tableName           = "decision"
+	idColumnName        = "id"
+	verdictColumnName   = "verdict"
+	usernameColumnName  = "username"
+	bidIdColumnName     = "bid_id"
+	createdAtColumnName = "created_at"
+	replaceVoteSuffix   = "ON CONFLICT (bid_id, username) DO UPDATE SET verdict = EXCLUDED.verdict, updated_at = NOW() RETURNING *"
 )
 
 func NewDecisionRepository(pool *pgxpool.Pool) *repository {
@@ -28,7 +31,7 @@ func (r *repository) SaveDecision(ctx context.Context, dec decision.Decision) (d
 	builder := squirrel.Insert(tableName).PlaceholderFormat(squirrel.Dollar).
 		Columns(bidIdColumnName, verdictColumnName, usernameColumnName).
 		Values(dec.BidId, dec.Verdict, dec.Username).
-		Suffix("RETURNING *")
+		Suffix(replaceVoteSuffix)
 
 	sql, args, err := builder.ToSql()
 	if err != nil {
@@ -48,10 +51,10 @@ func (r *repository) SaveDecision(ctx context.Context, dec decision.Decision) (d
 	return result, nil
 }
 
-func (r *repository) CountDecisionForBid(ctx context.Context, bidId uuid.UUID) (int, error) {
+func (r *repository) CountDecisionForBid(ctx context.Context, bidId uuid.UUID, verdict decision.Verdict) (int, error) {
 	builder := squirrel.Select("COUNT(*)").PlaceholderFormat(squirrel.Dollar).
 		From(tableName).
-		Where(squirrel.Eq{bidIdColumnName: bidId.String()})
+		Where(squirrel.Eq{bidIdColumnName: bidId.String(), verdictColumnName: verdict})
 
 	sql, args, err := builder.ToSql()
 	if err != nil {
@@ -74,3 +77,39 @@ func (r *repository) CountDecisionForBid(ctx context.Context, bidId uuid.UUID) (
 
 	return count, nil
 }
+
+func (r *repository) GetDecisionsForBid(ctx context.Context, bidId uuid.UUID) ([]decision.Decision, error) {
+	builder := squirrel.Select("*").PlaceholderFormat(squirrel.Dollar).
+		From(tableName).
+		Where(squirrel.Eq{bidIdColumnName: bidId.String()}).
+		OrderBy(createdAtColumnName, idColumnName)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowToStructByName[decision.Decision])
+}
+
+func (r *repository) DeleteDecision(ctx context.Context, bidId uuid.UUID, username string) (bool, error) {
+	builder := squirrel.Delete(tableName).PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{bidIdColumnName: bidId.String(), usernameColumnName: username})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	tag, err := r.pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
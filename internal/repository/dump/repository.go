@@ -0,0 +1,104 @@
+package dump
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"tender-service/internal/model/entity/dump"
+)
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+const (
+	// table names are never taken from user input, only from dump.Tables
+	exportQuery   = "SELECT COALESCE(jsonb_agg(to_jsonb(t)), '[]'::jsonb) FROM %s t"
+	importQuery   = "INSERT INTO %[1]s SELECT r.* FROM jsonb_array_elements($1::jsonb) e, jsonb_populate_record(NULL::%[1]s, e - $2::text[]) r ON CONFLICT DO NOTHING"
+	restoreQuery  = "UPDATE %[1]s SET %[2]s = r.%[2]s FROM jsonb_populate_recordset(NULL::%[1]s, $1::jsonb) r WHERE %[1]s.id = r.id AND %[1]s.%[2]s IS NULL"
+	sequenceQuery = "SELECT pg_get_serial_sequence(attrelid::regclass::text, attname) FROM pg_attribute WHERE attrelid = $1::regclass AND attname = 'id'"
+	setvalQuery   = "SELECT setval($1, GREATEST(MAX(id), 1)) FROM %s"
+)
+
+// deferredColumns reference rows imported later or rows of the same table,
+// they are inserted empty and restored once every table is in place
+var deferredColumns = map[string][]string{
+	"tender":   {"tender_version_id"},
+	"category": {"parent_id"},
+}
+
+func NewDumpRepository(pool *pgxpool.Pool) *repository {
+	return &repository{pool: pool}
+}
+
+func (r *repository) ExportTable(ctx context.Context, table string) (json.RawMessage, error) {
+	var rows json.RawMessage
+	if err := r.pool.QueryRow(ctx, fmt.Sprintf(exportQuery, pgx.Identifier{table}.Sanitize())).Scan(&rows); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// ImportTables inserts rows missing from the database in one transaction, existing rows are kept as they are
+func (r *repository) ImportTables(ctx context.Context, tables []dump.Table) ([]dump.ImportedTable, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	imported := make([]dump.ImportedTable, 0, len(tables))
+	for _, table := range tables {
+		name := pgx.Identifier{table.Name}.Sanitize()
+
+		deferred := deferredColumns[table.Name]
+		if deferred == nil {
+			deferred = []string{}
+		}
+
+		tag, err := tx.Exec(ctx, fmt.Sprintf(importQuery, name), string(table.Rows), deferred)
+		if err != nil {
+			return nil, fmt.Errorf("cannot import %s: %w", table.Name, err)
+		}
+
+		imported = append(imported, dump.ImportedTable{Name: table.Name, Inserted: int(tag.RowsAffected())})
+	}
+
+	for _, table := range tables {
+		name := pgx.Identifier{table.Name}.Sanitize()
+
+		for _, column := range deferredColumns[table.Name] {
+			if _, err = tx.Exec(ctx, fmt.Sprintf(restoreQuery, name, pgx.Identifier{column}.Sanitize()), string(table.Rows)); err != nil {
+				return nil, fmt.Errorf("cannot restore %s.%s: %w", table.Name, column, err)
+			}
+		}
+
+		rows, err := tx.Query(ctx, sequenceQuery, table.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		sequences, err := pgx.CollectRows(rows, pgx.RowTo[*string])
+		if err != nil {
+			return nil, err
+		}
+
+		// explicit ids do not advance serial sequences
+		for _, sequence := range sequences {
+			if sequence == nil {
+				continue
+			}
+			if _, err = tx.Exec(ctx, fmt.Sprintf(setvalQuery, name), *sequence); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return imported, nil
+}
@@ -2,11 +2,11 @@ package employee
 
 import (
 	"context"
-	"fmt"
 	"github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"tender-service/internal/i18n"
 	model2 "tender-service/internal/model"
 	"tender-service/internal/model/entity"
 	"tender-service/internal/repository/employee/model"
@@ -17,19 +17,46 @@ type repository struct {
 }
 
 const (
-	tableName          = "employee"
-	idColumnName       = "id"
-	usernameColumnName = "username"
+	tableName           = "employee"
+	idColumnName        = "id"
+	usernameColumnName  = "username"
+	firstNameColumnName = "first_name"
+	lastNameColumnName  = "last_name"
+	returningAllSuffix  = "RETURNING *"
 )
 
 var (
-	errEmployeeNotFound = fmt.Errorf("employee not found")
+	errEmployeeNotFound = i18n.NewError(i18n.EmployeeNotFound)
 )
 
 func NewEmployeeRepository(pool *pgxpool.Pool) *repository {
 	return &repository{pool: pool}
 }
 
+func (r *repository) SaveEmployee(ctx context.Context, employee entity.Employee) (entity.Employee, error) {
+	builder := squirrel.Insert(tableName).PlaceholderFormat(squirrel.Dollar).
+		Columns(usernameColumnName, firstNameColumnName, lastNameColumnName).
+		Values(employee.Username, nullIfEmpty(employee.FirstName), nullIfEmpty(employee.LastName)).
+		Suffix(returningAllSuffix)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return entity.Employee{}, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return entity.Employee{}, err
+	}
+
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[model.Employee])
+	if err != nil {
+		return entity.Employee{}, err
+	}
+
+	return model.DbEmployeeToEmployee(result), nil
+}
+
 func (r *repository) GetEmployeeByUsername(ctx context.Context, username string) (entity.Employee, error) {
 	op := "employee_repository.get_employee_by_username"
 
@@ -130,3 +157,10 @@ func (r *repository) EmployeeExistById(ctx context.Context, id uuid.UUID) (bool,
 
 	return result, nil
 }
+
+func nullIfEmpty(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
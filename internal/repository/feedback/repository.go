@@ -6,7 +6,13 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"tender-service/internal/i18n"
+	model2 "tender-service/internal/model"
 	"tender-service/internal/model/entity"
+	"tender-service/internal/model/entity/rating"
+	"tender-service/internal/repository/keyset"
+	"tender-service/internal/repository/pgerr"
+	"tender-service/internal/util"
 )
 
 type repository struct {
@@ -23,8 +29,17 @@ const (
 	bidTableName             = "bid"
 	bidTableIdColumnName     = "bid.id"
 	bidTableTenderNameColumn = "bid.tender_id"
+	ratingTableName          = "rating"
 )
 
+var (
+	errBidAlreadyRated = i18n.NewError(i18n.RatingBidAlreadyRated)
+)
+
+var sortColumns = map[string]keyset.Column{
+	util.SortByCreatedAt: {Name: "feedback.created_at", Type: "timestamptz"},
+}
+
 func NewFeedbackRepository(pool *pgxpool.Pool) *repository {
 	return &repository{pool: pool}
 }
@@ -53,13 +68,70 @@ func (r *repository) SaveFeedback(ctx context.Context, feedback entity.Feedback)
 	return result, nil
 }
 
-func (r *repository) GetFeedbackListForGroup(ctx context.Context, tenderId uuid.UUID, userId uuid.UUID) ([]entity.Feedback, error) {
+// SaveRatedFeedback stores the feedback together with the rating attached to it
+func (r *repository) SaveRatedFeedback(ctx context.Context, feedback entity.Feedback, feedbackRating rating.Rating) (entity.Feedback, error) {
+	op := "feedback_repository.save_rated_feedback"
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return entity.Feedback{}, err
+	}
+
+	defer tx.Rollback(ctx)
+
+	feedbackBuilder := squirrel.Insert(tableName).PlaceholderFormat(squirrel.Dollar).
+		Columns(bidIdColumnName, descriptionColumnName, usernameColumnName).
+		Values(feedback.BidId.String(), feedback.Description, feedback.Username).
+		Suffix("RETURNING *")
+
+	sql, args, err := feedbackBuilder.ToSql()
+	if err != nil {
+		return entity.Feedback{}, err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return entity.Feedback{}, err
+	}
+
+	saved, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[entity.Feedback])
+	if err != nil {
+		return entity.Feedback{}, err
+	}
+
+	ratingBuilder := squirrel.Insert(ratingTableName).PlaceholderFormat(squirrel.Dollar).
+		Columns("supplier_id", "tender_id", "bid_id", "feedback_id", "username", "quality", "timeliness", "communication").
+		Values(feedbackRating.SupplierId, feedbackRating.TenderId, saved.BidId, saved.Id, saved.Username, feedbackRating.Quality, feedbackRating.Timeliness, feedbackRating.Communication)
+
+	sql, args, err = ratingBuilder.ToSql()
+	if err != nil {
+		return entity.Feedback{}, err
+	}
+
+	if _, err = tx.Exec(ctx, sql, args...); err != nil {
+		if pgerr.IsUniqueViolation(err) {
+			return entity.Feedback{}, model2.NewConflictError(op, errBidAlreadyRated)
+		}
+		return entity.Feedback{}, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return entity.Feedback{}, err
+	}
+
+	return saved, nil
+}
+
+func (r *repository) GetFeedbackListForGroup(ctx context.Context, page util.Page, tenderId uuid.UUID, userId uuid.UUID) ([]entity.Feedback, error) {
 	builder := squirrel.Select("feedback.id, feedback.bid_id, feedback.description, feedback.username, feedback.created_at").PlaceholderFormat(squirrel.Dollar).
 		From(tableName).Join(bidTableName + " ON bid.id = feedback.bid_id").
-		Where(squirrel.And{
-			squirrel.Eq{bidTableTenderNameColumn: tenderId.String()},
-			squirrel.Eq{"bid.author_id": userId},
-		})
+		Where(feedbackForGroupCondition(tenderId, userId))
+
+	builder, err := keyset.Apply(builder, page, sortColumns, "feedback.id")
+	if err != nil {
+		return nil, err
+	}
+	builder = builder.Offset(uint64(page.Offset)).Limit(uint64(page.Limit))
 
 	sql, args, err := builder.ToSql()
 	if err != nil {
@@ -78,3 +150,28 @@ func (r *repository) GetFeedbackListForGroup(ctx context.Context, tenderId uuid.
 
 	return result, nil
 }
+
+func (r *repository) CountFeedbackForGroup(ctx context.Context, tenderId uuid.UUID, userId uuid.UUID) (int, error) {
+	builder := squirrel.Select("COUNT(*)").PlaceholderFormat(squirrel.Dollar).
+		From(tableName).Join(bidTableName + " ON bid.id = feedback.bid_id").
+		Where(feedbackForGroupCondition(tenderId, userId))
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	if err = r.pool.QueryRow(ctx, sql, args...).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func feedbackForGroupCondition(tenderId uuid.UUID, userId uuid.UUID) squirrel.Sqlizer {
+	return squirrel.And{
+		squirrel.Eq{bidTableTenderNameColumn: tenderId.String()},
+		squirrel.Eq{"bid.author_id": userId},
+	}
+}
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"tender-service/internal/model/entity/idempotency"
+	"time"
+)
+
+type Key struct {
+	Key          string
+	Caller       string
+	RequestHash  string
+	StatusCode   *int
+	ContentType  *string
+	ResponseBody []byte
+	CreatedAt    time.Time
+	ExpiresAt    time.Time
+}
+
+func DbKeyToKey(key Key) idempotency.Key {
+	return idempotency.Key{
+		Key:          key.Key,
+		Caller:       key.Caller,
+		RequestHash:  key.RequestHash,
+		StatusCode:   key.StatusCode,
+		ContentType:  key.ContentType,
+		ResponseBody: key.ResponseBody,
+		CreatedAt:    key.CreatedAt,
+		ExpiresAt:    key.ExpiresAt,
+	}
+}
@@ -0,0 +1,161 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"tender-service/internal/i18n"
+	model2 "tender-service/internal/model"
+	"tender-service/internal/model/entity/idempotency"
+	"tender-service/internal/repository/idempotency/model"
+	"time"
+)
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+const (
+	tableName               = "idempotency_key"
+	keyColumnName           = "key"
+	callerColumnName        = "caller"
+	requestHashColumnName   = "request_hash"
+	statusCodeColumnName    = "status_code"
+	contentTypeColumnName   = "content_type"
+	responseBodyColumnName  = "response_body"
+	createdAtColumnName     = "created_at"
+	expiresAtColumnName     = "expires_at"
+	returningAllSuffix      = "RETURNING *"
+	replaceExpiredKeySuffix = "ON CONFLICT (key, caller) DO UPDATE SET " +
+		"request_hash = EXCLUDED.request_hash, status_code = NULL, content_type = NULL, response_body = NULL, " +
+		"created_at = now(), expires_at = EXCLUDED.expires_at " +
+		"WHERE idempotency_key.expires_at <= now() RETURNING *"
+)
+
+var (
+	errIdempotencyKeyNotFound = i18n.NewError(i18n.IdempotencyKeyNotFound)
+)
+
+func NewIdempotencyRepository(pool *pgxpool.Pool) *repository {
+	return &repository{pool: pool}
+}
+
+func (r *repository) ReserveIdempotencyKey(ctx context.Context, key idempotency.Key) (idempotency.Key, bool, error) {
+	reserved, ok, err := r.reserveIdempotencyKey(ctx, key)
+
+	// the conflicting key may be released or expire before it is read, then it can be reserved again
+	var apiErr model2.ApiError
+	if errors.As(err, &apiErr) && apiErr.Code == model2.NotFoundCode {
+		return r.reserveIdempotencyKey(ctx, key)
+	}
+
+	return reserved, ok, err
+}
+
+func (r *repository) reserveIdempotencyKey(ctx context.Context, key idempotency.Key) (idempotency.Key, bool, error) {
+	builder := squirrel.Insert(tableName).PlaceholderFormat(squirrel.Dollar).
+		Columns(keyColumnName, callerColumnName, requestHashColumnName, expiresAtColumnName).
+		Values(key.Key, key.Caller, key.RequestHash, key.ExpiresAt).
+		Suffix(replaceExpiredKeySuffix)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return idempotency.Key{}, false, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return idempotency.Key{}, false, err
+	}
+
+	reserved, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[model.Key])
+	if err == nil {
+		return model.DbKeyToKey(reserved), true, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return idempotency.Key{}, false, err
+	}
+
+	existing, err := r.GetIdempotencyKey(ctx, key.Key, key.Caller)
+	if err != nil {
+		return idempotency.Key{}, false, err
+	}
+
+	return existing, false, nil
+}
+
+func (r *repository) GetIdempotencyKey(ctx context.Context, key, caller string) (idempotency.Key, error) {
+	op := "idempotency_repository.get_idempotency_key"
+
+	builder := squirrel.Select("*").PlaceholderFormat(squirrel.Dollar).
+		From(tableName).
+		Where(squirrel.Eq{keyColumnName: key, callerColumnName: caller})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return idempotency.Key{}, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return idempotency.Key{}, err
+	}
+
+	found, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[model.Key])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return idempotency.Key{}, model2.NewNotFoundError(op, errIdempotencyKeyNotFound)
+	}
+	if err != nil {
+		return idempotency.Key{}, err
+	}
+
+	return model.DbKeyToKey(found), nil
+}
+
+func (r *repository) CompleteIdempotencyKey(ctx context.Context, key, caller string, statusCode int, contentType string, body []byte) error {
+	builder := squirrel.Update(tableName).PlaceholderFormat(squirrel.Dollar).
+		Set(statusCodeColumnName, statusCode).
+		Set(contentTypeColumnName, contentType).
+		Set(responseBodyColumnName, body).
+		Where(squirrel.Eq{keyColumnName: key, callerColumnName: caller})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, sql, args...)
+	return err
+}
+
+func (r *repository) DeleteIdempotencyKey(ctx context.Context, key, caller string) error {
+	builder := squirrel.Delete(tableName).PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{keyColumnName: key, callerColumnName: caller})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, sql, args...)
+	return err
+}
+
+func (r *repository) DeleteExpiredIdempotencyKeys(ctx context.Context, before time.Time) (int64, error) {
+	builder := squirrel.Delete(tableName).PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Lt{expiresAtColumnName: before})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	tag, err := r.pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,95 @@
+package keyset
+
+import (
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"strconv"
+	"tender-service/internal/i18n"
+	"tender-service/internal/model"
+	"tender-service/internal/util"
+	"time"
+)
+
+type Column struct {
+	Name string
+	Type string
+}
+
+var (
+	errIncorrectCursor = i18n.NewError(i18n.PageIncorrectCursor)
+)
+
+func Apply(builder squirrel.SelectBuilder, page util.Page, columns map[string]Column, idColumn string) (squirrel.SelectBuilder, error) {
+	orderBy := make([]string, 0, len(page.Sort)+1)
+	for _, key := range page.Sort {
+		orderBy = append(orderBy, columns[key.Field].Name+direction(key))
+	}
+	orderBy = append(orderBy, idColumn)
+
+	if page.Cursor != nil {
+		condition, err := after(page, columns, idColumn)
+		if err != nil {
+			return builder, err
+		}
+		builder = builder.Where(condition)
+	}
+
+	return builder.OrderBy(orderBy...), nil
+}
+
+func after(page util.Page, columns map[string]Column, idColumn string) (squirrel.Or, error) {
+	op := "keyset.after"
+
+	values := make([]any, len(page.Sort))
+	for i, key := range page.Sort {
+		value, err := parseValue(columns[key.Field], page.Cursor.Values[i])
+		if err != nil {
+			return nil, model.NewBadRequestError(op, errIncorrectCursor)
+		}
+		values[i] = value
+	}
+
+	condition := make(squirrel.Or, 0, len(page.Sort)+1)
+	for i := 0; i <= len(page.Sort); i++ {
+		group := make(squirrel.And, 0, i+1)
+		for j := 0; j < i; j++ {
+			column := columns[page.Sort[j].Field]
+			group = append(group, squirrel.Expr(fmt.Sprintf("%s = ?::%s", column.Name, column.Type), values[j]))
+		}
+
+		if i == len(page.Sort) {
+			group = append(group, squirrel.Expr(idColumn+" > ?", page.Cursor.Id))
+		} else {
+			column := columns[page.Sort[i].Field]
+			operator := ">"
+			if page.Sort[i].Desc {
+				operator = "<"
+			}
+			group = append(group, squirrel.Expr(fmt.Sprintf("%s %s ?::%s", column.Name, operator, column.Type), values[i]))
+		}
+
+		condition = append(condition, group)
+	}
+	return condition, nil
+}
+
+// parseValue converts a cursor value to the Go type of its column, so malformed cursors never reach the query
+func parseValue(column Column, value string) (any, error) {
+	switch column.Type {
+	case "timestamp", "timestamptz":
+		return time.Parse(time.RFC3339Nano, value)
+	case "int":
+		return strconv.Atoi(value)
+	case "numeric":
+		return strconv.ParseFloat(value, 64)
+	default:
+		return value, nil
+	}
+}
+
+func direction(key util.SortKey) string {
+	if key.Desc {
+		return " DESC"
+	}
+	return " ASC"
+}
@@ -0,0 +1,204 @@
+package notification
+
+import (
+	"context"
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"tender-service/internal/model/entity/bid"
+	"tender-service/internal/model/entity/notification"
+	"tender-service/internal/model/entity/tender"
+	"time"
+)
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+const (
+	tableName               = "notification"
+	idColumnName            = "id"
+	typeColumnName          = "type"
+	recipientColumnName     = "recipient"
+	dedupKeyColumnName      = "dedup_key"
+	payloadColumnName       = "payload"
+	statusColumnName        = "status"
+	attemptsColumnName      = "attempts"
+	lastErrorColumnName     = "last_error"
+	nextAttemptAtColumnName = "next_attempt_at"
+	sentAtColumnName        = "sent_at"
+	createdAtColumnName     = "created_at"
+	returningAllSuffix      = "RETURNING *"
+	skipDuplicatesSuffix    = "ON CONFLICT (dedup_key) DO NOTHING"
+	claimCondition          = "id IN (SELECT id FROM notification WHERE status IN ('Pending', 'Processing') AND next_attempt_at <= NOW() ORDER BY next_attempt_at LIMIT ? FOR UPDATE SKIP LOCKED)"
+	tenderAlertSelect       = "?, saved_search.username, 'tender_alert:' || saved_search.id || ':' || tender.id, " +
+		"jsonb_build_object('savedSearchId', saved_search.id, 'savedSearchName', saved_search.name, 'tenderId', tender.id, " +
+		"'tenderName', tender_version.name, 'serviceType', tender_version.service_type, 'budget', tender_version.budget, 'organizationId', tender.organization_id)"
+	tenderCancelledSelect = "?, employee.username, 'tender_cancelled:' || bid.id, " +
+		"jsonb_build_object('tenderId', tender.id, 'tenderName', tender_version.name, 'bidId', bid.id, 'bidName', bid_version.name, 'reason', tender.cancellation_reason)"
+	// legacy service types also match tenders in the subtrees of their categories, the same way as the tender list filter
+	tenderAlertJoin = "saved_search ON " +
+		"(cardinality(saved_search.service_types) = 0 OR tender_version.service_type::text = ANY(saved_search.service_types) OR " +
+		"tender_version.category_ids && ARRAY(WITH RECURSIVE subtree AS (" +
+		"SELECT id FROM category WHERE service_type = ANY(saved_search.service_types) " +
+		"UNION SELECT category.id FROM category JOIN subtree ON category.parent_id = subtree.id" +
+		") SELECT id FROM subtree)) AND " +
+		"(cardinality(saved_search.category_ids) = 0 OR tender_version.category_ids && ARRAY(WITH RECURSIVE subtree AS (" +
+		"SELECT id FROM category WHERE id = ANY(saved_search.category_ids) " +
+		"UNION SELECT category.id FROM category JOIN subtree ON category.parent_id = subtree.id" +
+		") SELECT id FROM subtree)) AND " +
+		"(cardinality(saved_search.organization_ids) = 0 OR tender.organization_id = ANY(saved_search.organization_ids)) AND " +
+		"(saved_search.budget_from IS NULL OR tender_version.budget >= saved_search.budget_from) AND " +
+		"(saved_search.budget_to IS NULL OR tender_version.budget <= saved_search.budget_to) AND " +
+		"(saved_search.query = '' OR tender_search.document @@ tender_search_query(saved_search.query))"
+)
+
+func NewNotificationRepository(pool *pgxpool.Pool) *repository {
+	return &repository{pool: pool}
+}
+
+func (r *repository) EnqueueTenderAlerts(ctx context.Context, tenderId uuid.UUID) (int, error) {
+	matches := squirrel.Select().Column(squirrel.Expr(tenderAlertSelect, notification.TenderAlert)).
+		From("tender").
+		Join("tender_version ON tender.tender_version_id = tender_version.id").
+		Join("tender_search ON tender_search.tender_id = tender.id").
+		Join(tenderAlertJoin).
+		Where(squirrel.Eq{"tender.id": tenderId, "tender.status": tender.Published})
+
+	builder := squirrel.Insert(tableName).PlaceholderFormat(squirrel.Dollar).
+		Columns(typeColumnName, recipientColumnName, dedupKeyColumnName, payloadColumnName).
+		Select(matches).
+		Suffix(skipDuplicatesSuffix)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	tag, err := r.pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+// EnqueueTenderCancellationNotices tells authors of the bids left without an award that the tender was cancelled
+func (r *repository) EnqueueTenderCancellationNotices(ctx context.Context, tenderId uuid.UUID) (int, error) {
+	notices := squirrel.Select().Column(squirrel.Expr(tenderCancelledSelect, notification.TenderCancelled)).
+		From("bid").
+		Join("bid_version ON bid.bid_version_id = bid_version.id").
+		Join("employee ON employee.id = bid.author_id").
+		Join("tender ON tender.id = bid.tender_id").
+		Join("tender_version ON tender.tender_version_id = tender_version.id").
+		Where(squirrel.Eq{"bid.tender_id": tenderId, "bid.decision": bid.NotAwarded, "tender.status": tender.Cancelled})
+
+	builder := squirrel.Insert(tableName).PlaceholderFormat(squirrel.Dollar).
+		Columns(typeColumnName, recipientColumnName, dedupKeyColumnName, payloadColumnName).
+		Select(notices).
+		Suffix(skipDuplicatesSuffix)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	tag, err := r.pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+func (r *repository) ClaimPendingNotifications(ctx context.Context, limit int, lease time.Duration) ([]notification.Notification, error) {
+	builder := squirrel.Update(tableName).PlaceholderFormat(squirrel.Dollar).
+		Set(statusColumnName, notification.Processing).
+		Set(attemptsColumnName, squirrel.Expr(attemptsColumnName+" + 1")).
+		Set(nextAttemptAtColumnName, squirrel.Expr("NOW() + make_interval(secs => ?)", lease.Seconds())).
+		Where(squirrel.Expr(claimCondition, limit)).
+		Suffix(returningAllSuffix)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := pgx.CollectRows(rows, pgx.RowToStructByName[notification.Notification])
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *repository) MarkNotificationSent(ctx context.Context, id uuid.UUID) error {
+	builder := squirrel.Update(tableName).PlaceholderFormat(squirrel.Dollar).
+		Set(statusColumnName, notification.Sent).
+		Set(sentAtColumnName, squirrel.Expr("NOW()")).
+		Set(lastErrorColumnName, nil).
+		Where(squirrel.Eq{idColumnName: id})
+
+	return r.exec(ctx, builder)
+}
+
+func (r *repository) MarkNotificationFailed(ctx context.Context, id uuid.UUID, reason string, retryAt *time.Time) error {
+	builder := squirrel.Update(tableName).PlaceholderFormat(squirrel.Dollar).
+		Set(lastErrorColumnName, reason).
+		Where(squirrel.Eq{idColumnName: id})
+
+	if retryAt != nil {
+		builder = builder.Set(statusColumnName, notification.Pending).Set(nextAttemptAtColumnName, *retryAt)
+	} else {
+		builder = builder.Set(statusColumnName, notification.Failed)
+	}
+
+	return r.exec(ctx, builder)
+}
+
+func (r *repository) ReplayNotifications(ctx context.Context, filter notification.ReplayFilter) (int, error) {
+	builder := squirrel.Update(tableName).PlaceholderFormat(squirrel.Dollar).
+		Set(statusColumnName, notification.Pending).
+		Set(attemptsColumnName, 0).
+		Set(lastErrorColumnName, nil).
+		Set(sentAtColumnName, nil).
+		Set(nextAttemptAtColumnName, squirrel.Expr("NOW()"))
+
+	if len(filter.Ids) > 0 {
+		builder = builder.Where(squirrel.Eq{idColumnName: filter.Ids})
+	}
+	if len(filter.Statuses) > 0 {
+		builder = builder.Where(squirrel.Eq{statusColumnName: filter.Statuses})
+	}
+	if filter.Since != nil {
+		builder = builder.Where(squirrel.GtOrEq{createdAtColumnName: *filter.Since})
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	tag, err := r.pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+func (r *repository) exec(ctx context.Context, builder squirrel.UpdateBuilder) error {
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, sql, args...)
+	return err
+}
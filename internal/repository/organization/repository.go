@@ -15,14 +15,47 @@ type repository struct {
 }
 
 const (
-	tableName    = "organization"
-	idColumnName = "id"
+	tableName             = "organization"
+	idColumnName          = "id"
+	nameColumnName        = "name"
+	descriptionColumnName = "description"
+	typeColumnName        = "type"
+	returningAllSuffix    = "RETURNING *"
 )
 
 func NewOrganizationRepository(pool *pgxpool.Pool) *repository {
 	return &repository{pool: pool}
 }
 
+func (r *repository) SaveOrganization(ctx context.Context, org organization.Organization) (organization.Organization, error) {
+	var orgType *organization.Type
+	if org.Type != "" {
+		orgType = &org.Type
+	}
+
+	builder := squirrel.Insert(tableName).PlaceholderFormat(squirrel.Dollar).
+		Columns(nameColumnName, descriptionColumnName, typeColumnName).
+		Values(org.Name, org.Description, orgType).
+		Suffix(returningAllSuffix)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return organization.Organization{}, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return organization.Organization{}, err
+	}
+
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[model.Organization])
+	if err != nil {
+		return organization.Organization{}, err
+	}
+
+	return model.DbOrganizationToOrganization(result), nil
+}
+
 func (r *repository) GetOrganizationById(ctx context.Context, organizationId uuid.UUID) (organization.Organization, error) {
 	builder := squirrel.Select("*").PlaceholderFormat(squirrel.Dollar).
 		From(tableName).
@@ -0,0 +1,21 @@
+package pgerr
+
+import (
+	"errors"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	UniqueViolation        = "23505"
+	ForeignKeyViolation    = "23503"
+	NotNullViolation       = "23502"
+	CheckViolation         = "23514"
+	InvalidTextRepr        = "22P02"
+	StringDataRightTrunc   = "22001"
+	NumericValueOutOfRange = "22003"
+)
+
+func IsUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == UniqueViolation
+}
@@ -0,0 +1,110 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"slices"
+	"strings"
+	"tender-service/internal/model/entity/ratelimit"
+	"time"
+)
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+const (
+	tableName            = "rate_limit_bucket"
+	keyColumnName        = "key"
+	tokensColumnName     = "tokens"
+	allowedColumnName    = "allowed"
+	updatedAtColumnName  = "updated_at"
+	refilledTokensFormat = "LEAST($2::float8, " + tableName + ".tokens + EXTRACT(EPOCH FROM (now() - " + tableName + ".updated_at)) * $3::float8)"
+)
+
+// squirrel cannot build upserts with expressions referencing the existing row
+var refillQuery = fmt.Sprintf(
+	"INSERT INTO %[1]s (key, tokens, allowed, updated_at) VALUES ($1, $2::float8, TRUE, now()) "+
+		"ON CONFLICT (key) DO UPDATE SET "+
+		"tokens = %[2]s, "+
+		"allowed = %[2]s >= 1, "+
+		"updated_at = now() "+
+		"RETURNING tokens",
+	tableName, refilledTokensFormat,
+)
+
+func NewRateLimitRepository(pool *pgxpool.Pool) *repository {
+	return &repository{pool: pool}
+}
+
+// TakeTokens refills the buckets and takes a token from each of them only when all have one,
+// tokens are returned in the order of buckets as they were before taking
+func (r *repository) TakeTokens(ctx context.Context, buckets []ratelimit.Bucket) (bool, []float64, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return false, nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// rows are locked in key order, so requests sharing buckets cannot deadlock
+	order := make([]int, len(buckets))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortFunc(order, func(a, b int) int {
+		return strings.Compare(buckets[a].Key, buckets[b].Key)
+	})
+
+	tokens := make([]float64, len(buckets))
+	keys := make([]string, 0, len(buckets))
+	allowed := true
+	for _, i := range order {
+		b := buckets[i]
+		if err = tx.QueryRow(ctx, refillQuery, b.Key, float64(b.Burst), b.Rate).Scan(&tokens[i]); err != nil {
+			return false, nil, err
+		}
+		keys = append(keys, b.Key)
+		allowed = allowed && tokens[i] >= 1
+	}
+
+	builder := squirrel.Update(tableName).PlaceholderFormat(squirrel.Dollar).
+		Set(allowedColumnName, allowed).
+		Where(squirrel.Eq{keyColumnName: keys})
+	if allowed {
+		builder = builder.Set(tokensColumnName, squirrel.Expr(tokensColumnName+" - 1"))
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return false, nil, err
+	}
+
+	if _, err = tx.Exec(ctx, sql, args...); err != nil {
+		return false, nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return false, nil, err
+	}
+
+	return allowed, tokens, nil
+}
+
+func (r *repository) DeleteStaleBuckets(ctx context.Context, before time.Time) (int64, error) {
+	builder := squirrel.Delete(tableName).PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Lt{updatedAtColumnName: before})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	tag, err := r.pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
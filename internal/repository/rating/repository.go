@@ -0,0 +1,182 @@
+package rating
+
+import (
+	"context"
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"tender-service/internal/i18n"
+	model2 "tender-service/internal/model"
+	"tender-service/internal/model/entity/rating"
+	"tender-service/internal/repository/keyset"
+	"tender-service/internal/repository/pgerr"
+	"tender-service/internal/util"
+)
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+const (
+	tableName               = "rating"
+	idColumnName            = "rating.id"
+	supplierIdColumnName    = "supplier_id"
+	tenderIdColumnName      = "tender_id"
+	bidIdColumnName         = "bid_id"
+	contractIdColumnName    = "contract_id"
+	usernameColumnName      = "username"
+	qualityColumnName       = "quality"
+	timelinessColumnName    = "timeliness"
+	communicationColumnName = "communication"
+	returningAllSuffix      = "RETURNING *"
+	reputationColumns       = "COUNT(*)::int, " +
+		"ROUND(AVG((quality + timeliness + communication) / 3.0), 2)::float8, " +
+		"ROUND(AVG(quality), 2)::float8, ROUND(AVG(timeliness), 2)::float8, ROUND(AVG(communication), 2)::float8"
+	organizationCondition = "supplier_id IN (SELECT user_id FROM organization_responsible WHERE organization_id = ?) AND " +
+		"EXISTS(SELECT 1 FROM bid WHERE bid.id = rating.bid_id AND bid.author_type = 'Organization')"
+)
+
+var sortColumns = map[string]keyset.Column{
+	util.SortByCreatedAt: {Name: "rating.created_at", Type: "timestamptz"},
+}
+
+var (
+	errContractAlreadyRated = i18n.NewError(i18n.ContractAlreadyRated)
+)
+
+func NewRatingRepository(pool *pgxpool.Pool) *repository {
+	return &repository{pool: pool}
+}
+
+// SaveContractRating stores the rating of a completed contract, a contract is rated once
+func (r *repository) SaveContractRating(ctx context.Context, contractRating rating.Rating) (rating.Rating, error) {
+	op := "rating_repository.save_contract_rating"
+
+	builder := squirrel.Insert(tableName).PlaceholderFormat(squirrel.Dollar).
+		Columns(supplierIdColumnName, tenderIdColumnName, bidIdColumnName, contractIdColumnName, usernameColumnName, qualityColumnName, timelinessColumnName, communicationColumnName).
+		Values(contractRating.SupplierId, contractRating.TenderId, contractRating.BidId, contractRating.ContractId, contractRating.Username, contractRating.Quality, contractRating.Timeliness, contractRating.Communication).
+		Suffix(returningAllSuffix)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return rating.Rating{}, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return rating.Rating{}, err
+	}
+
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[rating.Rating])
+	if err != nil {
+		if pgerr.IsUniqueViolation(err) {
+			return rating.Rating{}, model2.NewConflictError(op, errContractAlreadyRated)
+		}
+		return rating.Rating{}, err
+	}
+
+	return result, nil
+}
+
+func (r *repository) GetRatingList(ctx context.Context, page util.Page, supplierId uuid.UUID) ([]rating.Rating, error) {
+	builder := squirrel.Select("*").PlaceholderFormat(squirrel.Dollar).
+		From(tableName).
+		Where(squirrel.Eq{supplierIdColumnName: supplierId})
+
+	builder, err := keyset.Apply(builder, page, sortColumns, idColumnName)
+	if err != nil {
+		return nil, err
+	}
+	builder = builder.Offset(uint64(page.Offset)).Limit(uint64(page.Limit))
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowToStructByName[rating.Rating])
+}
+
+func (r *repository) CountRatingList(ctx context.Context, supplierId uuid.UUID) (int, error) {
+	builder := squirrel.Select("COUNT(*)").PlaceholderFormat(squirrel.Dollar).
+		From(tableName).
+		Where(squirrel.Eq{supplierIdColumnName: supplierId})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	if err = r.pool.QueryRow(ctx, sql, args...).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *repository) GetEmployeeReputation(ctx context.Context, employeeId uuid.UUID) (rating.Reputation, error) {
+	return r.getReputation(ctx, squirrel.Eq{supplierIdColumnName: employeeId})
+}
+
+// GetOrganizationReputation aggregates ratings of bids submitted by organization employees on behalf of an organization
+func (r *repository) GetOrganizationReputation(ctx context.Context, organizationId uuid.UUID) (rating.Reputation, error) {
+	return r.getReputation(ctx, squirrel.Expr(organizationCondition, organizationId))
+}
+
+// GetEmployeeReputations returns reputations of the employees with at least one rating
+func (r *repository) GetEmployeeReputations(ctx context.Context, employeeIds []uuid.UUID) (map[uuid.UUID]rating.Reputation, error) {
+	builder := squirrel.Select(supplierIdColumnName, reputationColumns).PlaceholderFormat(squirrel.Dollar).
+		From(tableName).
+		Where(squirrel.Eq{supplierIdColumnName: employeeIds}).
+		GroupBy(supplierIdColumnName)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reputations := make(map[uuid.UUID]rating.Reputation, len(employeeIds))
+	for rows.Next() {
+		var employeeId uuid.UUID
+		var reputation rating.Reputation
+		if err = rows.Scan(&employeeId, &reputation.Count, &reputation.Score, &reputation.Quality, &reputation.Timeliness, &reputation.Communication); err != nil {
+			return nil, err
+		}
+		reputations[employeeId] = reputation
+	}
+
+	return reputations, rows.Err()
+}
+
+func (r *repository) getReputation(ctx context.Context, condition squirrel.Sqlizer) (rating.Reputation, error) {
+	builder := squirrel.Select(reputationColumns).PlaceholderFormat(squirrel.Dollar).
+		From(tableName).
+		Where(condition)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return rating.Reputation{}, err
+	}
+
+	var reputation rating.Reputation
+	err = r.pool.QueryRow(ctx, sql, args...).
+		Scan(&reputation.Count, &reputation.Score, &reputation.Quality, &reputation.Timeliness, &reputation.Communication)
+	if err != nil {
+		return rating.Reputation{}, err
+	}
+
+	return reputation, nil
+}
@@ -2,16 +2,26 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"github.com/google/uuid"
 	"tender-service/internal/model/entity"
+	"tender-service/internal/model/entity/award"
 	"tender-service/internal/model/entity/bid"
+	"tender-service/internal/model/entity/contract"
 	"tender-service/internal/model/entity/decision"
+	"tender-service/internal/model/entity/dump"
+	"tender-service/internal/model/entity/idempotency"
+	"tender-service/internal/model/entity/notification"
 	"tender-service/internal/model/entity/organization"
+	"tender-service/internal/model/entity/ratelimit"
+	"tender-service/internal/model/entity/rating"
 	"tender-service/internal/model/entity/tender"
 	"tender-service/internal/util"
+	"time"
 )
 
 type EmployeeRepository interface {
+	SaveEmployee(ctx context.Context, employee entity.Employee) (entity.Employee, error)
 	GetEmployeeByUsername(ctx context.Context, username string) (entity.Employee, error)
 	EmployeeExistByUsername(ctx context.Context, username string) (bool, error)
 	GetEmployeeById(ctx context.Context, id uuid.UUID) (entity.Employee, error)
@@ -19,6 +29,7 @@ type EmployeeRepository interface {
 }
 
 type OrganizationRepository interface {
+	SaveOrganization(ctx context.Context, org organization.Organization) (organization.Organization, error)
 	GetOrganizationById(ctx context.Context, id uuid.UUID) (organization.Organization, error)
 	OrganizationExistById(ctx context.Context, id uuid.UUID) (bool, error)
 }
@@ -28,15 +39,36 @@ type OrganizationResponsibleRepository interface {
 	CountEmployeesInOrganization(ctx context.Context, organizationId uuid.UUID) (int, error)
 	UsersHasSimilarOrganization(ctx context.Context, userId uuid.UUID, username string) (bool, error)
 	IsEmployeeInAnyOrganization(ctx context.Context, userId uuid.UUID) (bool, error)
+	GetOrganizationIdsByUsername(ctx context.Context, username string) ([]uuid.UUID, error)
+	SaveResponsible(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID) error
+	DeleteResponsible(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID) (bool, error)
 }
 
 type TenderRepository interface {
 	SaveTender(ctx context.Context, version tender.Tender) (tender.Tender, error)
 	GetTenderById(ctx context.Context, id uuid.UUID) (tender.Tender, error)
-	GetTenderList(ctx context.Context, page util.Page, serviceTypes []tender.ServiceType, username string, onlyPublished bool) ([]tender.Tender, error)
-	UpdateTender(ctx context.Context, id uuid.UUID, name, description string, serviceType tender.ServiceType) (tender.Tender, error)
+	GetTenderList(ctx context.Context, page util.Page, filter tender.ListFilter) ([]tender.Tender, error)
+	CountTenderList(ctx context.Context, filter tender.ListFilter) (int, error)
+	UpdateTender(ctx context.Context, id uuid.UUID, name, description string, serviceType tender.ServiceType, categoryIds []uuid.UUID, budget *float64) (tender.Tender, error)
 	UpdateTenderStatus(ctx context.Context, id uuid.UUID, status tender.Status) (tender.Tender, error)
+	CancelTender(ctx context.Context, id uuid.UUID, reason string) (tender.Tender, error)
+	CloseTender(ctx context.Context, id uuid.UUID) (tender.Tender, error)
+	ReopenTender(ctx context.Context, id uuid.UUID) (tender.Tender, error)
 	RollbackTender(ctx context.Context, id uuid.UUID, version int) (tender.Tender, error)
+	SearchTenders(ctx context.Context, page util.Page, filter tender.SearchFilter) ([]tender.SearchResult, error)
+}
+
+type CategoryRepository interface {
+	SaveCategory(ctx context.Context, category tender.Category) (tender.Category, error)
+	GetCategoryById(ctx context.Context, id uuid.UUID) (tender.Category, error)
+	GetCategoryByServiceType(ctx context.Context, serviceType tender.ServiceType) (tender.Category, error)
+	GetCategoryList(ctx context.Context, ids []uuid.UUID) ([]tender.Category, error)
+	UpdateCategory(ctx context.Context, category tender.Category) (tender.Category, error)
+	DeleteCategory(ctx context.Context, id uuid.UUID) error
+	GetCategoryServiceType(ctx context.Context, id uuid.UUID) (tender.ServiceType, error)
+	IsCategoryAncestor(ctx context.Context, id uuid.UUID, ancestorId uuid.UUID) (bool, error)
+	CountCategoryChildren(ctx context.Context, id uuid.UUID) (int, error)
+	CountCategoryTenders(ctx context.Context, id uuid.UUID) (int, error)
 }
 
 type BidRepository interface {
@@ -44,17 +76,79 @@ type BidRepository interface {
 	SaveBid(ctx context.Context, version bid.Bid) (bid.Bid, error)
 	GetBidById(ctx context.Context, id uuid.UUID) (bid.Bid, error)
 	GetBidList(ctx context.Context, page util.Page, tenderId uuid.UUID, userId uuid.UUID) ([]bid.Bid, error)
+	CountBidList(ctx context.Context, tenderId uuid.UUID, userId uuid.UUID) (int, error)
 	UpdateBidStatus(ctx context.Context, id uuid.UUID, stat bid.Status) (bid.Bid, error)
-	UpdateBid(ctx context.Context, id uuid.UUID, name, description string) (bid.Bid, error)
+	UpdateBid(ctx context.Context, id uuid.UUID, name, description string, price *float64) (bid.Bid, error)
 	RollbackBid(ctx context.Context, id uuid.UUID, version int) (bid.Bid, error)
+	WithdrawBid(ctx context.Context, id uuid.UUID, reason string) (bid.Bid, error)
 }
 
 type DecisionRepository interface {
 	SaveDecision(ctx context.Context, decision decision.Decision) (decision.Decision, error)
-	CountDecisionForBid(ctx context.Context, bidId uuid.UUID) (int, error)
+	CountDecisionForBid(ctx context.Context, bidId uuid.UUID, verdict decision.Verdict) (int, error)
+	GetDecisionsForBid(ctx context.Context, bidId uuid.UUID) ([]decision.Decision, error)
+	DeleteDecision(ctx context.Context, bidId uuid.UUID, username string) (bool, error)
+}
+
+type AwardRepository interface {
+	AwardBid(ctx context.Context, bidId uuid.UUID) (award.Award, error)
+	GetAwardByTenderId(ctx context.Context, tenderId uuid.UUID) (award.Award, error)
+}
+
+type ContractRepository interface {
+	SaveContract(ctx context.Context, contract contract.Contract) (contract.Contract, error)
+	GetContractById(ctx context.Context, id uuid.UUID) (contract.Contract, error)
+	GetContractList(ctx context.Context, userId uuid.UUID) ([]contract.Contract, error)
+	SaveMilestone(ctx context.Context, milestone contract.Milestone) (contract.Milestone, error)
+	CompleteMilestone(ctx context.Context, contractId, milestoneId uuid.UUID, username string) (contract.Milestone, error)
+}
+
+type RatingRepository interface {
+	SaveContractRating(ctx context.Context, contractRating rating.Rating) (rating.Rating, error)
+	GetRatingList(ctx context.Context, page util.Page, supplierId uuid.UUID) ([]rating.Rating, error)
+	CountRatingList(ctx context.Context, supplierId uuid.UUID) (int, error)
+	GetEmployeeReputation(ctx context.Context, employeeId uuid.UUID) (rating.Reputation, error)
+	GetOrganizationReputation(ctx context.Context, organizationId uuid.UUID) (rating.Reputation, error)
+	GetEmployeeReputations(ctx context.Context, employeeIds []uuid.UUID) (map[uuid.UUID]rating.Reputation, error)
 }
 
 type FeedbackRepository interface {
 	SaveFeedback(ctx context.Context, feedback entity.Feedback) (entity.Feedback, error)
-	GetFeedbackListForGroup(ctx context.Context, tenderId uuid.UUID, userId uuid.UUID) ([]entity.Feedback, error)
+	SaveRatedFeedback(ctx context.Context, feedback entity.Feedback, feedbackRating rating.Rating) (entity.Feedback, error)
+	GetFeedbackListForGroup(ctx context.Context, page util.Page, tenderId uuid.UUID, userId uuid.UUID) ([]entity.Feedback, error)
+	CountFeedbackForGroup(ctx context.Context, tenderId uuid.UUID, userId uuid.UUID) (int, error)
+}
+
+type SavedSearchRepository interface {
+	SaveSavedSearch(ctx context.Context, search tender.SavedSearch) (tender.SavedSearch, error)
+	GetSavedSearchById(ctx context.Context, id uuid.UUID) (tender.SavedSearch, error)
+	GetSavedSearchList(ctx context.Context, username string) ([]tender.SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, id uuid.UUID) error
+}
+
+type NotificationRepository interface {
+	EnqueueTenderAlerts(ctx context.Context, tenderId uuid.UUID) (int, error)
+	EnqueueTenderCancellationNotices(ctx context.Context, tenderId uuid.UUID) (int, error)
+	ClaimPendingNotifications(ctx context.Context, limit int, lease time.Duration) ([]notification.Notification, error)
+	MarkNotificationSent(ctx context.Context, id uuid.UUID) error
+	MarkNotificationFailed(ctx context.Context, id uuid.UUID, reason string, retryAt *time.Time) error
+	ReplayNotifications(ctx context.Context, filter notification.ReplayFilter) (int, error)
+}
+
+type RateLimitRepository interface {
+	TakeTokens(ctx context.Context, buckets []ratelimit.Bucket) (bool, []float64, error)
+	DeleteStaleBuckets(ctx context.Context, before time.Time) (int64, error)
+}
+
+type IdempotencyRepository interface {
+	ReserveIdempotencyKey(ctx context.Context, key idempotency.Key) (idempotency.Key, bool, error)
+	GetIdempotencyKey(ctx context.Context, key, caller string) (idempotency.Key, error)
+	CompleteIdempotencyKey(ctx context.Context, key, caller string, statusCode int, contentType string, body []byte) error
+	DeleteIdempotencyKey(ctx context.Context, key, caller string) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context, before time.Time) (int64, error)
+}
+
+type DumpRepository interface {
+	ExportTable(ctx context.Context, table string) (json.RawMessage, error)
+	ImportTables(ctx context.Context, tables []dump.Table) ([]dump.ImportedTable, error)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -18,6 +19,7 @@ const (
 	usernameColumnName       = "employee.username"
 	employeeUserIdColumnName = "employee.id"
 	userIdColumnName         = "organization_responsible.user_id"
+	bareUserIdColumnName     = "user_id"
 )
 
 func NewOrganizationResponsibleRepository(pool *pgxpool.Pool) *repository {
@@ -134,3 +136,52 @@ func (r *repository) CountEmployeesInOrganization(ctx context.Context, organizat
 
 	return count, nil
 }
+
+func (r *repository) GetOrganizationIdsByUsername(ctx context.Context, username string) ([]uuid.UUID, error) {
+	builder := squirrel.Select(tableName + "." + organizationIdColumnName).PlaceholderFormat(squirrel.Dollar).
+		From(tableName).Join(employeeTableName + " ON employee.id = organization_responsible.user_id").
+		Where(squirrel.Eq{usernameColumnName: username})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+}
+
+func (r *repository) SaveResponsible(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID) error {
+	builder := squirrel.Insert(tableName).PlaceholderFormat(squirrel.Dollar).
+		Columns(organizationIdColumnName, bareUserIdColumnName).
+		Values(organizationId.String(), userId.String())
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, sql, args...)
+	return err
+}
+
+func (r *repository) DeleteResponsible(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID) (bool, error) {
+	builder := squirrel.Delete(tableName).PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{organizationIdColumnName: organizationId.String(), bareUserIdColumnName: userId.String()})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	tag, err := r.pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"tender-service/internal/model/entity/tender"
+	"time"
+)
+
+type SavedSearch struct {
+	Id              uuid.UUID
+	Name            string
+	Username        string
+	Query           string
+	ServiceTypes    []string
+	CategoryIds     []uuid.UUID
+	OrganizationIds []uuid.UUID
+	BudgetFrom      *float64
+	BudgetTo        *float64
+	CreatedAt       time.Time
+}
+
+func ServiceTypesToDbServiceTypes(serviceTypes []tender.ServiceType) []string {
+	result := make([]string, len(serviceTypes))
+
+	for i := 0; i < len(serviceTypes); i++ {
+		result[i] = string(serviceTypes[i])
+	}
+
+	return result
+}
+
+func DbSavedSearchToSavedSearch(search SavedSearch) tender.SavedSearch {
+	serviceTypes := make([]tender.ServiceType, len(search.ServiceTypes))
+	for i := 0; i < len(search.ServiceTypes); i++ {
+		serviceTypes[i] = tender.ServiceType(search.ServiceTypes[i])
+	}
+
+	return tender.SavedSearch{
+		Id:              search.Id,
+		Name:            search.Name,
+		Username:        search.Username,
+		Query:           search.Query,
+		ServiceTypes:    serviceTypes,
+		CategoryIds:     search.CategoryIds,
+		OrganizationIds: search.OrganizationIds,
+		BudgetFrom:      search.BudgetFrom,
+		BudgetTo:        search.BudgetTo,
+		CreatedAt:       search.CreatedAt,
+	}
+}
+
+func DbSavedSearchListToSavedSearchList(list []SavedSearch) []tender.SavedSearch {
+	result := make([]tender.SavedSearch, len(list))
+
+	for i := 0; i < len(list); i++ {
+		result[i] = DbSavedSearchToSavedSearch(list[i])
+	}
+
+	return result
+}
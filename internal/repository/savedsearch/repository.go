@@ -0,0 +1,139 @@
+package savedsearch
+
+import (
+	"context"
+	"errors"
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"tender-service/internal/i18n"
+	model2 "tender-service/internal/model"
+	"tender-service/internal/model/entity/tender"
+	"tender-service/internal/repository/savedsearch/model"
+)
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+const (
+	tableName                 = "saved_search"
+	idColumnName              = "id"
+	nameColumnName            = "name"
+	usernameColumnName        = "username"
+	queryColumnName           = "query"
+	serviceTypesColumnName    = "service_types"
+	categoryIdsColumnName     = "category_ids"
+	organizationIdsColumnName = "organization_ids"
+	budgetFromColumnName      = "budget_from"
+	budgetToColumnName        = "budget_to"
+	createdAtColumnName       = "created_at"
+	returningAllSuffix        = "RETURNING *"
+)
+
+var (
+	errSavedSearchNotFound = i18n.NewError(i18n.SavedSearchNotFound)
+)
+
+func NewSavedSearchRepository(pool *pgxpool.Pool) *repository {
+	return &repository{pool: pool}
+}
+
+func (r *repository) SaveSavedSearch(ctx context.Context, search tender.SavedSearch) (tender.SavedSearch, error) {
+	organizationIds := search.OrganizationIds
+	if organizationIds == nil {
+		organizationIds = []uuid.UUID{}
+	}
+	categoryIds := search.CategoryIds
+	if categoryIds == nil {
+		categoryIds = []uuid.UUID{}
+	}
+
+	builder := squirrel.Insert(tableName).PlaceholderFormat(squirrel.Dollar).
+		Columns(nameColumnName, usernameColumnName, queryColumnName, serviceTypesColumnName, categoryIdsColumnName, organizationIdsColumnName, budgetFromColumnName, budgetToColumnName).
+		Values(search.Name, search.Username, search.Query, model.ServiceTypesToDbServiceTypes(search.ServiceTypes), categoryIds, organizationIds, search.BudgetFrom, search.BudgetTo).
+		Suffix(returningAllSuffix)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return tender.SavedSearch{}, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return tender.SavedSearch{}, err
+	}
+
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[model.SavedSearch])
+	if err != nil {
+		return tender.SavedSearch{}, err
+	}
+
+	return model.DbSavedSearchToSavedSearch(result), nil
+}
+
+func (r *repository) GetSavedSearchById(ctx context.Context, id uuid.UUID) (tender.SavedSearch, error) {
+	op := "saved_search_repository.get_saved_search_by_id"
+
+	builder := squirrel.Select("*").PlaceholderFormat(squirrel.Dollar).
+		From(tableName).
+		Where(squirrel.Eq{idColumnName: id})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return tender.SavedSearch{}, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return tender.SavedSearch{}, err
+	}
+
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[model.SavedSearch])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return tender.SavedSearch{}, model2.NewNotFoundError(op, errSavedSearchNotFound)
+		}
+		return tender.SavedSearch{}, err
+	}
+
+	return model.DbSavedSearchToSavedSearch(result), nil
+}
+
+func (r *repository) GetSavedSearchList(ctx context.Context, username string) ([]tender.SavedSearch, error) {
+	builder := squirrel.Select("*").PlaceholderFormat(squirrel.Dollar).
+		From(tableName).
+		Where(squirrel.Eq{usernameColumnName: username}).
+		OrderBy(createdAtColumnName, idColumnName)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := pgx.CollectRows(rows, pgx.RowToStructByName[model.SavedSearch])
+	if err != nil {
+		return nil, err
+	}
+
+	return model.DbSavedSearchListToSavedSearchList(result), nil
+}
+
+func (r *repository) DeleteSavedSearch(ctx context.Context, id uuid.UUID) error {
+	builder := squirrel.Delete(tableName).PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{idColumnName: id})
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, sql, args...)
+	return err
+}
@@ -8,12 +8,16 @@ import (
 )
 
 type Tender struct {
-	Id              uuid.UUID
-	Status          string
-	TenderVersionId sql.NullInt32
-	OrganizationId  uuid.UUID
-	CreatorUsername string
-	CreatedAt       time.Time
+	Id                  uuid.UUID
+	Status              string
+	TenderVersionId     sql.NullInt32
+	OrganizationId      uuid.UUID
+	CreatorUsername     string
+	CreatedAt           time.Time
+	BidDeadline         *time.Time
+	AllowLateWithdrawal bool
+	CancellationReason  *string
+	CancelledAt         *time.Time
 }
 
 type TenderVersion struct {
@@ -21,20 +25,37 @@ type TenderVersion struct {
 	TenderId    uuid.UUID
 	Name        string
 	Description string
-	ServiceType string
+	ServiceType *string
+	CategoryIds []uuid.UUID
+	Budget      *float64
 	Version     int
 }
 
 type TenderSum struct {
-	Id              uuid.UUID
-	Status          string
-	Name            string
-	Description     string
-	ServiceType     string
-	Version         int
-	OrganizationId  uuid.UUID
-	CreatorUsername string
-	CreatedAt       time.Time
+	Id                  uuid.UUID
+	Status              string
+	Name                string
+	Description         string
+	ServiceType         *string
+	CategoryIds         []uuid.UUID
+	Budget              *float64
+	Version             int
+	OrganizationId      uuid.UUID
+	CreatorUsername     string
+	CreatedAt           time.Time
+	BidDeadline         *time.Time
+	AllowLateWithdrawal bool
+	CancellationReason  *string
+	CancelledAt         *time.Time
+	PendingVotes        bool
+	ApprovedBid         bool
+}
+
+type TenderSearchSum struct {
+	TenderSum
+	Rank                 float64
+	NameHighlight        string
+	DescriptionHighlight string
 }
 
 func DbTenderSumToTender(tenderSum TenderSum) tender.Tender {
@@ -43,26 +64,71 @@ func DbTenderSumToTender(tenderSum TenderSum) tender.Tender {
 		Name:            tenderSum.Name,
 		Description:     tenderSum.Description,
 		Status:          tender.Status(tenderSum.Status),
-		ServiceType:     tender.ServiceType(tenderSum.ServiceType),
+		ServiceType:     DbServiceTypeToServiceType(tenderSum.ServiceType),
+		CategoryIds:     tenderSum.CategoryIds,
+		Budget:          tenderSum.Budget,
 		Version:         tenderSum.Version,
 		CreatedAt:       tenderSum.CreatedAt,
 		OrganizationId:  tenderSum.OrganizationId,
 		CreatorUsername: tenderSum.CreatorUsername,
+		Policy: tender.Policy{
+			BidDeadline:         tenderSum.BidDeadline,
+			AllowLateWithdrawal: tenderSum.AllowLateWithdrawal,
+		},
+		Cancellation: toCancellation(tenderSum.CancellationReason, tenderSum.CancelledAt),
+		Facts: tender.Facts{
+			PendingVotes: tenderSum.PendingVotes,
+			ApprovedBid:  tenderSum.ApprovedBid,
+		},
 	}
 }
 
 func MergeTenderWithVersion(v TenderVersion, t Tender) TenderSum {
 	return TenderSum{
-		Id:              t.Id,
-		Status:          t.Status,
-		Name:            v.Name,
-		Description:     v.Description,
-		ServiceType:     v.ServiceType,
-		Version:         v.Version,
-		OrganizationId:  t.OrganizationId,
-		CreatorUsername: t.CreatorUsername,
-		CreatedAt:       t.CreatedAt,
+		Id:                  t.Id,
+		Status:              t.Status,
+		Name:                v.Name,
+		Description:         v.Description,
+		ServiceType:         v.ServiceType,
+		CategoryIds:         v.CategoryIds,
+		Budget:              v.Budget,
+		Version:             v.Version,
+		OrganizationId:      t.OrganizationId,
+		CreatorUsername:     t.CreatorUsername,
+		CreatedAt:           t.CreatedAt,
+		BidDeadline:         t.BidDeadline,
+		AllowLateWithdrawal: t.AllowLateWithdrawal,
+		CancellationReason:  t.CancellationReason,
+		CancelledAt:         t.CancelledAt,
+	}
+}
+
+// DbServiceTypeToServiceType reads the legacy service type, tenders outside of the legacy categories have none
+func DbServiceTypeToServiceType(serviceType *string) tender.ServiceType {
+	if serviceType == nil {
+		return ""
+	}
+	return tender.ServiceType(*serviceType)
+}
+
+func ServiceTypeToDbServiceType(serviceType tender.ServiceType) *string {
+	if serviceType == "" {
+		return nil
+	}
+	value := string(serviceType)
+	return &value
+}
+
+func toCancellation(reason *string, cancelledAt *time.Time) *tender.Cancellation {
+	if cancelledAt == nil {
+		return nil
 	}
+
+	cancellation := &tender.Cancellation{CancelledAt: *cancelledAt}
+	if reason != nil {
+		cancellation.Reason = *reason
+	}
+	return cancellation
 }
 
 func DdTenderVersionListToTenderList(list []TenderSum) []tender.Tender {
@@ -74,3 +140,22 @@ func DdTenderVersionListToTenderList(list []TenderSum) []tender.Tender {
 
 	return dtoList
 }
+
+func DbTenderSearchSumToSearchResult(sum TenderSearchSum) tender.SearchResult {
+	return tender.SearchResult{
+		Tender:               DbTenderSumToTender(sum.TenderSum),
+		Rank:                 sum.Rank,
+		NameHighlight:        sum.NameHighlight,
+		DescriptionHighlight: sum.DescriptionHighlight,
+	}
+}
+
+func DbTenderSearchSumListToSearchResultList(list []TenderSearchSum) []tender.SearchResult {
+	result := make([]tender.SearchResult, len(list))
+
+	for i := 0; i < len(list); i++ {
+		result[i] = DbTenderSearchSumToSearchResult(list[i])
+	}
+
+	return result
+}
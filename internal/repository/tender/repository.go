@@ -8,9 +8,11 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
-	"log"
+	"tender-service/internal/i18n"
 	model2 "tender-service/internal/model"
+	"tender-service/internal/model/entity/bid"
 	"tender-service/internal/model/entity/tender"
+	"tender-service/internal/repository/keyset"
 	"tender-service/internal/repository/tender/model"
 	"tender-service/internal/util"
 )
@@ -20,26 +22,59 @@ type repository struct {
 }
 
 const (
-	versionTableName          = "tender_version"
-	tenderTableName           = "tender"
-	idColumnName              = "id"
-	tenderIdColumnName        = "tender_id"
-	nameColumnName            = "name"
-	descriptionColumnName     = "description"
-	statusColumnName          = "status"
-	serviceTypeColumnName     = "service_type"
-	versionColumnName         = "version"
-	organizationIdColumnName  = "organization_id"
-	creatorUsernameColumnName = "creator_username"
-	tenderVersionIdColumnName = "tender_version_id"
-	returningAllSuffix        = "RETURNING *"
-	tenderAndVersionJoin      = versionTableName + " ON tender.tender_version_id = tender_version.id"
-	selectTenderSum           = "tender.id, tender.status, tender_version.name, tender_version.description, " +
-		"tender_version.service_type, tender_version.version, tender.organization_id, tender.creator_username, tender.created_at"
+	versionTableName              = "tender_version"
+	tenderTableName               = "tender"
+	idColumnName                  = "id"
+	tenderIdColumnName            = "tender_id"
+	nameColumnName                = "name"
+	descriptionColumnName         = "description"
+	statusColumnName              = "status"
+	serviceTypeColumnName         = "service_type"
+	versionColumnName             = "version"
+	organizationIdColumnName      = "organization_id"
+	creatorUsernameColumnName     = "creator_username"
+	tenderVersionIdColumnName     = "tender_version_id"
+	budgetColumnName              = "budget"
+	categoryIdsColumnName         = "category_ids"
+	searchTableName               = "tender_search"
+	documentColumnName            = "document"
+	updatedAtColumnName           = "updated_at"
+	bidDeadlineColumnName         = "bid_deadline"
+	allowLateWithdrawalColumnName = "allow_late_withdrawal"
+	cancellationReasonColumnName  = "cancellation_reason"
+	cancelledAtColumnName         = "cancelled_at"
+	bidTableName                  = "bid"
+	decisionColumnName            = "decision"
+	awardTableName                = "award"
+	closedBidDecision             = "CASE WHEN EXISTS(SELECT 1 FROM award WHERE award.tender_id = bid.tender_id) THEN 'Lost' ELSE 'NotAwarded' END::bid_decision_type"
+	approvedBidExists             = "EXISTS(SELECT 1 FROM bid WHERE bid.tender_id = tender.id AND bid.decision = 'Approved')"
+	returningAllSuffix            = "RETURNING *"
+	tenderAndVersionJoin          = versionTableName + " ON tender.tender_version_id = tender_version.id"
+	tenderAndSearchJoin           = searchTableName + " ON tender_search.tender_id = tender.id"
+	searchQueryJoin               = "CROSS JOIN tender_search_query(?) AS search(query)"
+	selectTenderSum               = "tender.id, tender.status, tender_version.name, tender_version.description, " +
+		"tender_version.service_type, tender_version.category_ids, tender_version.budget, tender_version.version, tender.organization_id, tender.creator_username, tender.created_at, " +
+		"tender.bid_deadline, tender.allow_late_withdrawal, tender.cancellation_reason, tender.cancelled_at, " +
+		"EXISTS(SELECT 1 FROM bid JOIN decision ON decision.bid_id = bid.id WHERE bid.tender_id = tender.id AND bid.status = 'Published' AND bid.decision = 'None') AS pending_votes, " +
+		approvedBidExists + " AS approved_bid"
+	selectSearchRank = "ts_rank_cd(tender_search.document, search.query) AS rank, " +
+		"ts_headline('russian', tender_version.name, search.query, 'StartSel=<b>, StopSel=</b>, HighlightAll=true') AS name_highlight, " +
+		"ts_headline('russian', coalesce(tender_version.description, ''), search.query, 'StartSel=<b>, StopSel=</b>, MaxFragments=2, MaxWords=30, MinWords=10') AS description_highlight"
+	selectNoSearchRank    = "0::float8 AS rank, tender_version.name AS name_highlight, coalesce(tender_version.description, '') AS description_highlight"
+	refreshSearchSuffix   = "ON CONFLICT (tender_id) DO UPDATE SET document = EXCLUDED.document, updated_at = EXCLUDED.updated_at"
+	categorySubtreeFilter = "tender_version.category_ids && ARRAY(WITH RECURSIVE subtree AS (" +
+		"SELECT id FROM category WHERE %s = ANY(?) " +
+		"UNION SELECT category.id FROM category JOIN subtree ON category.parent_id = subtree.id" +
+		") SELECT id FROM subtree)"
 )
 
 var (
-	errTenderNotFound = fmt.Errorf("tender not found")
+	errTenderNotFound = i18n.NewError(i18n.TenderNotFound)
+	sortColumns       = map[string]keyset.Column{
+		tender.SortByName:      {Name: "tender_version.name", Type: "varchar"},
+		tender.SortByCreatedAt: {Name: "tender.created_at", Type: "timestamp"},
+		tender.SortByVersion:   {Name: "tender_version.version", Type: "int"},
+	}
 )
 
 func NewTenderRepository(pool *pgxpool.Pool) *repository {
@@ -56,8 +91,8 @@ func (r *repository) SaveTender(ctx context.Context, ten tender.Tender) (tender.
 	defer tx.Rollback(ctx)
 
 	tenderBuilder := squirrel.Insert(tenderTableName).PlaceholderFormat(squirrel.Dollar).
-		Columns(statusColumnName, organizationIdColumnName, creatorUsernameColumnName).
-		Values(ten.Status, ten.OrganizationId.String(), ten.CreatorUsername).
+		Columns(statusColumnName, organizationIdColumnName, creatorUsernameColumnName, bidDeadlineColumnName, allowLateWithdrawalColumnName).
+		Values(ten.Status, ten.OrganizationId.String(), ten.CreatorUsername, ten.Policy.BidDeadline, ten.Policy.AllowLateWithdrawal).
 		Suffix(returningAllSuffix)
 
 	sql, args, err := tenderBuilder.ToSql()
@@ -76,8 +111,8 @@ func (r *repository) SaveTender(ctx context.Context, ten tender.Tender) (tender.
 	}
 
 	versionBuilder := squirrel.Insert(versionTableName).PlaceholderFormat(squirrel.Dollar).
-		Columns(tenderIdColumnName, serviceTypeColumnName, nameColumnName, descriptionColumnName, versionColumnName).
-		Values(savedTender.Id.String(), ten.ServiceType, ten.Name, ten.Description, 1).
+		Columns(tenderIdColumnName, serviceTypeColumnName, categoryIdsColumnName, nameColumnName, descriptionColumnName, budgetColumnName, versionColumnName).
+		Values(savedTender.Id.String(), model.ServiceTypeToDbServiceType(ten.ServiceType), nonNilCategoryIds(ten.CategoryIds), ten.Name, ten.Description, ten.Budget, 1).
 		Suffix(returningAllSuffix)
 
 	sql, args, err = versionBuilder.ToSql()
@@ -116,6 +151,10 @@ func (r *repository) SaveTender(ctx context.Context, ten tender.Tender) (tender.
 		Valid: true,
 	}
 
+	if err = r.refreshSearchDocument(ctx, savedTender.Id); err != nil {
+		return tender.Tender{}, err
+	}
+
 	err = tx.Commit(ctx)
 	if err != nil {
 		return tender.Tender{}, err
@@ -135,8 +174,6 @@ func (r *repository) GetTenderById(ctx context.Context, id uuid.UUID) (tender.Te
 		return tender.Tender{}, err
 	}
 
-	log.Println("sql:" + sql)
-
 	rows, err := r.pool.Query(ctx, sql, args...)
 	if err != nil {
 		return tender.Tender{}, err
@@ -150,22 +187,16 @@ func (r *repository) GetTenderById(ctx context.Context, id uuid.UUID) (tender.Te
 	return model.DbTenderSumToTender(version), nil
 }
 
-func (r *repository) GetTenderList(ctx context.Context, page util.Page, serviceTypes []tender.ServiceType, username string, onlyPublished bool) ([]tender.Tender, error) {
+func (r *repository) GetTenderList(ctx context.Context, page util.Page, filter tender.ListFilter) ([]tender.Tender, error) {
 	builder := squirrel.Select(selectTenderSum).PlaceholderFormat(squirrel.Dollar).
 		From(tenderTableName).Join(tenderAndVersionJoin)
 
-	if onlyPublished {
-		builder = builder.Where(squirrel.Eq{statusColumnName: tender.Published})
-	}
+	builder = applyTenderListFilter(builder, filter)
 
-	if len(serviceTypes) > 0 {
-		builder = builder.Where(squirrel.Eq{serviceTypeColumnName: serviceTypes})
-	}
-
-	if username != "" {
-		builder = builder.Where(squirrel.Eq{creatorUsernameColumnName: username})
+	builder, err := keyset.Apply(builder, page, sortColumns, tenderTableName+"."+idColumnName)
+	if err != nil {
+		return nil, err
 	}
-
 	builder = builder.Offset(uint64(page.Offset)).Limit(uint64(page.Limit))
 
 	sql, args, err := builder.ToSql()
@@ -173,8 +204,6 @@ func (r *repository) GetTenderList(ctx context.Context, page util.Page, serviceT
 		return nil, err
 	}
 
-	log.Println("sql:" + sql)
-
 	rows, err := r.pool.Query(ctx, sql, args...)
 	if err != nil {
 		return nil, err
@@ -188,18 +217,82 @@ func (r *repository) GetTenderList(ctx context.Context, page util.Page, serviceT
 	return model.DdTenderVersionListToTenderList(versions), nil
 }
 
+func (r *repository) CountTenderList(ctx context.Context, filter tender.ListFilter) (int, error) {
+	builder := squirrel.Select("COUNT(*)").PlaceholderFormat(squirrel.Dollar).
+		From(tenderTableName).Join(tenderAndVersionJoin)
+
+	builder = applyTenderListFilter(builder, filter)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	if err = r.pool.QueryRow(ctx, sql, args...).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func applyTenderListFilter(builder squirrel.SelectBuilder, filter tender.ListFilter) squirrel.SelectBuilder {
+	if filter.OnlyPublished {
+		builder = builder.Where(squirrel.Eq{statusColumnName: tender.Published})
+	}
+
+	if len(filter.ServiceTypes) > 0 {
+		serviceTypes := make([]string, len(filter.ServiceTypes))
+		for i := 0; i < len(filter.ServiceTypes); i++ {
+			serviceTypes[i] = string(filter.ServiceTypes[i])
+		}
+		builder = builder.Where(squirrel.Or{
+			squirrel.Eq{versionTableName + "." + serviceTypeColumnName: filter.ServiceTypes},
+			squirrel.Expr(fmt.Sprintf(categorySubtreeFilter, serviceTypeColumnName), serviceTypes),
+		})
+	}
+
+	if len(filter.CategoryIds) > 0 {
+		builder = builder.Where(fmt.Sprintf(categorySubtreeFilter, idColumnName), filter.CategoryIds)
+	}
+
+	if filter.Username != "" {
+		builder = builder.Where(squirrel.Eq{creatorUsernameColumnName: filter.Username})
+	}
+
+	if len(filter.Outcomes) > 0 {
+		outcomes := squirrel.Or{}
+		for _, outcome := range filter.Outcomes {
+			switch outcome {
+			case tender.OutcomeCancelled:
+				outcomes = append(outcomes, squirrel.Eq{tenderTableName + "." + statusColumnName: tender.Cancelled})
+			case tender.OutcomeAwarded:
+				outcomes = append(outcomes, squirrel.And{
+					squirrel.Eq{tenderTableName + "." + statusColumnName: tender.Closed},
+					squirrel.Expr(approvedBidExists),
+				})
+			case tender.OutcomeClosedWithoutAward:
+				outcomes = append(outcomes, squirrel.And{
+					squirrel.Eq{tenderTableName + "." + statusColumnName: tender.Closed},
+					squirrel.Expr("NOT " + approvedBidExists),
+				})
+			}
+		}
+		builder = builder.Where(outcomes)
+	}
+
+	return builder
+}
+
 func (r *repository) UpdateTenderStatus(ctx context.Context, id uuid.UUID, stat tender.Status) (tender.Tender, error) {
 	updateBuilder := squirrel.Update(tenderTableName).PlaceholderFormat(squirrel.Dollar).Set(statusColumnName, stat).
 		Where(squirrel.Eq{idColumnName: id.String()})
 
 	sql, args, err := updateBuilder.ToSql()
 	if err != nil {
-		fmt.Println()
 		return tender.Tender{}, err
 	}
 
-	log.Println("sql:" + sql)
-
 	rows, err := r.pool.Query(ctx, sql, args...)
 	if err != nil {
 		return tender.Tender{}, err
@@ -210,7 +303,158 @@ func (r *repository) UpdateTenderStatus(ctx context.Context, id uuid.UUID, stat
 	return r.GetTenderById(ctx, id)
 }
 
-func (r *repository) UpdateTender(ctx context.Context, id uuid.UUID, name, description string, serviceType tender.ServiceType) (tender.Tender, error) {
+// CancelTender cancels the tender for good, its open bids are not awarded
+func (r *repository) CancelTender(ctx context.Context, id uuid.UUID, reason string) (tender.Tender, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return tender.Tender{}, err
+	}
+
+	defer tx.Rollback(ctx)
+
+	updateBuilder := squirrel.Update(tenderTableName).PlaceholderFormat(squirrel.Dollar).
+		Set(statusColumnName, tender.Cancelled).
+		Set(cancellationReasonColumnName, reason).
+		Set(cancelledAtColumnName, squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{idColumnName: id.String()})
+
+	sql, args, err := updateBuilder.ToSql()
+	if err != nil {
+		return tender.Tender{}, err
+	}
+
+	if _, err = tx.Exec(ctx, sql, args...); err != nil {
+		return tender.Tender{}, err
+	}
+
+	bidsBuilder := squirrel.Update(bidTableName).PlaceholderFormat(squirrel.Dollar).
+		Set(decisionColumnName, bid.NotAwarded).
+		Where(squirrel.Eq{
+			tenderIdColumnName: id.String(),
+			decisionColumnName: bid.None,
+			statusColumnName:   []bid.Status{bid.Created, bid.Published},
+		})
+
+	sql, args, err = bidsBuilder.ToSql()
+	if err != nil {
+		return tender.Tender{}, err
+	}
+
+	if _, err = tx.Exec(ctx, sql, args...); err != nil {
+		return tender.Tender{}, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return tender.Tender{}, err
+	}
+
+	return r.GetTenderById(ctx, id)
+}
+
+// CloseTender closes the tender and finalizes its open bids, they lose to the award or are not awarded when there is none
+func (r *repository) CloseTender(ctx context.Context, id uuid.UUID) (tender.Tender, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return tender.Tender{}, err
+	}
+
+	defer tx.Rollback(ctx)
+
+	updateBuilder := squirrel.Update(tenderTableName).PlaceholderFormat(squirrel.Dollar).
+		Set(statusColumnName, tender.Closed).
+		Where(squirrel.Eq{idColumnName: id.String()})
+
+	sql, args, err := updateBuilder.ToSql()
+	if err != nil {
+		return tender.Tender{}, err
+	}
+
+	if _, err = tx.Exec(ctx, sql, args...); err != nil {
+		return tender.Tender{}, err
+	}
+
+	bidsBuilder := squirrel.Update(bidTableName).PlaceholderFormat(squirrel.Dollar).
+		Set(decisionColumnName, squirrel.Expr(closedBidDecision)).
+		Where(squirrel.Eq{
+			tenderIdColumnName: id.String(),
+			decisionColumnName: bid.None,
+			statusColumnName:   []bid.Status{bid.Created, bid.Published},
+		})
+
+	sql, args, err = bidsBuilder.ToSql()
+	if err != nil {
+		return tender.Tender{}, err
+	}
+
+	if _, err = tx.Exec(ctx, sql, args...); err != nil {
+		return tender.Tender{}, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return tender.Tender{}, err
+	}
+
+	return r.GetTenderById(ctx, id)
+}
+
+// ReopenTender publishes the tender again, drops its award and returns the decisions made on close or award to None
+func (r *repository) ReopenTender(ctx context.Context, id uuid.UUID) (tender.Tender, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return tender.Tender{}, err
+	}
+
+	defer tx.Rollback(ctx)
+
+	updateBuilder := squirrel.Update(tenderTableName).PlaceholderFormat(squirrel.Dollar).
+		Set(statusColumnName, tender.Published).
+		Where(squirrel.Eq{idColumnName: id.String()})
+
+	sql, args, err := updateBuilder.ToSql()
+	if err != nil {
+		return tender.Tender{}, err
+	}
+
+	if _, err = tx.Exec(ctx, sql, args...); err != nil {
+		return tender.Tender{}, err
+	}
+
+	deleteBuilder := squirrel.Delete(awardTableName).PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{tenderIdColumnName: id.String()})
+
+	sql, args, err = deleteBuilder.ToSql()
+	if err != nil {
+		return tender.Tender{}, err
+	}
+
+	if _, err = tx.Exec(ctx, sql, args...); err != nil {
+		return tender.Tender{}, err
+	}
+
+	bidsBuilder := squirrel.Update(bidTableName).PlaceholderFormat(squirrel.Dollar).
+		Set(decisionColumnName, bid.None).
+		Where(squirrel.Eq{
+			tenderIdColumnName: id.String(),
+			decisionColumnName: []bid.Decision{bid.Approved, bid.Lost, bid.NotAwarded},
+		})
+
+	sql, args, err = bidsBuilder.ToSql()
+	if err != nil {
+		return tender.Tender{}, err
+	}
+
+	if _, err = tx.Exec(ctx, sql, args...); err != nil {
+		return tender.Tender{}, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return tender.Tender{}, err
+	}
+
+	return r.GetTenderById(ctx, id)
+}
+
+func (r *repository) UpdateTender(ctx context.Context, id uuid.UUID, name, description string, serviceType tender.ServiceType, categoryIds []uuid.UUID, budget *float64) (tender.Tender, error) {
 	oldVersion, err := r.GetTenderById(ctx, id)
 	if err != nil {
 		return tender.Tender{}, err
@@ -222,7 +466,9 @@ func (r *repository) UpdateTender(ctx context.Context, id uuid.UUID, name, descr
 	setMap[tenderIdColumnName] = oldVersion.Id.String()
 	setMap[nameColumnName] = oldVersion.Name
 	setMap[descriptionColumnName] = oldVersion.Description
-	setMap[serviceTypeColumnName] = oldVersion.ServiceType
+	setMap[serviceTypeColumnName] = model.ServiceTypeToDbServiceType(oldVersion.ServiceType)
+	setMap[categoryIdsColumnName] = nonNilCategoryIds(oldVersion.CategoryIds)
+	setMap[budgetColumnName] = oldVersion.Budget
 
 	if name != "" {
 		setMap[nameColumnName] = name
@@ -232,8 +478,14 @@ func (r *repository) UpdateTender(ctx context.Context, id uuid.UUID, name, descr
 		setMap[descriptionColumnName] = description
 	}
 
-	if serviceType != "" {
-		setMap[serviceTypeColumnName] = serviceType
+	// categories come resolved together with their service type, which is empty outside of the legacy categories
+	if len(categoryIds) > 0 {
+		setMap[serviceTypeColumnName] = model.ServiceTypeToDbServiceType(serviceType)
+		setMap[categoryIdsColumnName] = categoryIds
+	}
+
+	if budget != nil {
+		setMap[budgetColumnName] = budget
 	}
 
 	newVersionBuilder := squirrel.Insert(versionTableName).PlaceholderFormat(squirrel.Dollar).
@@ -245,8 +497,6 @@ func (r *repository) UpdateTender(ctx context.Context, id uuid.UUID, name, descr
 		return tender.Tender{}, err
 	}
 
-	log.Println("sql:" + sql)
-
 	rows, err := r.pool.Query(ctx, sql, args...)
 	if err != nil {
 		return tender.Tender{}, err
@@ -266,8 +516,6 @@ func (r *repository) UpdateTender(ctx context.Context, id uuid.UUID, name, descr
 		return tender.Tender{}, err
 	}
 
-	log.Println("sql:" + sql)
-
 	rows, err = r.pool.Query(ctx, sql, args...)
 	if err != nil {
 		return tender.Tender{}, err
@@ -275,10 +523,16 @@ func (r *repository) UpdateTender(ctx context.Context, id uuid.UUID, name, descr
 
 	rows.Close()
 
+	if err = r.refreshSearchDocument(ctx, id); err != nil {
+		return tender.Tender{}, err
+	}
+
 	oldVersion.Version = newVersion.Version
 	oldVersion.Name = newVersion.Name
 	oldVersion.Description = newVersion.Description
-	oldVersion.ServiceType = tender.ServiceType(newVersion.ServiceType)
+	oldVersion.ServiceType = model.DbServiceTypeToServiceType(newVersion.ServiceType)
+	oldVersion.CategoryIds = newVersion.CategoryIds
+	oldVersion.Budget = newVersion.Budget
 
 	return oldVersion, nil
 }
@@ -316,8 +570,8 @@ func (r *repository) RollbackTender(ctx context.Context, id uuid.UUID, version i
 	}
 
 	versionBuilder := squirrel.Insert(versionTableName).PlaceholderFormat(squirrel.Dollar).
-		Columns(tenderIdColumnName, serviceTypeColumnName, nameColumnName, descriptionColumnName, versionColumnName).
-		Values(curTender.Id.String(), oldVersion.ServiceType, oldVersion.Name, oldVersion.Description, curTender.Version+1).
+		Columns(tenderIdColumnName, serviceTypeColumnName, categoryIdsColumnName, nameColumnName, descriptionColumnName, budgetColumnName, versionColumnName).
+		Values(curTender.Id.String(), oldVersion.ServiceType, nonNilCategoryIds(oldVersion.CategoryIds), oldVersion.Name, oldVersion.Description, oldVersion.Budget, curTender.Version+1).
 		Suffix(returningAllSuffix)
 
 	sql, args, err = versionBuilder.ToSql()
@@ -351,9 +605,15 @@ func (r *repository) RollbackTender(ctx context.Context, id uuid.UUID, version i
 
 	rows.Close()
 
-	curTender.ServiceType = tender.ServiceType(oldVersion.ServiceType)
+	if err = r.refreshSearchDocument(ctx, id); err != nil {
+		return tender.Tender{}, err
+	}
+
+	curTender.ServiceType = model.DbServiceTypeToServiceType(oldVersion.ServiceType)
+	curTender.CategoryIds = oldVersion.CategoryIds
 	curTender.Name = oldVersion.Name
 	curTender.Description = oldVersion.Description
+	curTender.Budget = oldVersion.Budget
 	curTender.Version += 1
 
 	err = tx.Commit(ctx)
@@ -363,3 +623,91 @@ func (r *repository) RollbackTender(ctx context.Context, id uuid.UUID, version i
 
 	return curTender, nil
 }
+
+func (r *repository) SearchTenders(ctx context.Context, page util.Page, filter tender.SearchFilter) ([]tender.SearchResult, error) {
+	builder := squirrel.Select(selectTenderSum).PlaceholderFormat(squirrel.Dollar).
+		From(tenderTableName).Join(tenderAndVersionJoin)
+
+	if filter.Query != "" {
+		builder = builder.Column(selectSearchRank).
+			Join(tenderAndSearchJoin).
+			JoinClause(searchQueryJoin, filter.Query).
+			Where(searchTableName + "." + documentColumnName + " @@ search.query").
+			OrderBy("rank DESC")
+	} else {
+		builder = builder.Column(selectNoSearchRank)
+	}
+
+	if len(filter.Statuses) > 0 {
+		builder = builder.Where(squirrel.Eq{tenderTableName + "." + statusColumnName: filter.Statuses})
+	}
+
+	if filter.OrganizationId != uuid.Nil {
+		builder = builder.Where(squirrel.Eq{tenderTableName + "." + organizationIdColumnName: filter.OrganizationId.String()})
+	}
+
+	if !filter.CreatedFrom.IsZero() {
+		builder = builder.Where(squirrel.GtOrEq{tenderTableName + ".created_at": filter.CreatedFrom})
+	}
+
+	if !filter.CreatedTo.IsZero() {
+		builder = builder.Where(squirrel.LtOrEq{tenderTableName + ".created_at": filter.CreatedTo})
+	}
+
+	if filter.BudgetFrom != nil {
+		builder = builder.Where(squirrel.GtOrEq{versionTableName + "." + budgetColumnName: *filter.BudgetFrom})
+	}
+
+	if filter.BudgetTo != nil {
+		builder = builder.Where(squirrel.LtOrEq{versionTableName + "." + budgetColumnName: *filter.BudgetTo})
+	}
+
+	builder = builder.OrderBy(tenderTableName+".created_at DESC", tenderTableName+"."+idColumnName).
+		Offset(uint64(page.Offset)).Limit(uint64(page.Limit))
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	sums, err := pgx.CollectRows(rows, pgx.RowToStructByName[model.TenderSearchSum])
+	if err != nil {
+		return nil, err
+	}
+
+	return model.DbTenderSearchSumListToSearchResultList(sums), nil
+}
+
+func (r *repository) refreshSearchDocument(ctx context.Context, id uuid.UUID) error {
+	documentBuilder := squirrel.Select(
+		tenderTableName+"."+idColumnName,
+		"tender_search_document(tender_version.name, tender_version.description)",
+		"NOW()",
+	).From(tenderTableName).Join(tenderAndVersionJoin).
+		Where(squirrel.Eq{tenderTableName + "." + idColumnName: id.String()})
+
+	builder := squirrel.Insert(searchTableName).PlaceholderFormat(squirrel.Dollar).
+		Columns(tenderIdColumnName, documentColumnName, updatedAtColumnName).
+		Select(documentBuilder).
+		Suffix(refreshSearchSuffix)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, sql, args...)
+	return err
+}
+
+func nonNilCategoryIds(categoryIds []uuid.UUID) []uuid.UUID {
+	if categoryIds == nil {
+		return []uuid.UUID{}
+	}
+	return categoryIds
+}
@@ -0,0 +1,38 @@
+package reqinfo
+
+import "context"
+
+const (
+	UnmatchedRoute = "unmatched"
+	maxIdLength    = 128
+)
+
+type Info struct {
+	Id    string
+	User  string
+	Route string
+}
+
+type infoKey struct{}
+
+func NewContext(ctx context.Context, info *Info) context.Context {
+	return context.WithValue(ctx, infoKey{}, info)
+}
+
+func FromContext(ctx context.Context) *Info {
+	info, _ := ctx.Value(infoKey{}).(*Info)
+	return info
+}
+
+// IsValidId accepts client supplied request ids of printable ascii characters only
+func IsValidId(id string) bool {
+	if id == "" || len(id) > maxIdLength {
+		return false
+	}
+	for _, c := range id {
+		if c < 0x21 || c > 0x7e {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,104 @@
+package bid
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"tender-service/internal/model/dto"
+	"tender-service/internal/model/entity/bid"
+	tenderv1 "tender-service/pkg/api/tender/v1"
+)
+
+func BidDtoToBidProto(bid dto.BidDto) *tenderv1.Bid {
+	return &tenderv1.Bid{
+		Id:               bid.Id.String(),
+		Name:             bid.Name,
+		Description:      bid.Description,
+		Price:            bid.Price,
+		Status:           string(bid.Status),
+		TenderId:         bid.TenderId.String(),
+		AuthorType:       string(bid.AuthorType),
+		AuthorId:         bid.AuthorId.String(),
+		Version:          int32(bid.Version),
+		CreatedAt:        timestamppb.New(bid.CreatedAt),
+		Withdrawal:       withdrawalDtoToWithdrawalProto(bid.Withdrawal),
+		Transitions:      statusesToStrings(bid.Transitions),
+		Decision:         string(bid.Decision),
+		AuthorReputation: reputationDtoToReputationProto(bid.AuthorReputation),
+	}
+}
+
+func reputationDtoToReputationProto(reputation *dto.ReputationDto) *tenderv1.Reputation {
+	if reputation == nil {
+		return nil
+	}
+	return &tenderv1.Reputation{
+		Count:         int32(reputation.Count),
+		Score:         reputation.Score,
+		Quality:       reputation.Quality,
+		Timeliness:    reputation.Timeliness,
+		Communication: reputation.Communication,
+	}
+}
+
+func RatingScoresProtoToRatingScoresDto(scores *tenderv1.RatingScores) *dto.RatingScoresDto {
+	if scores == nil {
+		return nil
+	}
+	return &dto.RatingScoresDto{
+		Quality:       int(scores.GetQuality()),
+		Timeliness:    int(scores.GetTimeliness()),
+		Communication: int(scores.GetCommunication()),
+	}
+}
+
+func withdrawalDtoToWithdrawalProto(withdrawal *dto.WithdrawalDto) *tenderv1.Withdrawal {
+	if withdrawal == nil {
+		return nil
+	}
+	return &tenderv1.Withdrawal{
+		Reason:      withdrawal.Reason,
+		WithdrawnAt: timestamppb.New(withdrawal.WithdrawnAt),
+	}
+}
+
+func BidDtoListToBidProtoList(bids []dto.BidDto) []*tenderv1.Bid {
+	result := make([]*tenderv1.Bid, len(bids))
+	for i, bid := range bids {
+		result[i] = BidDtoToBidProto(bid)
+	}
+	return result
+}
+
+func DecisionDtoListToDecisionProtoList(decisions []dto.DecisionDto) []*tenderv1.Decision {
+	result := make([]*tenderv1.Decision, len(decisions))
+	for i, decision := range decisions {
+		result[i] = &tenderv1.Decision{
+			Id:        decision.Id.String(),
+			BidId:     decision.BidId.String(),
+			Username:  decision.Username,
+			Verdict:   string(decision.Verdict),
+			CreatedAt: timestamppb.New(decision.CreatedAt),
+			UpdatedAt: timestamppb.New(decision.UpdatedAt),
+		}
+	}
+	return result
+}
+
+func FeedbackDtoListToFeedbackProtoList(feedbacks []dto.FeedbackDto) []*tenderv1.Feedback {
+	result := make([]*tenderv1.Feedback, len(feedbacks))
+	for i, feedback := range feedbacks {
+		result[i] = &tenderv1.Feedback{
+			Id:          feedback.Id.String(),
+			Description: feedback.Description,
+			CreatedAt:   timestamppb.New(feedback.CreatedAt),
+		}
+	}
+	return result
+}
+
+func statusesToStrings(statuses []bid.Status) []string {
+	result := make([]string, len(statuses))
+	for i, status := range statuses {
+		result[i] = string(status)
+	}
+	return result
+}
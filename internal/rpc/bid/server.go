@@ -0,0 +1,391 @@
+package bid
+
+import (
+	"context"
+	"github.com/go-playground/validator/v10"
+	"tender-service/internal/i18n"
+	"tender-service/internal/model"
+	"tender-service/internal/model/dto"
+	"tender-service/internal/model/entity/bid"
+	"tender-service/internal/model/entity/decision"
+	"tender-service/internal/rpc"
+	"tender-service/internal/service"
+	"tender-service/internal/util"
+	"tender-service/internal/validation"
+	tenderv1 "tender-service/pkg/api/tender/v1"
+)
+
+var (
+	errNoBidFeedbackPresented = i18n.NewError(i18n.RequestFieldMissing, "bid_feedback")
+	errIncorrectBidDecision   = i18n.NewError(i18n.BidIncorrectDecision)
+)
+
+type server struct {
+	tenderv1.UnimplementedBidServiceServer
+	bidService service.BidService
+	validator  *validator.Validate
+	pageLimits util.PageLimits
+}
+
+func NewBidServer(bidService service.BidService, pageLimits util.PageLimits) *server {
+	return &server{
+		bidService: bidService,
+		pageLimits: pageLimits,
+		validator:  validation.New(),
+	}
+}
+
+func (s *server) CreateBid(ctx context.Context, req *tenderv1.CreateBidRequest) (*tenderv1.CreateBidResponse, error) {
+	op := "bid_rpc/create_bid"
+
+	tenderId, err := rpc.ParseId(op, "tender_id", req.GetTenderId())
+	if err != nil {
+		return nil, err
+	}
+
+	authorId, err := rpc.ParseId(op, "author_id", req.GetAuthorId())
+	if err != nil {
+		return nil, err
+	}
+
+	createDto := dto.CreateBidDto{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Price:       req.Price,
+		TenderId:    tenderId,
+		AuthorType:  bid.AuthorType(req.GetAuthorType()),
+		AuthorId:    authorId,
+	}
+
+	if err = s.validator.Struct(createDto); err != nil {
+		return nil, model.NewBadRequestError(op, err)
+	}
+
+	saved, err := s.bidService.CreateNewBid(ctx, createDto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenderv1.CreateBidResponse{Bid: BidDtoToBidProto(saved)}, nil
+}
+
+func (s *server) GetUserBids(ctx context.Context, req *tenderv1.GetUserBidsRequest) (*tenderv1.GetUserBidsResponse, error) {
+	op := "bid_rpc/get_user_bids"
+
+	page, err := rpc.NewPage(op, req.GetPage(), s.pageLimits, bid.SortFields)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = rpc.RequireUsername(op, "username", req.GetUsername()); err != nil {
+		return nil, err
+	}
+
+	bids, info, err := s.bidService.GetUserBids(ctx, page, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenderv1.GetUserBidsResponse{Bids: BidDtoListToBidProtoList(bids), PageInfo: rpc.PageInfoToProto(info)}, nil
+}
+
+func (s *server) GetTenderBids(ctx context.Context, req *tenderv1.GetTenderBidsRequest) (*tenderv1.GetTenderBidsResponse, error) {
+	op := "bid_rpc/get_tender_bids"
+
+	page, err := rpc.NewPage(op, req.GetPage(), s.pageLimits, bid.SortFields)
+	if err != nil {
+		return nil, err
+	}
+
+	tenderId, err := rpc.ParseId(op, "tender_id", req.GetTenderId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = rpc.RequireUsername(op, "username", req.GetUsername()); err != nil {
+		return nil, err
+	}
+
+	bids, info, err := s.bidService.GetTenderBids(ctx, page, tenderId, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenderv1.GetTenderBidsResponse{Bids: BidDtoListToBidProtoList(bids), PageInfo: rpc.PageInfoToProto(info)}, nil
+}
+
+func (s *server) StreamTenderBids(req *tenderv1.StreamTenderBidsRequest, stream tenderv1.BidService_StreamTenderBidsServer) error {
+	op := "bid_rpc/stream_tender_bids"
+
+	page, err := rpc.NewPage(op, &tenderv1.PageRequest{Limit: req.GetBatchSize(), Sort: req.GetSort()}, s.pageLimits, bid.SortFields)
+	if err != nil {
+		return err
+	}
+
+	tenderId, err := rpc.ParseId(op, "tender_id", req.GetTenderId())
+	if err != nil {
+		return err
+	}
+
+	if err = rpc.RequireUsername(op, "username", req.GetUsername()); err != nil {
+		return err
+	}
+
+	for {
+		bids, info, err := s.bidService.GetTenderBids(stream.Context(), page, tenderId, req.GetUsername())
+		if err != nil {
+			return err
+		}
+
+		for _, b := range bids {
+			if err = stream.Send(&tenderv1.StreamTenderBidsResponse{Bid: BidDtoToBidProto(b)}); err != nil {
+				return err
+			}
+		}
+
+		if info.NextCursor == "" {
+			return nil
+		}
+
+		cursor, err := util.DecodeCursor(info.NextCursor)
+		if err != nil {
+			return model.NewInternalServerError(op, err)
+		}
+		page.Cursor = &cursor
+	}
+}
+
+func (s *server) GetBidStatus(ctx context.Context, req *tenderv1.GetBidStatusRequest) (*tenderv1.GetBidStatusResponse, error) {
+	op := "bid_rpc/get_bid_status"
+
+	bidId, err := rpc.ParseId(op, "bid_id", req.GetBidId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = rpc.RequireUsername(op, "username", req.GetUsername()); err != nil {
+		return nil, err
+	}
+
+	status, err := s.bidService.GetBidStatus(ctx, bidId, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenderv1.GetBidStatusResponse{Status: string(status)}, nil
+}
+
+func (s *server) UpdateBidStatus(ctx context.Context, req *tenderv1.UpdateBidStatusRequest) (*tenderv1.UpdateBidStatusResponse, error) {
+	op := "bid_rpc/update_bid_status"
+
+	bidId, err := rpc.ParseId(op, "bid_id", req.GetBidId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = rpc.RequireUsername(op, "username", req.GetUsername()); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.bidService.UpdateBidStatus(ctx, bidId, req.GetUsername(), bid.Status(req.GetStatus()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenderv1.UpdateBidStatusResponse{Bid: BidDtoToBidProto(updated)}, nil
+}
+
+func (s *server) EditBid(ctx context.Context, req *tenderv1.EditBidRequest) (*tenderv1.EditBidResponse, error) {
+	op := "bid_rpc/edit_bid"
+
+	bidId, err := rpc.ParseId(op, "bid_id", req.GetBidId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = rpc.RequireUsername(op, "username", req.GetUsername()); err != nil {
+		return nil, err
+	}
+
+	updateDto := dto.UpdateBidDto{Name: req.GetName(), Description: req.GetDescription(), Price: req.Price}
+
+	if err = s.validator.Struct(updateDto); err != nil {
+		return nil, model.NewBadRequestError(op, err)
+	}
+
+	updated, err := s.bidService.EditBid(ctx, bidId, req.GetUsername(), updateDto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenderv1.EditBidResponse{Bid: BidDtoToBidProto(updated)}, nil
+}
+
+func (s *server) SubmitBidDecision(ctx context.Context, req *tenderv1.SubmitBidDecisionRequest) (*tenderv1.SubmitBidDecisionResponse, error) {
+	op := "bid_rpc/submit_bid_decision"
+
+	bidId, err := rpc.ParseId(op, "bid_id", req.GetBidId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = rpc.RequireUsername(op, "username", req.GetUsername()); err != nil {
+		return nil, err
+	}
+
+	if !decision.IsDecisionVerdict(req.GetDecision()) {
+		return nil, model.NewBadRequestError(op, errIncorrectBidDecision)
+	}
+
+	updated, err := s.bidService.SubmitBidDecision(ctx, bidId, req.GetUsername(), decision.Verdict(req.GetDecision()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenderv1.SubmitBidDecisionResponse{Bid: BidDtoToBidProto(updated)}, nil
+}
+
+func (s *server) WithdrawBidDecision(ctx context.Context, req *tenderv1.WithdrawBidDecisionRequest) (*tenderv1.WithdrawBidDecisionResponse, error) {
+	op := "bid_rpc/withdraw_bid_decision"
+
+	bidId, err := rpc.ParseId(op, "bid_id", req.GetBidId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = rpc.RequireUsername(op, "username", req.GetUsername()); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.bidService.WithdrawBidDecision(ctx, bidId, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenderv1.WithdrawBidDecisionResponse{Bid: BidDtoToBidProto(updated)}, nil
+}
+
+func (s *server) GetBidDecisions(ctx context.Context, req *tenderv1.GetBidDecisionsRequest) (*tenderv1.GetBidDecisionsResponse, error) {
+	op := "bid_rpc/get_bid_decisions"
+
+	bidId, err := rpc.ParseId(op, "bid_id", req.GetBidId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = rpc.RequireUsername(op, "username", req.GetUsername()); err != nil {
+		return nil, err
+	}
+
+	decisions, err := s.bidService.GetBidDecisions(ctx, bidId, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenderv1.GetBidDecisionsResponse{Decisions: DecisionDtoListToDecisionProtoList(decisions)}, nil
+}
+
+func (s *server) CreateBidFeedback(ctx context.Context, req *tenderv1.CreateBidFeedbackRequest) (*tenderv1.CreateBidFeedbackResponse, error) {
+	op := "bid_rpc/create_bid_feedback"
+
+	bidId, err := rpc.ParseId(op, "bid_id", req.GetBidId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = rpc.RequireUsername(op, "username", req.GetUsername()); err != nil {
+		return nil, err
+	}
+
+	if req.GetBidFeedback() == "" {
+		return nil, model.NewBadRequestError(op, errNoBidFeedbackPresented)
+	}
+
+	scores := RatingScoresProtoToRatingScoresDto(req.GetRating())
+	if scores != nil {
+		if err = s.validator.Struct(scores); err != nil {
+			return nil, model.NewBadRequestError(op, err)
+		}
+	}
+
+	updated, err := s.bidService.CreateBidFeedback(ctx, bidId, req.GetBidFeedback(), req.GetUsername(), scores)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenderv1.CreateBidFeedbackResponse{Bid: BidDtoToBidProto(updated)}, nil
+}
+
+func (s *server) RollbackBid(ctx context.Context, req *tenderv1.RollbackBidRequest) (*tenderv1.RollbackBidResponse, error) {
+	op := "bid_rpc/rollback_bid"
+
+	bidId, err := rpc.ParseId(op, "bid_id", req.GetBidId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = rpc.RequireUsername(op, "username", req.GetUsername()); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.bidService.RollbackBid(ctx, bidId, req.GetUsername(), int(req.GetVersion()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenderv1.RollbackBidResponse{Bid: BidDtoToBidProto(updated)}, nil
+}
+
+func (s *server) GetBidReviews(ctx context.Context, req *tenderv1.GetBidReviewsRequest) (*tenderv1.GetBidReviewsResponse, error) {
+	op := "bid_rpc/get_bid_reviews"
+
+	page, err := rpc.NewPage(op, req.GetPage(), s.pageLimits, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tenderId, err := rpc.ParseId(op, "tender_id", req.GetTenderId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = rpc.RequireUsername(op, "author_username", req.GetAuthorUsername()); err != nil {
+		return nil, err
+	}
+
+	if err = rpc.RequireUsername(op, "requester_username", req.GetRequesterUsername()); err != nil {
+		return nil, err
+	}
+
+	feedbacks, info, err := s.bidService.GetBidReviews(ctx, page, tenderId, req.GetAuthorUsername(), req.GetRequesterUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenderv1.GetBidReviewsResponse{Feedbacks: FeedbackDtoListToFeedbackProtoList(feedbacks), PageInfo: rpc.PageInfoToProto(info)}, nil
+}
+
+func (s *server) WithdrawBid(ctx context.Context, req *tenderv1.WithdrawBidRequest) (*tenderv1.WithdrawBidResponse, error) {
+	op := "bid_rpc/withdraw_bid"
+
+	bidId, err := rpc.ParseId(op, "bid_id", req.GetBidId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = rpc.RequireUsername(op, "username", req.GetUsername()); err != nil {
+		return nil, err
+	}
+
+	withdrawDto := dto.WithdrawBidDto{Reason: req.GetReason()}
+	if err = s.validator.Struct(withdrawDto); err != nil {
+		return nil, model.NewBadRequestError(op, err)
+	}
+
+	withdrawn, err := s.bidService.WithdrawBid(ctx, bidId, req.GetUsername(), withdrawDto.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenderv1.WithdrawBidResponse{Bid: BidDtoToBidProto(withdrawn)}, nil
+}
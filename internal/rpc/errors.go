@@ -0,0 +1,68 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"tender-service/internal/httperr"
+	"tender-service/internal/i18n"
+)
+
+var problemCodes = map[string]codes.Code{
+	httperr.TypeNotAuthorized:    codes.Unauthenticated,
+	httperr.TypeForbidden:        codes.PermissionDenied,
+	httperr.TypeBadRequest:       codes.InvalidArgument,
+	httperr.TypeValidationFailed: codes.InvalidArgument,
+	httperr.TypeNotFound:         codes.NotFound,
+	httperr.TypeConflict:         codes.Aborted,
+	httperr.TypeAlreadyExists:    codes.AlreadyExists,
+	httperr.TypeInvalidReference: codes.FailedPrecondition,
+	httperr.TypeUnprocessable:    codes.FailedPrecondition,
+	httperr.TypeTooManyRequests:  codes.ResourceExhausted,
+	httperr.TypePayloadTooLarge:  codes.ResourceExhausted,
+	httperr.TypeInternal:         codes.Internal,
+}
+
+// toStatusError converts service errors with the same rules and messages as the REST problem documents
+func toStatusError(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, err.Error())
+	}
+
+	lang := i18n.FromAcceptLanguage(firstMetadata(ctx, AcceptLanguageMetadata))
+
+	problem, unexpected := httperr.NewProblem(err, lang)
+	if unexpected {
+		slog.ErrorContext(ctx, "rpc failed", slog.Any("error", err))
+	}
+
+	code, ok := problemCodes[problem.Type]
+	if !ok {
+		code = codes.Unknown
+	}
+
+	st := status.New(code, problem.Detail)
+	if len(problem.Errors) == 0 {
+		return st.Err()
+	}
+
+	violations := make([]*errdetails.BadRequest_FieldViolation, len(problem.Errors))
+	for i, fieldErr := range problem.Errors {
+		violations[i] = &errdetails.BadRequest_FieldViolation{Field: fieldErr.Field, Description: fieldErr.Message}
+	}
+
+	detailed, detailsErr := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
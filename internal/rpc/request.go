@@ -0,0 +1,81 @@
+package rpc
+
+import (
+	"github.com/google/uuid"
+	"net/url"
+	"strconv"
+	"tender-service/internal/i18n"
+	"tender-service/internal/model"
+	"tender-service/internal/util"
+	tenderv1 "tender-service/pkg/api/tender/v1"
+)
+
+func ParseId(op, field, value string) (uuid.UUID, error) {
+	if value == "" {
+		return uuid.Nil, model.NewBadRequestError(op, i18n.NewError(i18n.RequestFieldMissing, field))
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, model.NewBadRequestError(op, i18n.NewError(i18n.RequestIncorrectUuid, field))
+	}
+	return id, nil
+}
+
+func ParseIds(op, field string, values []string) ([]uuid.UUID, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	ids := make([]uuid.UUID, len(values))
+	for i, value := range values {
+		id, err := ParseId(op, field, value)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+func RequireUsername(op, field, username string) error {
+	if username == "" {
+		return model.NewNotAuthorizedError(op, i18n.NewError(i18n.RequestFieldMissing, field))
+	}
+	return nil
+}
+
+// NewPage applies the same defaults and checks as the offset, limit, cursor, sort and with_total query parameters
+func NewPage(op string, page *tenderv1.PageRequest, limits util.PageLimits, sortFields []string) (util.Page, error) {
+	query := url.Values{}
+	if page != nil {
+		if page.GetOffset() != 0 {
+			query.Set("offset", strconv.Itoa(int(page.GetOffset())))
+		}
+		if page.GetLimit() != 0 {
+			query.Set("limit", strconv.Itoa(int(page.GetLimit())))
+		}
+		if page.GetCursor() != "" {
+			query.Set("cursor", page.GetCursor())
+		}
+		if page.GetSort() != "" {
+			query.Set("sort", page.GetSort())
+		}
+		if page.GetWithTotal() {
+			query.Set("with_total", "true")
+		}
+	}
+
+	p, err := util.NewPageFromQuery(query, limits, sortFields)
+	if err != nil {
+		return util.Page{}, model.NewBadRequestError(op, err)
+	}
+	return p, nil
+}
+
+func PageInfoToProto(info util.PageInfo) *tenderv1.PageInfo {
+	result := &tenderv1.PageInfo{NextCursor: info.NextCursor}
+	if info.Total != nil {
+		total := int32(*info.Total)
+		result.Total = &total
+	}
+	return result
+}
@@ -0,0 +1,120 @@
+package rpc
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"tender-service/internal/reqinfo"
+	"tender-service/internal/tracing"
+	"time"
+)
+
+const (
+	RequestIdMetadata      = "x-request-id"
+	AcceptLanguageMetadata = "accept-language"
+)
+
+type usernameGetter interface {
+	GetUsername() string
+}
+
+func NewServer() *grpc.Server {
+	return grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryInterceptor),
+		grpc.ChainStreamInterceptor(streamInterceptor),
+	)
+}
+
+func unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx = newRequestContext(ctx, info.FullMethod, req)
+	ctx, span := tracing.Start(ctx, info.FullMethod)
+	defer span.End()
+	start := time.Now()
+
+	resp, err := handler(ctx, req)
+	err = toStatusError(ctx, err)
+
+	logCall(ctx, info.FullMethod, err, start)
+	return resp, err
+}
+
+func streamInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := newRequestContext(stream.Context(), info.FullMethod, nil)
+	ctx, span := tracing.Start(ctx, info.FullMethod)
+	defer span.End()
+	start := time.Now()
+
+	err := handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+	err = toStatusError(ctx, err)
+
+	logCall(ctx, info.FullMethod, err, start)
+	return err
+}
+
+// contextStream passes the request context to stream handlers and records the user of the first message
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *contextStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		if info := reqinfo.FromContext(s.ctx); info != nil && info.User == "" {
+			if r, ok := m.(usernameGetter); ok {
+				info.User = r.GetUsername()
+			}
+		}
+	}
+	return err
+}
+
+func newRequestContext(ctx context.Context, method string, req any) context.Context {
+	requestId := firstMetadata(ctx, RequestIdMetadata)
+	if !reqinfo.IsValidId(requestId) {
+		requestId = uuid.NewString()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIdMetadata, requestId))
+
+	info := &reqinfo.Info{Id: requestId, Route: method}
+	if r, ok := req.(usernameGetter); ok {
+		info.User = r.GetUsername()
+	}
+
+	return reqinfo.NewContext(ctx, info)
+}
+
+func firstMetadata(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func logCall(ctx context.Context, method string, err error, start time.Time) {
+	code := status.Code(err)
+
+	level := slog.LevelInfo
+	if code == codes.Internal || code == codes.Unknown {
+		level = slog.LevelError
+	}
+
+	slog.Log(ctx, level, "rpc completed",
+		slog.String("method", method),
+		slog.String("code", code.String()),
+		slog.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+	)
+}
@@ -0,0 +1,91 @@
+package tender
+
+import (
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"tender-service/internal/events"
+	"tender-service/internal/model/dto"
+	"tender-service/internal/model/entity/tender"
+	tenderv1 "tender-service/pkg/api/tender/v1"
+	"time"
+)
+
+func TenderDtoToTenderProto(tender dto.TenderDto) *tenderv1.Tender {
+	return &tenderv1.Tender{
+		Id:                  tender.Id.String(),
+		Name:                tender.Name,
+		Description:         tender.Description,
+		Status:              string(tender.Status),
+		ServiceType:         string(tender.ServiceType),
+		CategoryIds:         idsToStrings(tender.CategoryIds),
+		Budget:              tender.Budget,
+		OrganizationId:      tender.OrganizationId.String(),
+		Version:             int32(tender.Version),
+		BidDeadline:         timeToTimestamp(tender.BidDeadline),
+		AllowLateWithdrawal: tender.AllowLateWithdrawal,
+		Transitions:         statusesToStrings(tender.Transitions),
+		Outcome:             string(tender.Outcome),
+		Cancellation:        cancellationDtoToCancellationProto(tender.Cancellation),
+	}
+}
+
+func cancellationDtoToCancellationProto(cancellation *dto.CancellationDto) *tenderv1.Cancellation {
+	if cancellation == nil {
+		return nil
+	}
+	return &tenderv1.Cancellation{
+		Reason:      cancellation.Reason,
+		CancelledAt: timestamppb.New(cancellation.CancelledAt),
+	}
+}
+
+func AwardDtoToAwardProto(award dto.AwardDto) *tenderv1.Award {
+	return &tenderv1.Award{
+		Id:         award.Id.String(),
+		TenderId:   award.TenderId.String(),
+		BidId:      award.BidId.String(),
+		BidVersion: int32(award.BidVersion),
+		Amount:     award.Amount,
+		Approvers:  award.Approvers,
+		AwardedAt:  timestamppb.New(award.AwardedAt),
+	}
+}
+
+func TenderDtoListToTenderProtoList(tenders []dto.TenderDto) []*tenderv1.Tender {
+	result := make([]*tenderv1.Tender, len(tenders))
+	for i, tender := range tenders {
+		result[i] = TenderDtoToTenderProto(tender)
+	}
+	return result
+}
+
+func TenderEventToTenderEventProto(event events.TenderEvent) *tenderv1.WatchTenderEventsResponse {
+	return &tenderv1.WatchTenderEventsResponse{
+		Type:       string(event.Type),
+		Tender:     TenderDtoToTenderProto(event.Tender),
+		OccurredAt: timestamppb.New(event.OccurredAt),
+	}
+}
+
+func timeToTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func statusesToStrings(statuses []tender.Status) []string {
+	result := make([]string, len(statuses))
+	for i, status := range statuses {
+		result[i] = string(status)
+	}
+	return result
+}
+
+func idsToStrings(ids []uuid.UUID) []string {
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		result[i] = id.String()
+	}
+	return result
+}
@@ -0,0 +1,308 @@
+package tender
+
+import (
+	"context"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"tender-service/internal/i18n"
+	"tender-service/internal/model"
+	"tender-service/internal/model/dto"
+	"tender-service/internal/model/entity/tender"
+	"tender-service/internal/rpc"
+	"tender-service/internal/service"
+	"tender-service/internal/util"
+	"tender-service/internal/validation"
+	tenderv1 "tender-service/pkg/api/tender/v1"
+)
+
+var (
+	errIncorrectServiceType   = i18n.NewError(i18n.FilterIncorrectServiceType)
+	errIncorrectTenderStatus  = i18n.NewError(i18n.FilterIncorrectTenderStatus)
+	errIncorrectTenderOutcome = i18n.NewError(i18n.FilterIncorrectTenderOutcome)
+)
+
+type server struct {
+	tenderv1.UnimplementedTenderServiceServer
+	tenderService service.TenderService
+	validator     *validator.Validate
+	pageLimits    util.PageLimits
+}
+
+func NewTenderServer(tenderService service.TenderService, pageLimits util.PageLimits) *server {
+	return &server{
+		tenderService: tenderService,
+		pageLimits:    pageLimits,
+		validator:     validation.New(),
+	}
+}
+
+func (s *server) GetTenders(ctx context.Context, req *tenderv1.GetTendersRequest) (*tenderv1.GetTendersResponse, error) {
+	op := "tender_rpc/get_tenders"
+
+	page, err := rpc.NewPage(op, req.GetPage(), s.pageLimits, tender.SortFields)
+	if err != nil {
+		return nil, err
+	}
+
+	var serviceTypes []tender.ServiceType
+	for _, serviceType := range req.GetServiceTypes() {
+		if !tender.IsServiceType(serviceType) {
+			return nil, model.NewBadRequestError(op, errIncorrectServiceType)
+		}
+		serviceTypes = append(serviceTypes, tender.ServiceType(serviceType))
+	}
+
+	categoryIds, err := rpc.ParseIds(op, "category_ids", req.GetCategoryIds())
+	if err != nil {
+		return nil, err
+	}
+
+	tenders, info, err := s.tenderService.GetTenders(ctx, page, tender.ListFilter{ServiceTypes: serviceTypes, CategoryIds: categoryIds})
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenderv1.GetTendersResponse{Tenders: TenderDtoListToTenderProtoList(tenders), PageInfo: rpc.PageInfoToProto(info)}, nil
+}
+
+func (s *server) CreateTender(ctx context.Context, req *tenderv1.CreateTenderRequest) (*tenderv1.CreateTenderResponse, error) {
+	op := "tender_rpc/create_tender"
+
+	organizationId, err := rpc.ParseId(op, "organization_id", req.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+
+	categoryIds, err := rpc.ParseIds(op, "category_ids", req.GetCategoryIds())
+	if err != nil {
+		return nil, err
+	}
+
+	createDto := dto.CreateTenderDto{
+		Name:                req.GetName(),
+		Description:         req.GetDescription(),
+		ServiceType:         tender.ServiceType(req.GetServiceType()),
+		CategoryIds:         categoryIds,
+		Budget:              req.Budget,
+		AllowLateWithdrawal: req.GetAllowLateWithdrawal(),
+		OrganizationId:      organizationId,
+		CreatorUsername:     req.GetCreatorUsername(),
+	}
+
+	if req.BidDeadline != nil {
+		bidDeadline := req.GetBidDeadline().AsTime()
+		createDto.BidDeadline = &bidDeadline
+	}
+
+	if err = s.validator.Struct(createDto); err != nil {
+		return nil, model.NewBadRequestError(op, err)
+	}
+
+	saved, err := s.tenderService.CreateNewTender(ctx, createDto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenderv1.CreateTenderResponse{Tender: TenderDtoToTenderProto(saved)}, nil
+}
+
+func (s *server) GetUserTenders(ctx context.Context, req *tenderv1.GetUserTendersRequest) (*tenderv1.GetUserTendersResponse, error) {
+	op := "tender_rpc/get_user_tenders"
+
+	page, err := rpc.NewPage(op, req.GetPage(), s.pageLimits, tender.SortFields)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = rpc.RequireUsername(op, "username", req.GetUsername()); err != nil {
+		return nil, err
+	}
+
+	var outcomes []tender.Outcome
+	for _, outcome := range req.GetOutcomes() {
+		if !tender.IsOutcome(outcome) {
+			return nil, model.NewBadRequestError(op, errIncorrectTenderOutcome)
+		}
+		outcomes = append(outcomes, tender.Outcome(outcome))
+	}
+
+	tenders, info, err := s.tenderService.GetUserTenders(ctx, page, req.GetUsername(), outcomes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenderv1.GetUserTendersResponse{Tenders: TenderDtoListToTenderProtoList(tenders), PageInfo: rpc.PageInfoToProto(info)}, nil
+}
+
+func (s *server) GetTenderStatus(ctx context.Context, req *tenderv1.GetTenderStatusRequest) (*tenderv1.GetTenderStatusResponse, error) {
+	op := "tender_rpc/get_tender_status"
+
+	tenderId, err := rpc.ParseId(op, "tender_id", req.GetTenderId())
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := s.tenderService.GetTenderStatus(ctx, tenderId, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenderv1.GetTenderStatusResponse{Status: string(status)}, nil
+}
+
+func (s *server) UpdateTenderStatus(ctx context.Context, req *tenderv1.UpdateTenderStatusRequest) (*tenderv1.UpdateTenderStatusResponse, error) {
+	op := "tender_rpc/update_tender_status"
+
+	tenderId, err := rpc.ParseId(op, "tender_id", req.GetTenderId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = rpc.RequireUsername(op, "username", req.GetUsername()); err != nil {
+		return nil, err
+	}
+
+	if !tender.IsTenderStatus(req.GetStatus()) {
+		return nil, model.NewBadRequestError(op, errIncorrectTenderStatus)
+	}
+
+	updated, err := s.tenderService.UpdateTenderStatus(ctx, tenderId, req.GetUsername(), tender.Status(req.GetStatus()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenderv1.UpdateTenderStatusResponse{Tender: TenderDtoToTenderProto(updated)}, nil
+}
+
+func (s *server) CancelTender(ctx context.Context, req *tenderv1.CancelTenderRequest) (*tenderv1.CancelTenderResponse, error) {
+	op := "tender_rpc/cancel_tender"
+
+	tenderId, err := rpc.ParseId(op, "tender_id", req.GetTenderId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = rpc.RequireUsername(op, "username", req.GetUsername()); err != nil {
+		return nil, err
+	}
+
+	cancelDto := dto.CancelTenderDto{Reason: req.GetReason()}
+	if err = s.validator.Struct(cancelDto); err != nil {
+		return nil, model.NewBadRequestError(op, err)
+	}
+
+	cancelled, err := s.tenderService.CancelTender(ctx, tenderId, req.GetUsername(), cancelDto.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenderv1.CancelTenderResponse{Tender: TenderDtoToTenderProto(cancelled)}, nil
+}
+
+func (s *server) GetTenderAward(ctx context.Context, req *tenderv1.GetTenderAwardRequest) (*tenderv1.GetTenderAwardResponse, error) {
+	op := "tender_rpc/get_tender_award"
+
+	tenderId, err := rpc.ParseId(op, "tender_id", req.GetTenderId())
+	if err != nil {
+		return nil, err
+	}
+
+	award, err := s.tenderService.GetTenderAward(ctx, tenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenderv1.GetTenderAwardResponse{Award: AwardDtoToAwardProto(award)}, nil
+}
+
+func (s *server) EditTender(ctx context.Context, req *tenderv1.EditTenderRequest) (*tenderv1.EditTenderResponse, error) {
+	op := "tender_rpc/edit_tender"
+
+	tenderId, err := rpc.ParseId(op, "tender_id", req.GetTenderId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = rpc.RequireUsername(op, "username", req.GetUsername()); err != nil {
+		return nil, err
+	}
+
+	categoryIds, err := rpc.ParseIds(op, "category_ids", req.GetCategoryIds())
+	if err != nil {
+		return nil, err
+	}
+
+	updateDto := dto.UpdateTenderDto{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		ServiceType: tender.ServiceType(req.GetServiceType()),
+		CategoryIds: categoryIds,
+		Budget:      req.Budget,
+	}
+
+	if err = s.validator.Struct(updateDto); err != nil {
+		return nil, model.NewBadRequestError(op, err)
+	}
+
+	updated, err := s.tenderService.EditTender(ctx, updateDto, tenderId, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenderv1.EditTenderResponse{Tender: TenderDtoToTenderProto(updated)}, nil
+}
+
+func (s *server) RollbackTender(ctx context.Context, req *tenderv1.RollbackTenderRequest) (*tenderv1.RollbackTenderResponse, error) {
+	op := "tender_rpc/rollback_tender"
+
+	tenderId, err := rpc.ParseId(op, "tender_id", req.GetTenderId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = rpc.RequireUsername(op, "username", req.GetUsername()); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.tenderService.RollbackTender(ctx, tenderId, req.GetUsername(), int(req.GetVersion()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenderv1.RollbackTenderResponse{Tender: TenderDtoToTenderProto(updated)}, nil
+}
+
+func (s *server) WatchTenderEvents(req *tenderv1.WatchTenderEventsRequest, stream tenderv1.TenderService_WatchTenderEventsServer) error {
+	op := "tender_rpc/watch_tender_events"
+
+	organizationId := uuid.Nil
+	if req.GetOrganizationId() != "" {
+		var err error
+		organizationId, err = rpc.ParseId(op, "organization_id", req.GetOrganizationId())
+		if err != nil {
+			return err
+		}
+
+		if err = rpc.RequireUsername(op, "username", req.GetUsername()); err != nil {
+			return err
+		}
+	}
+
+	feed, err := s.tenderService.WatchTenderEvents(stream.Context(), organizationId, req.GetUsername())
+	if err != nil {
+		return err
+	}
+
+	// flush headers so clients know the subscription is active
+	if err = stream.SendHeader(nil); err != nil {
+		return err
+	}
+
+	for event := range feed {
+		if err = stream.Send(TenderEventToTenderEventProto(event)); err != nil {
+			return err
+		}
+	}
+
+	return stream.Context().Err()
+}
@@ -2,10 +2,11 @@ package bid
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"github.com/google/uuid"
-	"log"
+	"tender-service/internal/i18n"
 	"tender-service/internal/mapper"
+	"tender-service/internal/metrics"
 	"tender-service/internal/model"
 	"tender-service/internal/model/dto"
 	entity2 "tender-service/internal/model/entity"
@@ -14,7 +15,9 @@ import (
 	"tender-service/internal/model/entity/tender"
 	"tender-service/internal/repository"
 	service2 "tender-service/internal/service"
+	"tender-service/internal/tracing"
 	"tender-service/internal/util"
+	"time"
 )
 
 type service struct {
@@ -24,17 +27,25 @@ type service struct {
 	tenderService       service2.TenderService
 	feedbackRepository  repository.FeedbackRepository
 	decisionRepository  repository.DecisionRepository
+	awardRepository     repository.AwardRepository
+	ratingRepository    repository.RatingRepository
+	metrics             *metrics.Metrics
 }
 
 var (
-	errStatusCannotBeSelectedByOwner = fmt.Errorf("given status cannot be set by bid owner")
-	errCannotVoteOnBid               = fmt.Errorf("cannot vote on given bid")
-	errTenderAlreadyClosed           = fmt.Errorf("tender already closed")
-	errBidVersionDontExists          = fmt.Errorf("given bid version dont exists")
-	errEmployeeNotBidAuhtor          = fmt.Errorf("employee not auuthor of bid")
-	errEmployeeNotInBidOrg           = fmt.Errorf("employee not in bid org")
-	errNoReviewsFound                = fmt.Errorf("no reviews found")
-	errCannotBidOnClosedTender       = fmt.Errorf("cannot make bid on closed tender")
+	errStatusCannotBeSelectedByOwner = i18n.NewError(i18n.BidStatusNotAllowedForAuthor)
+	errCannotVoteOnBid               = i18n.NewError(i18n.BidCannotVote)
+	errTenderAlreadyClosed           = i18n.NewError(i18n.BidTenderClosed)
+	errBidVersionNotFound            = i18n.NewError(i18n.BidVersionNotFound)
+	errEmployeeNotBidAuthor          = i18n.NewError(i18n.BidNotAuthor)
+	errEmployeeNotInBidOrg           = i18n.NewError(i18n.BidNotInAuthorOrganization)
+	errNoReviewsFound                = i18n.NewError(i18n.BidNoReviews)
+	errCannotBidOnClosedTender       = i18n.NewError(i18n.BidCannotBidOnClosedTender)
+	errDecisionNotFound              = i18n.NewError(i18n.BidDecisionNotFound)
+	errBidDeadlinePassed             = i18n.NewError(i18n.BidDeadlinePassed)
+	errBidWithdrawn                  = i18n.NewError(i18n.BidWithdrawn)
+	errLateWithdrawalForbidden       = i18n.NewError(i18n.BidLateWithdrawalForbidden)
+	errBidNotEditable                = i18n.NewError(i18n.BidNotEditable)
 )
 
 func NewBidService(
@@ -44,6 +55,9 @@ func NewBidService(
 	tenderService service2.TenderService,
 	feedbackRepository repository.FeedbackRepository,
 	decisionRepository repository.DecisionRepository,
+	awardRepository repository.AwardRepository,
+	ratingRepository repository.RatingRepository,
+	metrics *metrics.Metrics,
 ) *service {
 	return &service{
 		employeeService:     employeeService,
@@ -52,11 +66,17 @@ func NewBidService(
 		tenderService:       tenderService,
 		feedbackRepository:  feedbackRepository,
 		decisionRepository:  decisionRepository,
+		awardRepository:     awardRepository,
+		ratingRepository:    ratingRepository,
+		metrics:             metrics,
 	}
 }
 
 func (s *service) CreateNewBid(ctx context.Context, createDto dto.CreateBidDto) (dto.BidDto, error) {
 	op := "bid_service.create_bid"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
 	ten, err := s.tenderService.GetTenderById(ctx, createDto.TenderId)
 	if err != nil {
 		return dto.BidDto{}, err
@@ -64,6 +84,9 @@ func (s *service) CreateNewBid(ctx context.Context, createDto dto.CreateBidDto)
 	if ten.Status != tender.Published {
 		return dto.BidDto{}, model.NewBadRequestError(op, errCannotBidOnClosedTender)
 	}
+	if ten.Policy.DeadlinePassed(time.Now()) {
+		return dto.BidDto{}, model.NewBadRequestError(op, errBidDeadlinePassed)
+	}
 
 	if err := s.employeeService.ValidateEmployeeExistsById(ctx, createDto.AuthorId); err != nil {
 		return dto.BidDto{}, err
@@ -82,39 +105,94 @@ func (s *service) CreateNewBid(ctx context.Context, createDto dto.CreateBidDto)
 		return dto.BidDto{}, err
 	}
 
+	s.metrics.BidCreated()
+
 	return mapper.BidToBidDto(saved), err
 }
 
-func (s *service) GetUserBids(ctx context.Context, page util.Page, username string) ([]dto.BidDto, error) {
+func (s *service) GetUserBids(ctx context.Context, page util.Page, username string) ([]dto.BidDto, util.PageInfo, error) {
+	ctx, span := tracing.Start(ctx, "bid_service.get_user_bids")
+	defer span.End()
+
 	user, err := s.employeeService.GetEmployeeByUsername(ctx, username)
 	if err != nil {
-		return nil, err
+		return nil, util.PageInfo{}, err
 	}
 
 	bids, err := s.bidRepository.GetBidList(ctx, page, uuid.Nil, user.Id)
 	if err != nil {
-		return nil, err
+		return nil, util.PageInfo{}, err
 	}
 
-	log.Println(bids)
+	info, err := s.getBidListPageInfo(ctx, page, bids, uuid.Nil, user.Id)
+	if err != nil {
+		return nil, util.PageInfo{}, err
+	}
 
-	return mapper.BidListToBidDtoList(bids), nil
+	return mapper.BidListToBidDtoList(bids), info, nil
 }
 
-func (s *service) GetTenderBids(ctx context.Context, page util.Page, tenderId uuid.UUID, username string) ([]dto.BidDto, error) {
+func (s *service) GetTenderBids(ctx context.Context, page util.Page, tenderId uuid.UUID, username string) ([]dto.BidDto, util.PageInfo, error) {
+	ctx, span := tracing.Start(ctx, "bid_service.get_tender_bids")
+	defer span.End()
+
 	if err := s.tenderService.ValidateEmployeeRightsOnTender(ctx, tenderId, username); err != nil {
-		return nil, err
+		return nil, util.PageInfo{}, err
 	}
 
 	bids, err := s.bidRepository.GetBidList(ctx, page, tenderId, uuid.Nil)
 	if err != nil {
-		return nil, err
+		return nil, util.PageInfo{}, err
+	}
+
+	info, err := s.getBidListPageInfo(ctx, page, bids, tenderId, uuid.Nil)
+	if err != nil {
+		return nil, util.PageInfo{}, err
+	}
+
+	authorIds := make([]uuid.UUID, len(bids))
+	for i, b := range bids {
+		authorIds[i] = b.AuthorId
+	}
+
+	reputations, err := s.ratingRepository.GetEmployeeReputations(ctx, authorIds)
+	if err != nil {
+		return nil, util.PageInfo{}, err
+	}
+
+	bidDtoList := mapper.BidListToBidDtoList(bids)
+	for i := range bidDtoList {
+		reputation := mapper.ReputationToReputationDto(reputations[bidDtoList[i].AuthorId])
+		bidDtoList[i].AuthorReputation = &reputation
+	}
+
+	return bidDtoList, info, nil
+}
+
+func (s *service) getBidListPageInfo(ctx context.Context, page util.Page, bids []bid.Bid, tenderId uuid.UUID, userId uuid.UUID) (util.PageInfo, error) {
+	var last util.Cursor
+	if len(bids) > 0 {
+		last = util.NewCursor(page.Sort, bids[len(bids)-1].Id, bids[len(bids)-1].SortValue)
+	}
+
+	info := util.NewPageInfo(page, len(bids), last)
+	if !page.WithTotal {
+		return info, nil
+	}
+
+	total, err := s.bidRepository.CountBidList(ctx, tenderId, userId)
+	if err != nil {
+		return util.PageInfo{}, err
 	}
+	info.Total = &total
 
-	return mapper.BidListToBidDtoList(bids), nil
+	return info, nil
 }
 
 func (s *service) GetBidStatus(ctx context.Context, bidId uuid.UUID, username string) (bid.Status, error) {
+	ctx, span := tracing.Start(ctx, "bid_service.get_bid_status")
+	defer span.End()
+
 	entity, err := s.bidRepository.GetBidById(ctx, bidId)
 	if err != nil {
 		return "", err
@@ -124,6 +202,13 @@ func (s *service) GetBidStatus(ctx context.Context, bidId uuid.UUID, username st
 		return bid.Published, nil
 	}
 
+	// withdrawals are shown to the tender organization as well as to the author
+	if entity.Status == bid.Withdrawn {
+		if err = s.tenderService.ValidateEmployeeRightsOnTender(ctx, entity.TenderId, username); err == nil {
+			return entity.Status, nil
+		}
+	}
+
 	if err = s.validateEmployeeRightsOnBid(ctx, bidId, username); err != nil {
 		return "", err
 	}
@@ -133,6 +218,9 @@ func (s *service) GetBidStatus(ctx context.Context, bidId uuid.UUID, username st
 
 func (s *service) UpdateBidStatus(ctx context.Context, bidId uuid.UUID, username string, status bid.Status) (dto.BidDto, error) {
 	op := "bid_service.update_bid_status"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
 	err := s.validateEmployeeRightsOnBid(ctx, bidId, username)
 	if err != nil {
 		return dto.BidDto{}, err
@@ -142,6 +230,19 @@ func (s *service) UpdateBidStatus(ctx context.Context, bidId uuid.UUID, username
 		return dto.BidDto{}, model.NewBadRequestError(op, errStatusCannotBeSelectedByOwner)
 	}
 
+	curBid, err := s.bidRepository.GetBidById(ctx, bidId)
+	if err != nil {
+		return dto.BidDto{}, err
+	}
+
+	if curBid.Status == bid.Withdrawn {
+		return dto.BidDto{}, model.NewConflictError(op, errBidWithdrawn)
+	}
+
+	if err = curBid.CheckTransition(status); err != nil {
+		return dto.BidDto{}, model.NewConflictError(op, err)
+	}
+
 	updated, err := s.bidRepository.UpdateBidStatus(ctx, bidId, status)
 	if err != nil {
 		return dto.BidDto{}, err
@@ -150,13 +251,27 @@ func (s *service) UpdateBidStatus(ctx context.Context, bidId uuid.UUID, username
 	return mapper.BidToBidDto(updated), err
 }
 
+// EditBid creates a new version of an undecided bid, votes cast on the previous version are dropped
 func (s *service) EditBid(ctx context.Context, bidId uuid.UUID, username string, bidDto dto.UpdateBidDto) (dto.BidDto, error) {
+	op := "bid_service.edit_bid"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
 	err := s.validateEmployeeRightsOnBid(ctx, bidId, username)
 	if err != nil {
 		return dto.BidDto{}, err
 	}
 
-	updated, err := s.bidRepository.UpdateBid(ctx, bidId, bidDto.Name, bidDto.Description)
+	curBid, err := s.bidRepository.GetBidById(ctx, bidId)
+	if err != nil {
+		return dto.BidDto{}, err
+	}
+
+	if !curBid.Editable() {
+		return dto.BidDto{}, model.NewConflictError(op, errBidNotEditable)
+	}
+
+	updated, err := s.bidRepository.UpdateBid(ctx, bidId, bidDto.Name, bidDto.Description, bidDto.Price)
 	if err != nil {
 		return dto.BidDto{}, err
 	}
@@ -166,80 +281,290 @@ func (s *service) EditBid(ctx context.Context, bidId uuid.UUID, username string,
 
 func (s *service) SubmitBidDecision(ctx context.Context, bidId uuid.UUID, username string, verdict decision.Verdict) (dto.BidDto, error) {
 	op := "bid_service.submit_bid_decision"
-	curBid, err := s.validateEmployeeRightsOnTenderByBid(ctx, bidId, username)
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	curBid, ten, err := s.getVotableBid(ctx, op, bidId, username)
 	if err != nil {
 		return dto.BidDto{}, err
 	}
 
-	if curBid.Status != bid.Published || curBid.Decision != bid.None {
-		return dto.BidDto{}, model.NewBadRequestError(op, errCannotVoteOnBid)
-	}
-
-	ten, err := s.tenderService.GetTenderById(ctx, curBid.TenderId)
+	_, err = s.decisionRepository.SaveDecision(ctx, decision.Decision{
+		Verdict:  verdict,
+		Username: username,
+		BidId:    bidId,
+	})
 	if err != nil {
 		return dto.BidDto{}, err
 	}
 
-	if ten.Status != tender.Published {
-		return dto.BidDto{}, model.NewBadRequestError(op, errTenderAlreadyClosed)
-	}
+	s.metrics.DecisionSubmitted(verdict)
 
 	if verdict == decision.Rejected {
-		updatedBid, err := s.bidRepository.UpdateBidDecision(ctx, curBid.Id, bid.Rejected) //-<<<<<<
+		updatedBid, err := s.bidRepository.UpdateBidDecision(ctx, curBid.Id, bid.Rejected)
 		if err != nil {
 			return dto.BidDto{}, err
 		}
-		fmt.Println("gb" + verdict)
 		return mapper.BidToBidDto(updatedBid), nil
 	}
 
-	_, err = s.decisionRepository.SaveDecision(ctx, decision.Decision{
-		Verdict:  verdict,
-		Username: username,
-		BidId:    bidId,
-	})
+	approveCount, err := s.decisionRepository.CountDecisionForBid(ctx, bidId, decision.Approved)
 	if err != nil {
 		return dto.BidDto{}, err
 	}
 
-	approveCount, err := s.decisionRepository.CountDecisionForBid(ctx, bidId)
+	organizationEmployeeCount, err := s.organizationService.GetOrganizationEmployeeCount(ctx, ten.OrganizationId)
 	if err != nil {
 		return dto.BidDto{}, err
 	}
 
-	organizationEmployeeCount, err := s.organizationService.GetOrganizationEmployeeCount(ctx, ten.OrganizationId)
+	if approveCount < quorum(organizationEmployeeCount) {
+		return mapper.BidToBidDto(curBid), nil
+	}
+
+	updated, err := s.awardBid(ctx, bidId)
 	if err != nil {
 		return dto.BidDto{}, err
 	}
 
-	if approveCount < min(organizationEmployeeCount, 3) {
-		return mapper.BidToBidDto(curBid), nil
+	return mapper.BidToBidDto(updated), nil
+}
+
+// awardBid closes the tender with the bid that reached the quorum
+func (s *service) awardBid(ctx context.Context, bidId uuid.UUID) (bid.Bid, error) {
+	if _, err := s.tenderService.AwardBid(ctx, bidId); err != nil {
+		return bid.Bid{}, err
 	}
 
-	updated, err := s.bidRepository.UpdateBidDecision(ctx, bidId, bid.Approved) //-<<<<<<
+	s.metrics.QuorumReached()
+
+	return s.bidRepository.GetBidById(ctx, bidId)
+}
+
+func (s *service) WithdrawBidDecision(ctx context.Context, bidId uuid.UUID, username string) (dto.BidDto, error) {
+	op := "bid_service.withdraw_bid_decision"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	curBid, _, err := s.getVotableBid(ctx, op, bidId, username)
 	if err != nil {
 		return dto.BidDto{}, err
 	}
 
-	_, err = s.tenderService.UpdateTenderStatus(ctx, updated.TenderId, username, tender.Closed)
+	deleted, err := s.decisionRepository.DeleteDecision(ctx, bidId, username)
 	if err != nil {
 		return dto.BidDto{}, err
 	}
 
-	return mapper.BidToBidDto(updated), err
+	if !deleted {
+		return dto.BidDto{}, model.NewNotFoundError(op, errDecisionNotFound)
+	}
+
+	return mapper.BidToBidDto(curBid), nil
+}
+
+// WithdrawBid takes a created or published bid without a decision out of the tender for good,
+// votes already cast on it are dropped
+func (s *service) WithdrawBid(ctx context.Context, bidId uuid.UUID, username, reason string) (dto.BidDto, error) {
+	op := "bid_service.withdraw_bid"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	if err := s.validateEmployeeRightsOnBid(ctx, bidId, username); err != nil {
+		return dto.BidDto{}, err
+	}
+
+	curBid, err := s.bidRepository.GetBidById(ctx, bidId)
+	if err != nil {
+		return dto.BidDto{}, err
+	}
+
+	if curBid.Status == bid.Withdrawn {
+		return dto.BidDto{}, model.NewConflictError(op, errBidWithdrawn)
+	}
+	if err = curBid.CheckTransition(bid.Withdrawn); err != nil {
+		return dto.BidDto{}, model.NewConflictError(op, err)
+	}
+
+	ten, err := s.tenderService.GetTenderById(ctx, curBid.TenderId)
+	if err != nil {
+		return dto.BidDto{}, err
+	}
+
+	if ten.Policy.DeadlinePassed(time.Now()) && !ten.Policy.AllowLateWithdrawal {
+		return dto.BidDto{}, model.NewForbiddenError(op, errLateWithdrawalForbidden)
+	}
+
+	withdrawn, err := s.bidRepository.WithdrawBid(ctx, bidId, reason)
+	if err != nil {
+		return dto.BidDto{}, err
+	}
+
+	s.metrics.BidWithdrawn()
+
+	return mapper.BidToBidDto(withdrawn), nil
 }
 
-func (s *service) CreateBidFeedback(ctx context.Context, bidId uuid.UUID, bidFeedback, username string) (dto.BidDto, error) {
+// RecomputeBidDecisions derives decisions of the published tender bids from stored votes again and returns the bids
+// whose decision changed. Approved bids are never downgraded and a bid reaching the quorum is awarded like on a vote,
+// tenders that are not published or already have an award are left as they are
+func (s *service) RecomputeBidDecisions(ctx context.Context, tenderId uuid.UUID) ([]bid.Bid, error) {
+	ctx, span := tracing.Start(ctx, "bid_service.recompute_bid_decisions")
+	defer span.End()
+
+	ten, err := s.tenderService.GetTenderById(ctx, tenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := make([]bid.Bid, 0)
+	if ten.Status != tender.Published {
+		return changed, nil
+	}
+
+	_, err = s.awardRepository.GetAwardByTenderId(ctx, tenderId)
+	if err == nil {
+		return changed, nil
+	}
+	var apiErr model.ApiError
+	if !errors.As(err, &apiErr) || apiErr.Code != model.NotFoundCode {
+		return nil, err
+	}
+
+	count, err := s.bidRepository.CountBidList(ctx, tenderId, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+
+	bids, err := s.bidRepository.GetBidList(ctx, util.Page{Limit: count}, tenderId, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+
+	organizationEmployeeCount, err := s.organizationService.GetOrganizationEmployeeCount(ctx, ten.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	var winnerId *uuid.UUID
+	for _, b := range bids {
+		// approved bids and bids left by an award or a cancelled tender keep their outcome
+		if b.Decision != bid.None && b.Decision != bid.Rejected {
+			continue
+		}
+
+		rejectCount, err := s.decisionRepository.CountDecisionForBid(ctx, b.Id, decision.Rejected)
+		if err != nil {
+			return nil, err
+		}
+
+		approveCount, err := s.decisionRepository.CountDecisionForBid(ctx, b.Id, decision.Approved)
+		if err != nil {
+			return nil, err
+		}
+
+		dec := bid.None
+		switch {
+		case rejectCount > 0:
+			dec = bid.Rejected
+		case approveCount > 0 && approveCount >= quorum(organizationEmployeeCount):
+			// only one bid can win, the first one reaching the quorum is awarded once the rejections are stored
+			if winnerId == nil {
+				winnerId = &b.Id
+			}
+			continue
+		}
+
+		if dec == b.Decision {
+			continue
+		}
+
+		updated, err := s.bidRepository.UpdateBidDecision(ctx, b.Id, dec)
+		if err != nil {
+			return nil, err
+		}
+		changed = append(changed, updated)
+	}
+
+	if winnerId != nil {
+		awarded, err := s.awardBid(ctx, *winnerId)
+		if err != nil {
+			return nil, err
+		}
+		changed = append(changed, awarded)
+	}
+
+	return changed, nil
+}
+
+// quorum is the number of approvals a bid needs, small organizations approve with every employee
+func quorum(organizationEmployeeCount int) int {
+	return min(organizationEmployeeCount, 3)
+}
+
+func (s *service) GetBidDecisions(ctx context.Context, bidId uuid.UUID, username string) ([]dto.DecisionDto, error) {
+	ctx, span := tracing.Start(ctx, "bid_service.get_bid_decisions")
+	defer span.End()
+
+	if _, err := s.validateEmployeeRightsOnTenderByBid(ctx, bidId, username); err != nil {
+		return nil, err
+	}
+
+	decisions, err := s.decisionRepository.GetDecisionsForBid(ctx, bidId)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.DecisionListToDecisionDtoList(decisions), nil
+}
+
+// getVotableBid returns the bid and its tender while votes on the bid can still be cast or changed
+func (s *service) getVotableBid(ctx context.Context, op string, bidId uuid.UUID, username string) (bid.Bid, tender.Tender, error) {
+	curBid, err := s.validateEmployeeRightsOnTenderByBid(ctx, bidId, username)
+	if err != nil {
+		return bid.Bid{}, tender.Tender{}, err
+	}
+
+	if curBid.Status != bid.Published || curBid.Decision != bid.None {
+		return bid.Bid{}, tender.Tender{}, model.NewBadRequestError(op, errCannotVoteOnBid)
+	}
+
+	ten, err := s.tenderService.GetTenderById(ctx, curBid.TenderId)
+	if err != nil {
+		return bid.Bid{}, tender.Tender{}, err
+	}
+
+	if ten.Status != tender.Published {
+		return bid.Bid{}, tender.Tender{}, model.NewBadRequestError(op, errTenderAlreadyClosed)
+	}
+
+	return curBid, ten, nil
+}
+
+// CreateBidFeedback optionally rates the bid author, the rating counts towards the author reputation
+func (s *service) CreateBidFeedback(ctx context.Context, bidId uuid.UUID, bidFeedback, username string, scores *dto.RatingScoresDto) (dto.BidDto, error) {
+	ctx, span := tracing.Start(ctx, "bid_service.create_bid_feedback")
+	defer span.End()
+
 	entity, err := s.validateEmployeeRightsOnTenderByBid(ctx, bidId, username)
 	if err != nil {
 		return dto.BidDto{}, err
 	}
 
-	_, err = s.feedbackRepository.SaveFeedback(ctx, entity2.Feedback{
+	feedback := entity2.Feedback{
 		BidId:       bidId,
 		Description: bidFeedback,
 		Username:    username,
-	})
+	}
+
+	if scores == nil {
+		_, err = s.feedbackRepository.SaveFeedback(ctx, feedback)
+	} else {
+		feedbackRating := mapper.RatingScoresDtoToRating(*scores)
+		feedbackRating.SupplierId = entity.AuthorId
+		feedbackRating.TenderId = entity.TenderId
+		_, err = s.feedbackRepository.SaveRatedFeedback(ctx, feedback, feedbackRating)
+	}
 	if err != nil {
 		return dto.BidDto{}, err
 	}
@@ -249,19 +574,26 @@ func (s *service) CreateBidFeedback(ctx context.Context, bidId uuid.UUID, bidFee
 
 func (s *service) RollbackBid(ctx context.Context, bidId uuid.UUID, username string, version int) (dto.BidDto, error) {
 	op := "bid_service.rollback_bid"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
 	curBid, err := s.bidRepository.GetBidById(ctx, bidId)
 	if err != nil {
 		return dto.BidDto{}, err
 	}
 
 	if curBid.Version < version {
-		return dto.BidDto{}, model.NewBadRequestError(op, errBidVersionDontExists)
+		return dto.BidDto{}, model.NewBadRequestError(op, errBidVersionNotFound)
 	}
 
 	if err = s.validateEmployeeRightsOnBid(ctx, bidId, username); err != nil {
 		return dto.BidDto{}, err
 	}
 
+	if !curBid.Editable() {
+		return dto.BidDto{}, model.NewConflictError(op, errBidNotEditable)
+	}
+
 	updated, err := s.bidRepository.RollbackBid(ctx, bidId, version)
 	if err != nil {
 		return dto.BidDto{}, err
@@ -270,26 +602,43 @@ func (s *service) RollbackBid(ctx context.Context, bidId uuid.UUID, username str
 	return mapper.BidToBidDto(updated), err
 }
 
-func (s *service) GetBidReviews(ctx context.Context, page util.Page, tenderId uuid.UUID, authorUsername, requesterUsername string) ([]dto.FeedbackDto, error) {
+func (s *service) GetBidReviews(ctx context.Context, page util.Page, tenderId uuid.UUID, authorUsername, requesterUsername string) ([]dto.FeedbackDto, util.PageInfo, error) {
 	op := "bid_service.get_bid_reviews"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
 	if err := s.tenderService.ValidateEmployeeRightsOnTender(ctx, tenderId, requesterUsername); err != nil {
-		return nil, err
+		return nil, util.PageInfo{}, err
 	}
 
 	author, err := s.employeeService.GetEmployeeByUsername(ctx, authorUsername)
 	if err != nil {
-		return nil, err
+		return nil, util.PageInfo{}, err
 	}
 
-	feedback, err := s.feedbackRepository.GetFeedbackListForGroup(ctx, tenderId, author.Id)
+	feedback, err := s.feedbackRepository.GetFeedbackListForGroup(ctx, page, tenderId, author.Id)
 	if err != nil {
-		return nil, err
+		return nil, util.PageInfo{}, err
 	}
-	if len(feedback) == 0 {
-		return nil, model.NewNotFoundError(op, errNoReviewsFound)
+	if len(feedback) == 0 && page.Cursor == nil {
+		return nil, util.PageInfo{}, model.NewNotFoundError(op, errNoReviewsFound)
+	}
+
+	var last util.Cursor
+	if len(feedback) > 0 {
+		last = util.NewCursor(page.Sort, feedback[len(feedback)-1].Id, feedback[len(feedback)-1].SortValue)
+	}
+
+	info := util.NewPageInfo(page, len(feedback), last)
+	if page.WithTotal {
+		total, err := s.feedbackRepository.CountFeedbackForGroup(ctx, tenderId, author.Id)
+		if err != nil {
+			return nil, util.PageInfo{}, err
+		}
+		info.Total = &total
 	}
 
-	return mapper.FeedbackListToFeedBackDtoList(feedback), nil
+	return mapper.FeedbackListToFeedBackDtoList(feedback), info, nil
 }
 
 func (s *service) validateEmployeeRightsOnTenderByBid(ctx context.Context, bidId uuid.UUID, username string) (bid.Bid, error) {
@@ -318,7 +667,7 @@ func (s *service) validateEmployeeRightsOnBid(ctx context.Context, bidId uuid.UU
 			return err
 		}
 		if entity.AuthorId != curUser.Id {
-			return model.NewForbiddenError(op, errEmployeeNotBidAuhtor)
+			return model.NewForbiddenError(op, errEmployeeNotBidAuthor)
 		}
 	} else {
 		ok, err := s.organizationService.UsersHasSimilarOrganization(ctx, entity.AuthorId, username)
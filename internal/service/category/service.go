@@ -0,0 +1,246 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"tender-service/internal/i18n"
+	"tender-service/internal/mapper"
+	"tender-service/internal/model"
+	"tender-service/internal/model/dto"
+	"tender-service/internal/model/entity/tender"
+	"tender-service/internal/repository"
+	"tender-service/internal/tracing"
+)
+
+type service struct {
+	categoryRepository repository.CategoryRepository
+}
+
+var (
+	errIncorrectServiceType     = i18n.NewError(i18n.FilterIncorrectServiceType)
+	errUnknownCategory          = i18n.NewError(i18n.CategoryUnknown)
+	errParentCategoryNotFound   = i18n.NewError(i18n.CategoryParentNotFound)
+	errCategoryCycle            = i18n.NewError(i18n.CategoryCycle)
+	errCategoryHasChildren      = i18n.NewError(i18n.CategoryHasChildren)
+	errCategoryInUse            = i18n.NewError(i18n.CategoryInUse)
+	errServiceTypeMismatch      = i18n.NewError(i18n.CategoryServiceTypeMismatch)
+	errLegacyCategoryNotRemoved = i18n.NewError(i18n.CategoryLegacyNotRemoved)
+)
+
+func NewCategoryService(categoryRepository repository.CategoryRepository) *service {
+	return &service{categoryRepository: categoryRepository}
+}
+
+func (s *service) CreateCategory(ctx context.Context, categoryDto dto.CreateCategoryDto) (dto.CategoryDto, error) {
+	op := "category_service.create_category"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	if categoryDto.ParentId != nil {
+		if err := s.validateParentExists(ctx, op, *categoryDto.ParentId); err != nil {
+			return dto.CategoryDto{}, err
+		}
+	}
+
+	saved, err := s.categoryRepository.SaveCategory(ctx, mapper.CreateCategoryDtoToCategory(categoryDto))
+	if err != nil {
+		return dto.CategoryDto{}, err
+	}
+
+	return mapper.CategoryToCategoryDto(saved), nil
+}
+
+func (s *service) GetCategories(ctx context.Context) ([]dto.CategoryDto, error) {
+	ctx, span := tracing.Start(ctx, "category_service.get_categories")
+	defer span.End()
+
+	categories, err := s.categoryRepository.GetCategoryList(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.CategoryListToCategoryDtoList(categories), nil
+}
+
+func (s *service) GetCategory(ctx context.Context, categoryId uuid.UUID) (dto.CategoryDto, error) {
+	ctx, span := tracing.Start(ctx, "category_service.get_category")
+	defer span.End()
+
+	category, err := s.categoryRepository.GetCategoryById(ctx, categoryId)
+	if err != nil {
+		return dto.CategoryDto{}, err
+	}
+
+	return mapper.CategoryToCategoryDto(category), nil
+}
+
+func (s *service) UpdateCategory(ctx context.Context, categoryId uuid.UUID, categoryDto dto.UpdateCategoryDto) (dto.CategoryDto, error) {
+	op := "category_service.update_category"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	category, err := s.categoryRepository.GetCategoryById(ctx, categoryId)
+	if err != nil {
+		return dto.CategoryDto{}, err
+	}
+
+	if categoryDto.Code != "" {
+		category.Code = categoryDto.Code
+	}
+
+	if categoryDto.Name != "" {
+		category.Name = categoryDto.Name
+	}
+
+	if categoryDto.ParentId != nil {
+		if *categoryDto.ParentId == uuid.Nil {
+			category.ParentId = nil
+		} else {
+			if err = s.validateParentExists(ctx, op, *categoryDto.ParentId); err != nil {
+				return dto.CategoryDto{}, err
+			}
+
+			cycle, err := s.categoryRepository.IsCategoryAncestor(ctx, *categoryDto.ParentId, categoryId)
+			if err != nil {
+				return dto.CategoryDto{}, err
+			}
+			if cycle {
+				return dto.CategoryDto{}, model.NewBadRequestError(op, errCategoryCycle)
+			}
+
+			category.ParentId = categoryDto.ParentId
+		}
+	}
+
+	updated, err := s.categoryRepository.UpdateCategory(ctx, category)
+	if err != nil {
+		return dto.CategoryDto{}, err
+	}
+
+	return mapper.CategoryToCategoryDto(updated), nil
+}
+
+func (s *service) DeleteCategory(ctx context.Context, categoryId uuid.UUID) error {
+	op := "category_service.delete_category"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	category, err := s.categoryRepository.GetCategoryById(ctx, categoryId)
+	if err != nil {
+		return err
+	}
+
+	if category.ServiceType != nil {
+		return model.NewBadRequestError(op, errLegacyCategoryNotRemoved)
+	}
+
+	children, err := s.categoryRepository.CountCategoryChildren(ctx, categoryId)
+	if err != nil {
+		return err
+	}
+	if children > 0 {
+		return model.NewBadRequestError(op, errCategoryHasChildren)
+	}
+
+	tenders, err := s.categoryRepository.CountCategoryTenders(ctx, categoryId)
+	if err != nil {
+		return err
+	}
+	if tenders > 0 {
+		return model.NewBadRequestError(op, errCategoryInUse)
+	}
+
+	return s.categoryRepository.DeleteCategory(ctx, categoryId)
+}
+
+func (s *service) ResolveTenderCategories(ctx context.Context, serviceType tender.ServiceType, categoryIds []uuid.UUID) (tender.ServiceType, []uuid.UUID, error) {
+	op := "category_service.resolve_tender_categories"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	if serviceType != "" && !tender.IsServiceType(string(serviceType)) {
+		return "", nil, model.NewBadRequestError(op, errIncorrectServiceType)
+	}
+
+	if len(categoryIds) == 0 {
+		if serviceType == "" {
+			return "", nil, nil
+		}
+
+		category, err := s.categoryRepository.GetCategoryByServiceType(ctx, serviceType)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return serviceType, []uuid.UUID{category.Id}, nil
+	}
+
+	categoryIds = uniqueCategoryIds(categoryIds)
+
+	if err := s.ValidateCategoriesExist(ctx, categoryIds); err != nil {
+		return "", nil, err
+	}
+
+	// categories outside of the legacy roots leave the service type empty, the ones inside must agree on it
+	resolved := serviceType
+	for _, categoryId := range categoryIds {
+		categoryServiceType, err := s.categoryRepository.GetCategoryServiceType(ctx, categoryId)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if categoryServiceType == "" {
+			continue
+		}
+		if resolved != "" && resolved != categoryServiceType {
+			return "", nil, model.NewBadRequestError(op, errServiceTypeMismatch)
+		}
+		resolved = categoryServiceType
+	}
+
+	return resolved, categoryIds, nil
+}
+
+func (s *service) ValidateCategoriesExist(ctx context.Context, categoryIds []uuid.UUID) error {
+	op := "category_service.validate_categories_exist"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	categoryIds = uniqueCategoryIds(categoryIds)
+
+	categories, err := s.categoryRepository.GetCategoryList(ctx, categoryIds)
+	if err != nil {
+		return err
+	}
+	if len(categories) != len(categoryIds) {
+		return model.NewBadRequestError(op, errUnknownCategory)
+	}
+	return nil
+}
+
+func (s *service) validateParentExists(ctx context.Context, op string, parentId uuid.UUID) error {
+	if _, err := s.categoryRepository.GetCategoryById(ctx, parentId); err != nil {
+		var apiErr model.ApiError
+		if errors.As(err, &apiErr) && apiErr.Code == model.NotFoundCode {
+			return model.NewBadRequestError(op, errParentCategoryNotFound)
+		}
+		return err
+	}
+	return nil
+}
+
+func uniqueCategoryIds(categoryIds []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(categoryIds))
+	result := make([]uuid.UUID, 0, len(categoryIds))
+
+	for _, id := range categoryIds {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		result = append(result, id)
+	}
+
+	return result
+}
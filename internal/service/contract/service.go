@@ -0,0 +1,271 @@
+package contract
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"slices"
+	"tender-service/internal/i18n"
+	"tender-service/internal/mapper"
+	"tender-service/internal/metrics"
+	"tender-service/internal/model"
+	"tender-service/internal/model/dto"
+	"tender-service/internal/model/entity/bid"
+	"tender-service/internal/model/entity/contract"
+	"tender-service/internal/repository"
+	service2 "tender-service/internal/service"
+	"tender-service/internal/tracing"
+	"time"
+)
+
+type service struct {
+	contractRepository  repository.ContractRepository
+	ratingRepository    repository.RatingRepository
+	bidRepository       repository.BidRepository
+	tenderService       service2.TenderService
+	employeeService     service2.EmployeeService
+	organizationService service2.OrganizationService
+	metrics             *metrics.Metrics
+}
+
+var (
+	errIncorrectTerm        = i18n.NewError(i18n.ContractIncorrectTerm)
+	errMilestoneOutsideTerm = i18n.NewError(i18n.ContractMilestoneOutsideTerm)
+	errEmployeeNotParty     = i18n.NewError(i18n.ContractNotParty)
+	errEmployeeNotCustomer  = i18n.NewError(i18n.ContractNotCustomer)
+	errContractNotCompleted = i18n.NewError(i18n.ContractNotCompleted)
+	errRatingNotCustomer    = i18n.NewError(i18n.ContractRatingNotCustomer)
+)
+
+func NewContractService(
+	contractRepository repository.ContractRepository,
+	ratingRepository repository.RatingRepository,
+	bidRepository repository.BidRepository,
+	tenderService service2.TenderService,
+	employeeService service2.EmployeeService,
+	organizationService service2.OrganizationService,
+	metrics *metrics.Metrics,
+) *service {
+	return &service{
+		contractRepository:  contractRepository,
+		ratingRepository:    ratingRepository,
+		bidRepository:       bidRepository,
+		tenderService:       tenderService,
+		employeeService:     employeeService,
+		organizationService: organizationService,
+		metrics:             metrics,
+	}
+}
+
+// CreateContract is done by the tender organization once the tender is awarded, the value defaults to the awarded amount
+func (s *service) CreateContract(ctx context.Context, contractDto dto.CreateContractDto, username string) (dto.ContractDto, error) {
+	op := "contract_service.create_contract"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	if err := s.tenderService.ValidateEmployeeRightsOnTender(ctx, contractDto.TenderId, username); err != nil {
+		return dto.ContractDto{}, err
+	}
+
+	award, err := s.tenderService.GetTenderAward(ctx, contractDto.TenderId)
+	if err != nil {
+		return dto.ContractDto{}, err
+	}
+
+	newContract := mapper.CreateContractDtoToContract(contractDto)
+	if !newContract.EndDate.After(newContract.StartDate) {
+		return dto.ContractDto{}, model.NewBadRequestError(op, errIncorrectTerm)
+	}
+	for _, milestone := range newContract.Milestones {
+		if !newContract.WithinTerm(milestone.DueDate) {
+			return dto.ContractDto{}, model.NewBadRequestError(op, errMilestoneOutsideTerm)
+		}
+	}
+
+	ten, err := s.tenderService.GetTenderById(ctx, award.TenderId)
+	if err != nil {
+		return dto.ContractDto{}, err
+	}
+
+	winner, err := s.bidRepository.GetBidById(ctx, award.BidId)
+	if err != nil {
+		return dto.ContractDto{}, err
+	}
+
+	newContract.AwardId = award.Id
+	newContract.BidId = award.BidId
+	newContract.CustomerOrganizationId = ten.OrganizationId
+	newContract.SupplierType = winner.AuthorType
+	newContract.SupplierId = winner.AuthorId
+	if newContract.Value == nil {
+		newContract.Value = award.Amount
+	}
+
+	saved, err := s.contractRepository.SaveContract(ctx, newContract)
+	if err != nil {
+		return dto.ContractDto{}, err
+	}
+
+	s.metrics.ContractCreated()
+
+	return mapper.ContractToContractDto(saved), nil
+}
+
+func (s *service) GetContract(ctx context.Context, contractId uuid.UUID, username string) (dto.ContractDto, error) {
+	ctx, span := tracing.Start(ctx, "contract_service.get_contract")
+	defer span.End()
+
+	found, err := s.contractRepository.GetContractById(ctx, contractId)
+	if err != nil {
+		return dto.ContractDto{}, err
+	}
+
+	if _, err = s.validateParty(ctx, found, username); err != nil {
+		return dto.ContractDto{}, err
+	}
+
+	return mapper.ContractToContractDto(found), nil
+}
+
+func (s *service) GetUserContracts(ctx context.Context, username string) ([]dto.ContractDto, error) {
+	ctx, span := tracing.Start(ctx, "contract_service.get_user_contracts")
+	defer span.End()
+
+	user, err := s.employeeService.GetEmployeeByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	contracts, err := s.contractRepository.GetContractList(ctx, user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ContractListToContractDtoList(contracts), nil
+}
+
+// AddContractMilestone lets the tender organization extend the schedule within the contract term
+func (s *service) AddContractMilestone(ctx context.Context, contractId uuid.UUID, username string, milestoneDto dto.CreateMilestoneDto) (dto.MilestoneDto, error) {
+	op := "contract_service.add_contract_milestone"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	found, err := s.contractRepository.GetContractById(ctx, contractId)
+	if err != nil {
+		return dto.MilestoneDto{}, err
+	}
+
+	customer, err := s.validateParty(ctx, found, username)
+	if err != nil {
+		return dto.MilestoneDto{}, err
+	}
+	if !customer {
+		return dto.MilestoneDto{}, model.NewForbiddenError(op, errEmployeeNotCustomer)
+	}
+
+	milestone := mapper.CreateMilestoneDtoToMilestone(milestoneDto)
+	if !found.WithinTerm(milestone.DueDate) {
+		return dto.MilestoneDto{}, model.NewBadRequestError(op, errMilestoneOutsideTerm)
+	}
+
+	milestone.ContractId = contractId
+	saved, err := s.contractRepository.SaveMilestone(ctx, milestone)
+	if err != nil {
+		return dto.MilestoneDto{}, err
+	}
+
+	return mapper.MilestoneToMilestoneDto(saved), nil
+}
+
+func (s *service) CompleteContractMilestone(ctx context.Context, contractId, milestoneId uuid.UUID, username string) (dto.MilestoneDto, error) {
+	ctx, span := tracing.Start(ctx, "contract_service.complete_contract_milestone")
+	defer span.End()
+
+	found, err := s.contractRepository.GetContractById(ctx, contractId)
+	if err != nil {
+		return dto.MilestoneDto{}, err
+	}
+
+	if _, err = s.validateParty(ctx, found, username); err != nil {
+		return dto.MilestoneDto{}, err
+	}
+
+	completed, err := s.contractRepository.CompleteMilestone(ctx, contractId, milestoneId, username)
+	if err != nil {
+		return dto.MilestoneDto{}, err
+	}
+
+	return mapper.MilestoneToMilestoneDto(completed), nil
+}
+
+// RateContract lets the tender organization rate the supplier once the contract is completed
+func (s *service) RateContract(ctx context.Context, contractId uuid.UUID, username string, scoresDto dto.RatingScoresDto) (dto.RatingDto, error) {
+	op := "contract_service.rate_contract"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	found, err := s.contractRepository.GetContractById(ctx, contractId)
+	if err != nil {
+		return dto.RatingDto{}, err
+	}
+
+	customer, err := s.validateParty(ctx, found, username)
+	if err != nil {
+		return dto.RatingDto{}, err
+	}
+	if !customer {
+		return dto.RatingDto{}, model.NewForbiddenError(op, errRatingNotCustomer)
+	}
+
+	if !found.Completed(time.Now()) {
+		return dto.RatingDto{}, model.NewConflictError(op, errContractNotCompleted)
+	}
+
+	contractRating := mapper.RatingScoresDtoToRating(scoresDto)
+	contractRating.SupplierId = found.SupplierId
+	contractRating.TenderId = found.TenderId
+	contractRating.BidId = found.BidId
+	contractRating.ContractId = &found.Id
+	contractRating.Username = username
+
+	saved, err := s.ratingRepository.SaveContractRating(ctx, contractRating)
+	if err != nil {
+		return dto.RatingDto{}, err
+	}
+
+	return mapper.RatingToRatingDto(saved), nil
+}
+
+// validateParty reports whether the employee represents the customer, supplier representatives get false
+func (s *service) validateParty(ctx context.Context, c contract.Contract, username string) (bool, error) {
+	op := "contract_service.validate_party"
+
+	user, err := s.employeeService.GetEmployeeByUsername(ctx, username)
+	if err != nil {
+		return false, err
+	}
+
+	organizationIds, err := s.organizationService.GetEmployeeOrganizationIds(ctx, username)
+	if err != nil {
+		return false, err
+	}
+	if slices.Contains(organizationIds, c.CustomerOrganizationId) {
+		return true, nil
+	}
+
+	if c.SupplierType == bid.AuthorUser {
+		if c.SupplierId == user.Id {
+			return false, nil
+		}
+		return false, model.NewForbiddenError(op, errEmployeeNotParty)
+	}
+
+	ok, err := s.organizationService.UsersHasSimilarOrganization(ctx, c.SupplierId, username)
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		return false, model.NewForbiddenError(op, errEmployeeNotParty)
+	}
+
+	return false, nil
+}
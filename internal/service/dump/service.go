@@ -0,0 +1,64 @@
+package dump
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"tender-service/internal/model/entity/dump"
+	"tender-service/internal/repository"
+	"tender-service/internal/tracing"
+	"time"
+)
+
+type service struct {
+	dumpRepository repository.DumpRepository
+}
+
+func NewDumpService(dumpRepository repository.DumpRepository) *service {
+	return &service{dumpRepository: dumpRepository}
+}
+
+func (s *service) Export(ctx context.Context, w io.Writer) error {
+	ctx, span := tracing.Start(ctx, "dump_service.export")
+	defer span.End()
+
+	result := dump.Dump{
+		CreatedAt: time.Now().UTC(),
+		Tables:    make([]dump.Table, 0, len(dump.Tables)),
+	}
+
+	for _, name := range dump.Tables {
+		rows, err := s.dumpRepository.ExportTable(ctx, name)
+		if err != nil {
+			return fmt.Errorf("cannot export %s: %w", name, err)
+		}
+		result.Tables = append(result.Tables, dump.Table{Name: name, Rows: rows})
+	}
+
+	return json.NewEncoder(w).Encode(result)
+}
+
+// Import accepts dumps with any subset of tables and imports them in dump.Tables order
+func (s *service) Import(ctx context.Context, r io.Reader) ([]dump.ImportedTable, error) {
+	ctx, span := tracing.Start(ctx, "dump_service.import")
+	defer span.End()
+
+	var source dump.Dump
+	if err := json.NewDecoder(r).Decode(&source); err != nil {
+		return nil, fmt.Errorf("cannot read dump: %w", err)
+	}
+
+	for _, table := range source.Tables {
+		if !slices.Contains(dump.Tables, table.Name) {
+			return nil, fmt.Errorf("unknown table %s in dump", table.Name)
+		}
+	}
+
+	slices.SortStableFunc(source.Tables, func(a, b dump.Table) int {
+		return slices.Index(dump.Tables, a.Name) - slices.Index(dump.Tables, b.Name)
+	})
+
+	return s.dumpRepository.ImportTables(ctx, source.Tables)
+}
@@ -2,15 +2,16 @@ package employee
 
 import (
 	"context"
-	"fmt"
 	"github.com/google/uuid"
+	"tender-service/internal/i18n"
 	"tender-service/internal/model"
 	"tender-service/internal/model/entity"
 	"tender-service/internal/repository"
+	"tender-service/internal/tracing"
 )
 
 var (
-	errEmployeeDoesNotExists = fmt.Errorf("employee does not exists")
+	errEmployeeNotFound = i18n.NewError(i18n.EmployeeNotFound)
 )
 
 type service struct {
@@ -21,35 +22,54 @@ func NewEmployeeService(employeeRepository repository.EmployeeRepository) *servi
 	return &service{employeeRepository: employeeRepository}
 }
 
+func (s *service) CreateEmployee(ctx context.Context, employee entity.Employee) (entity.Employee, error) {
+	ctx, span := tracing.Start(ctx, "employee_service.create_employee")
+	defer span.End()
+
+	return s.employeeRepository.SaveEmployee(ctx, employee)
+}
+
 func (s *service) GetEmployeeByUsername(ctx context.Context, username string) (entity.Employee, error) {
+	ctx, span := tracing.Start(ctx, "employee_service.get_employee_by_username")
+	defer span.End()
+
 	return s.employeeRepository.GetEmployeeByUsername(ctx, username)
 }
 
 func (s *service) ValidateEmployeeExistsByUsername(ctx context.Context, username string) error {
 	op := "employee_service.validate_employee_exists"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
 	exists, err := s.employeeRepository.EmployeeExistByUsername(ctx, username)
 	if err != nil {
 		return err
 	}
 	if !exists {
-		return model.NewNotAuthorizedError(op, errEmployeeDoesNotExists)
+		return model.NewNotAuthorizedError(op, errEmployeeNotFound)
 	}
 
 	return nil
 }
 
 func (s *service) GetEmployeeByUsernameById(ctx context.Context, id uuid.UUID) (entity.Employee, error) {
+	ctx, span := tracing.Start(ctx, "employee_service.get_employee_by_username_by_id")
+	defer span.End()
+
 	return s.employeeRepository.GetEmployeeById(ctx, id)
 }
 
 func (s *service) ValidateEmployeeExistsById(ctx context.Context, id uuid.UUID) error {
 	op := "employee_service.validate_employee_exists"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
 	exists, err := s.employeeRepository.EmployeeExistById(ctx, id)
 	if err != nil {
 		return err
 	}
 	if !exists {
-		return model.NewNotAuthorizedError(op, errEmployeeDoesNotExists)
+		return model.NewNotAuthorizedError(op, errEmployeeNotFound)
 	}
 
 	return nil
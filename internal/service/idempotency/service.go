@@ -0,0 +1,92 @@
+package idempotency
+
+import (
+	"context"
+	"tender-service/internal/i18n"
+	"tender-service/internal/model"
+	"tender-service/internal/model/entity/idempotency"
+	"tender-service/internal/repository"
+	"tender-service/internal/tracing"
+	"time"
+)
+
+type service struct {
+	idempotencyRepository repository.IdempotencyRepository
+	ttl                   time.Duration
+}
+
+const (
+	maxKeyLength = 255
+	fallbackTTL  = 24 * time.Hour
+)
+
+var (
+	errIncorrectKey     = i18n.NewError(i18n.IdempotencyKeyIncorrect, maxKeyLength)
+	errKeyReused        = i18n.NewError(i18n.IdempotencyKeyReused)
+	errRequestInProcess = i18n.NewError(i18n.IdempotencyKeyInProgress)
+)
+
+func NewIdempotencyService(idempotencyRepository repository.IdempotencyRepository, ttl time.Duration) *service {
+	if ttl <= 0 {
+		ttl = fallbackTTL
+	}
+	return &service{
+		idempotencyRepository: idempotencyRepository,
+		ttl:                   ttl,
+	}
+}
+
+func (s *service) BeginRequest(ctx context.Context, key, caller, requestHash string) (idempotency.Key, bool, error) {
+	op := "idempotency_service.begin_request"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	if key == "" || len(key) > maxKeyLength {
+		return idempotency.Key{}, false, model.NewBadRequestError(op, errIncorrectKey)
+	}
+
+	stored, reserved, err := s.idempotencyRepository.ReserveIdempotencyKey(ctx, idempotency.Key{
+		Key:         key,
+		Caller:      caller,
+		RequestHash: requestHash,
+		ExpiresAt:   time.Now().Add(s.ttl),
+	})
+	if err != nil {
+		return idempotency.Key{}, false, model.NewInternalServerError(op, err)
+	}
+
+	if reserved {
+		return stored, false, nil
+	}
+
+	if stored.RequestHash != requestHash {
+		return idempotency.Key{}, false, model.NewUnprocessableEntityError(op, errKeyReused)
+	}
+
+	if !stored.Completed() {
+		return idempotency.Key{}, false, model.NewConflictError(op, errRequestInProcess)
+	}
+
+	return stored, true, nil
+}
+
+func (s *service) CompleteRequest(ctx context.Context, key, caller string, statusCode int, contentType string, body []byte) error {
+	ctx, span := tracing.Start(ctx, "idempotency_service.complete_request")
+	defer span.End()
+
+	return s.idempotencyRepository.CompleteIdempotencyKey(ctx, key, caller, statusCode, contentType, body)
+}
+
+func (s *service) AbandonRequest(ctx context.Context, key, caller string) error {
+	ctx, span := tracing.Start(ctx, "idempotency_service.abandon_request")
+	defer span.End()
+
+	return s.idempotencyRepository.DeleteIdempotencyKey(ctx, key, caller)
+}
+
+func (s *service) DeleteExpiredKeys(ctx context.Context) (int64, error) {
+	ctx, span := tracing.Start(ctx, "idempotency_service.delete_expired_keys")
+	defer span.End()
+
+	return s.idempotencyRepository.DeleteExpiredIdempotencyKeys(ctx, time.Now())
+}
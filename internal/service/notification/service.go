@@ -0,0 +1,134 @@
+package notification
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"log/slog"
+	"tender-service/internal/model/entity/notification"
+	"tender-service/internal/notifier"
+	"tender-service/internal/repository"
+	"tender-service/internal/tracing"
+	"time"
+)
+
+type service struct {
+	notificationRepository repository.NotificationRepository
+	notifier               notifier.Notifier
+	batchSize              int
+	maxAttempts            int
+}
+
+const (
+	fallbackBatchSize   = 50
+	fallbackMaxAttempts = 5
+	claimLease          = 5 * time.Minute
+	retryBackoff        = 10 * time.Second
+	maxRetryBackoff     = time.Hour
+)
+
+func NewNotificationService(
+	notificationRepository repository.NotificationRepository,
+	notifier notifier.Notifier,
+	batchSize int,
+	maxAttempts int,
+) *service {
+	if batchSize <= 0 {
+		batchSize = fallbackBatchSize
+	}
+	if maxAttempts <= 0 {
+		maxAttempts = fallbackMaxAttempts
+	}
+	return &service{
+		notificationRepository: notificationRepository,
+		notifier:               notifier,
+		batchSize:              batchSize,
+		maxAttempts:            maxAttempts,
+	}
+}
+
+func (s *service) EnqueueTenderAlerts(ctx context.Context, tenderId uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "notification_service.enqueue_tender_alerts")
+	defer span.End()
+
+	count, err := s.notificationRepository.EnqueueTenderAlerts(ctx, tenderId)
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		slog.InfoContext(ctx, "enqueued tender alerts", slog.Int("count", count), slog.String("tender_id", tenderId.String()))
+	}
+
+	return nil
+}
+
+func (s *service) EnqueueTenderCancellationNotices(ctx context.Context, tenderId uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "notification_service.enqueue_tender_cancellation_notices")
+	defer span.End()
+
+	count, err := s.notificationRepository.EnqueueTenderCancellationNotices(ctx, tenderId)
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		slog.InfoContext(ctx, "enqueued tender cancellation notices", slog.Int("count", count), slog.String("tender_id", tenderId.String()))
+	}
+
+	return nil
+}
+
+func (s *service) ReplayNotifications(ctx context.Context, filter notification.ReplayFilter) (int, error) {
+	ctx, span := tracing.Start(ctx, "notification_service.replay_notifications")
+	defer span.End()
+
+	count, err := s.notificationRepository.ReplayNotifications(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	slog.InfoContext(ctx, "replayed notifications", slog.Int("count", count))
+
+	return count, nil
+}
+
+func (s *service) DispatchPending(ctx context.Context) (int, error) {
+	ctx, span := tracing.Start(ctx, "notification_service.dispatch_pending")
+	defer span.End()
+
+	pending, err := s.notificationRepository.ClaimPendingNotifications(ctx, s.batchSize, claimLease)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, n := range pending {
+		if err = s.notifier.Notify(ctx, n); err != nil {
+			if err = s.notificationRepository.MarkNotificationFailed(ctx, n.Id, err.Error(), s.nextAttempt(n)); err != nil {
+				return sent, err
+			}
+			continue
+		}
+
+		if err = s.notificationRepository.MarkNotificationSent(ctx, n.Id); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+func (s *service) nextAttempt(n notification.Notification) *time.Time {
+	if n.Attempts >= s.maxAttempts {
+		return nil
+	}
+
+	backoff := retryBackoff << (n.Attempts - 1)
+	if backoff <= 0 || backoff > maxRetryBackoff {
+		backoff = maxRetryBackoff
+	}
+
+	retryAt := time.Now().Add(backoff)
+	return &retryAt
+}
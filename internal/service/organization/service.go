@@ -2,10 +2,13 @@ package organization
 
 import (
 	"context"
-	"fmt"
 	"github.com/google/uuid"
+	"tender-service/internal/i18n"
 	"tender-service/internal/model"
+	"tender-service/internal/model/entity"
+	"tender-service/internal/model/entity/organization"
 	"tender-service/internal/repository"
+	"tender-service/internal/tracing"
 )
 
 type service struct {
@@ -14,9 +17,11 @@ type service struct {
 }
 
 var (
-	errNotInOrganization    = fmt.Errorf("given user not in given organization")
-	errOrganizationNotFound = fmt.Errorf("organization not found")
-	errEmployeeNotInOrg     = fmt.Errorf("employee not in organization")
+	errNotInOrganization     = i18n.NewError(i18n.OrganizationNotResponsible)
+	errOrganizationNotFound  = i18n.NewError(i18n.OrganizationNotFound)
+	errEmployeeNotInOrg      = i18n.NewError(i18n.OrganizationNotMember)
+	errAlreadyInOrganization = i18n.NewError(i18n.OrganizationAlreadyMember)
+	errIncorrectType         = i18n.NewError(i18n.OrganizationIncorrectType)
 )
 
 func NewOrganizationService(
@@ -29,12 +34,68 @@ func NewOrganizationService(
 	}
 }
 
+func (s *service) CreateOrganization(ctx context.Context, org organization.Organization) (organization.Organization, error) {
+	op := "organization_service.create_organization"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	switch org.Type {
+	case "", organization.IE, organization.LLC, organization.JSC:
+	default:
+		return organization.Organization{}, model.NewBadRequestError(op, errIncorrectType)
+	}
+
+	return s.organizationRepository.SaveOrganization(ctx, org)
+}
+
+func (s *service) AddOrganizationEmployee(ctx context.Context, orgId uuid.UUID, employee entity.Employee) error {
+	op := "organization_service.add_organization_employee"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	if err := s.ValidateOrganizationExists(ctx, orgId); err != nil {
+		return err
+	}
+
+	exists, err := s.organizationResponsibleRepository.IsResponsibleInOrganization(ctx, employee.Username, orgId)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return model.NewConflictError(op, errAlreadyInOrganization)
+	}
+
+	return s.organizationResponsibleRepository.SaveResponsible(ctx, orgId, employee.Id)
+}
+
+func (s *service) RemoveOrganizationEmployee(ctx context.Context, orgId uuid.UUID, employee entity.Employee) error {
+	op := "organization_service.remove_organization_employee"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	deleted, err := s.organizationResponsibleRepository.DeleteResponsible(ctx, orgId, employee.Id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return model.NewNotFoundError(op, errNotInOrganization)
+	}
+
+	return nil
+}
+
 func (s *service) UsersHasSimilarOrganization(ctx context.Context, userId uuid.UUID, username string) (bool, error) {
+	ctx, span := tracing.Start(ctx, "organization_service.users_has_similar_organization")
+	defer span.End()
+
 	return s.organizationResponsibleRepository.UsersHasSimilarOrganization(ctx, userId, username)
 }
 
 func (s *service) ValidateOrganizationExists(ctx context.Context, id uuid.UUID) error {
 	op := "organization_service.validate_organization_exists"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
 	exists, err := s.organizationRepository.OrganizationExistById(ctx, id)
 	if !exists {
 		return model.NewNotFoundError(op, errOrganizationNotFound)
@@ -44,6 +105,8 @@ func (s *service) ValidateOrganizationExists(ctx context.Context, id uuid.UUID)
 
 func (s *service) ValidateEmployeeInAnyOrganization(ctx context.Context, userId uuid.UUID) error {
 	op := "organization_service.validate_employee_is_is_any_organization"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
 
 	result, err := s.organizationResponsibleRepository.IsEmployeeInAnyOrganization(ctx, userId)
 	if !result {
@@ -54,6 +117,8 @@ func (s *service) ValidateEmployeeInAnyOrganization(ctx context.Context, userId
 
 func (s *service) ValidateEmployeeBelongsToOrganization(ctx context.Context, orgId uuid.UUID, username string) error {
 	op := "organization_service.validate_employee_belongs_to_organization"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
 
 	if err := s.ValidateOrganizationExists(ctx, orgId); err != nil {
 		return err
@@ -67,9 +132,19 @@ func (s *service) ValidateEmployeeBelongsToOrganization(ctx context.Context, org
 }
 
 func (s *service) GetOrganizationEmployeeCount(ctx context.Context, id uuid.UUID) (int, error) {
+	ctx, span := tracing.Start(ctx, "organization_service.get_organization_employee_count")
+	defer span.End()
+
 	count, err := s.organizationResponsibleRepository.CountEmployeesInOrganization(ctx, id)
 	if err != nil {
 		return 0, err
 	}
 	return count, nil
 }
+
+func (s *service) GetEmployeeOrganizationIds(ctx context.Context, username string) ([]uuid.UUID, error) {
+	ctx, span := tracing.Start(ctx, "organization_service.get_employee_organization_ids")
+	defer span.End()
+
+	return s.organizationResponsibleRepository.GetOrganizationIdsByUsername(ctx, username)
+}
@@ -0,0 +1,106 @@
+package rating
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"tender-service/internal/mapper"
+	"tender-service/internal/model/dto"
+	"tender-service/internal/repository"
+	service2 "tender-service/internal/service"
+	"tender-service/internal/tracing"
+	"tender-service/internal/util"
+)
+
+type service struct {
+	ratingRepository    repository.RatingRepository
+	employeeService     service2.EmployeeService
+	organizationService service2.OrganizationService
+}
+
+func NewRatingService(
+	ratingRepository repository.RatingRepository,
+	employeeService service2.EmployeeService,
+	organizationService service2.OrganizationService,
+) *service {
+	return &service{
+		ratingRepository:    ratingRepository,
+		employeeService:     employeeService,
+		organizationService: organizationService,
+	}
+}
+
+func (s *service) GetEmployeeReputation(ctx context.Context, employeeId uuid.UUID, username string) (dto.ReputationDto, error) {
+	ctx, span := tracing.Start(ctx, "rating_service.get_employee_reputation")
+	defer span.End()
+
+	if err := s.validateRequest(ctx, employeeId, username); err != nil {
+		return dto.ReputationDto{}, err
+	}
+
+	reputation, err := s.ratingRepository.GetEmployeeReputation(ctx, employeeId)
+	if err != nil {
+		return dto.ReputationDto{}, err
+	}
+
+	return mapper.ReputationToReputationDto(reputation), nil
+}
+
+func (s *service) GetOrganizationReputation(ctx context.Context, organizationId uuid.UUID, username string) (dto.ReputationDto, error) {
+	ctx, span := tracing.Start(ctx, "rating_service.get_organization_reputation")
+	defer span.End()
+
+	if err := s.employeeService.ValidateEmployeeExistsByUsername(ctx, username); err != nil {
+		return dto.ReputationDto{}, err
+	}
+
+	if err := s.organizationService.ValidateOrganizationExists(ctx, organizationId); err != nil {
+		return dto.ReputationDto{}, err
+	}
+
+	reputation, err := s.ratingRepository.GetOrganizationReputation(ctx, organizationId)
+	if err != nil {
+		return dto.ReputationDto{}, err
+	}
+
+	return mapper.ReputationToReputationDto(reputation), nil
+}
+
+func (s *service) GetEmployeeRatingHistory(ctx context.Context, page util.Page, employeeId uuid.UUID, username string) ([]dto.RatingDto, util.PageInfo, error) {
+	ctx, span := tracing.Start(ctx, "rating_service.get_employee_rating_history")
+	defer span.End()
+
+	if err := s.validateRequest(ctx, employeeId, username); err != nil {
+		return nil, util.PageInfo{}, err
+	}
+
+	ratings, err := s.ratingRepository.GetRatingList(ctx, page, employeeId)
+	if err != nil {
+		return nil, util.PageInfo{}, err
+	}
+
+	var last util.Cursor
+	if len(ratings) > 0 {
+		last = util.NewCursor(page.Sort, ratings[len(ratings)-1].Id, ratings[len(ratings)-1].SortValue)
+	}
+
+	info := util.NewPageInfo(page, len(ratings), last)
+	if page.WithTotal {
+		total, err := s.ratingRepository.CountRatingList(ctx, employeeId)
+		if err != nil {
+			return nil, util.PageInfo{}, err
+		}
+		info.Total = &total
+	}
+
+	return mapper.RatingListToRatingDtoList(ratings), info, nil
+}
+
+// validateRequest checks that the requester is a known employee and the rated employee exists
+func (s *service) validateRequest(ctx context.Context, employeeId uuid.UUID, username string) error {
+	if err := s.employeeService.ValidateEmployeeExistsByUsername(ctx, username); err != nil {
+		return err
+	}
+
+	_, err := s.employeeService.GetEmployeeByUsernameById(ctx, employeeId)
+	return err
+}
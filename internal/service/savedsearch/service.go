@@ -0,0 +1,117 @@
+package savedsearch
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"tender-service/internal/i18n"
+	"tender-service/internal/mapper"
+	"tender-service/internal/model"
+	"tender-service/internal/model/dto"
+	"tender-service/internal/model/entity/tender"
+	"tender-service/internal/repository"
+	service2 "tender-service/internal/service"
+	"tender-service/internal/tracing"
+)
+
+type service struct {
+	savedSearchRepository repository.SavedSearchRepository
+	employeeService       service2.EmployeeService
+	organizationService   service2.OrganizationService
+	categoryService       service2.CategoryService
+}
+
+var (
+	errIncorrectServiceType = i18n.NewError(i18n.FilterIncorrectServiceType)
+	errIncorrectBudgetRange = i18n.NewError(i18n.FilterIncorrectBudgetRange)
+	errNotSavedSearchOwner  = i18n.NewError(i18n.SavedSearchNotOwner)
+)
+
+func NewSavedSearchService(
+	savedSearchRepository repository.SavedSearchRepository,
+	employeeService service2.EmployeeService,
+	organizationService service2.OrganizationService,
+	categoryService service2.CategoryService,
+) *service {
+	return &service{
+		savedSearchRepository: savedSearchRepository,
+		employeeService:       employeeService,
+		organizationService:   organizationService,
+		categoryService:       categoryService,
+	}
+}
+
+func (s *service) CreateSavedSearch(ctx context.Context, searchDto dto.CreateSavedSearchDto, username string) (dto.SavedSearchDto, error) {
+	op := "saved_search_service.create_saved_search"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	if err := s.employeeService.ValidateEmployeeExistsByUsername(ctx, username); err != nil {
+		return dto.SavedSearchDto{}, err
+	}
+
+	for _, serviceType := range searchDto.ServiceTypes {
+		if !tender.IsServiceType(string(serviceType)) {
+			return dto.SavedSearchDto{}, model.NewBadRequestError(op, errIncorrectServiceType)
+		}
+	}
+
+	if len(searchDto.CategoryIds) > 0 {
+		if err := s.categoryService.ValidateCategoriesExist(ctx, searchDto.CategoryIds); err != nil {
+			return dto.SavedSearchDto{}, err
+		}
+	}
+
+	if searchDto.BudgetFrom != nil && searchDto.BudgetTo != nil && *searchDto.BudgetFrom > *searchDto.BudgetTo {
+		return dto.SavedSearchDto{}, model.NewBadRequestError(op, errIncorrectBudgetRange)
+	}
+
+	for _, organizationId := range searchDto.OrganizationIds {
+		if err := s.organizationService.ValidateOrganizationExists(ctx, organizationId); err != nil {
+			return dto.SavedSearchDto{}, err
+		}
+	}
+
+	saved, err := s.savedSearchRepository.SaveSavedSearch(ctx, mapper.CreateSavedSearchDtoToSavedSearch(searchDto, username))
+	if err != nil {
+		return dto.SavedSearchDto{}, err
+	}
+
+	return mapper.SavedSearchToSavedSearchDto(saved), nil
+}
+
+func (s *service) GetUserSavedSearches(ctx context.Context, username string) ([]dto.SavedSearchDto, error) {
+	ctx, span := tracing.Start(ctx, "saved_search_service.get_user_saved_searches")
+	defer span.End()
+
+	if err := s.employeeService.ValidateEmployeeExistsByUsername(ctx, username); err != nil {
+		return nil, err
+	}
+
+	searches, err := s.savedSearchRepository.GetSavedSearchList(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.SavedSearchListToSavedSearchDtoList(searches), nil
+}
+
+func (s *service) DeleteSavedSearch(ctx context.Context, searchId uuid.UUID, username string) error {
+	op := "saved_search_service.delete_saved_search"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	if err := s.employeeService.ValidateEmployeeExistsByUsername(ctx, username); err != nil {
+		return err
+	}
+
+	search, err := s.savedSearchRepository.GetSavedSearchById(ctx, searchId)
+	if err != nil {
+		return err
+	}
+
+	if search.Username != username {
+		return model.NewForbiddenError(op, errNotSavedSearchOwner)
+	}
+
+	return s.savedSearchRepository.DeleteSavedSearch(ctx, searchId)
+}
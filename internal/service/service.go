@@ -3,38 +3,55 @@ package service
 import (
 	"context"
 	"github.com/google/uuid"
+	"io"
+	"tender-service/internal/events"
 	"tender-service/internal/model/dto"
 	"tender-service/internal/model/entity"
 	"tender-service/internal/model/entity/bid"
 	"tender-service/internal/model/entity/decision"
+	"tender-service/internal/model/entity/dump"
+	"tender-service/internal/model/entity/idempotency"
+	"tender-service/internal/model/entity/notification"
+	"tender-service/internal/model/entity/organization"
 	"tender-service/internal/model/entity/tender"
 	"tender-service/internal/util"
 )
 
 type TenderService interface {
-	GetTenders(ctx context.Context, page util.Page, serviceTypes []tender.ServiceType) ([]dto.TenderDto, error)
+	GetTenders(ctx context.Context, page util.Page, filter tender.ListFilter) ([]dto.TenderDto, util.PageInfo, error)
 	CreateNewTender(ctx context.Context, tenderDto dto.CreateTenderDto) (dto.TenderDto, error)
-	GetUserTenders(ctx context.Context, page util.Page, username string) ([]dto.TenderDto, error)
+	GetUserTenders(ctx context.Context, page util.Page, username string, outcomes []tender.Outcome) ([]dto.TenderDto, util.PageInfo, error)
 	GetTenderStatus(ctx context.Context, tenderId uuid.UUID, username string) (tender.Status, error)
 	UpdateTenderStatus(ctx context.Context, tenderId uuid.UUID, username string, status tender.Status) (dto.TenderDto, error)
+	ForceTenderStatus(ctx context.Context, tenderId uuid.UUID, status tender.Status) (dto.TenderDto, error)
+	ReopenTender(ctx context.Context, tenderId uuid.UUID, clearAward bool) (dto.TenderDto, error)
+	CancelTender(ctx context.Context, tenderId uuid.UUID, username, reason string) (dto.TenderDto, error)
+	GetTenderAward(ctx context.Context, tenderId uuid.UUID) (dto.AwardDto, error)
+	AwardBid(ctx context.Context, bidId uuid.UUID) (dto.TenderDto, error)
 	EditTender(ctx context.Context, tenderDto dto.UpdateTenderDto, tenderId uuid.UUID, username string) (dto.TenderDto, error)
 	RollbackTender(ctx context.Context, tenderId uuid.UUID, username string, version int) (dto.TenderDto, error)
 	ValidateTenderExists(ctx context.Context, tenderId uuid.UUID) error
 	ValidateEmployeeRightsOnTender(ctx context.Context, tenderId uuid.UUID, username string) error
 	GetTenderById(ctx context.Context, tenderId uuid.UUID) (tender.Tender, error)
+	SearchTenders(ctx context.Context, page util.Page, filter tender.SearchFilter, username string) ([]dto.TenderSearchResultDto, error)
+	WatchTenderEvents(ctx context.Context, organizationId uuid.UUID, username string) (<-chan events.TenderEvent, error)
 }
 
 type BidService interface {
 	CreateNewBid(ctx context.Context, dto dto.CreateBidDto) (dto.BidDto, error)
-	GetUserBids(ctx context.Context, page util.Page, username string) ([]dto.BidDto, error)
-	GetTenderBids(ctx context.Context, page util.Page, tenderId uuid.UUID, username string) ([]dto.BidDto, error)
+	GetUserBids(ctx context.Context, page util.Page, username string) ([]dto.BidDto, util.PageInfo, error)
+	GetTenderBids(ctx context.Context, page util.Page, tenderId uuid.UUID, username string) ([]dto.BidDto, util.PageInfo, error)
 	GetBidStatus(ctx context.Context, bidId uuid.UUID, username string) (bid.Status, error)
 	UpdateBidStatus(ctx context.Context, bidId uuid.UUID, username string, status bid.Status) (dto.BidDto, error)
 	EditBid(ctx context.Context, bidId uuid.UUID, username string, bidDto dto.UpdateBidDto) (dto.BidDto, error)
 	SubmitBidDecision(ctx context.Context, bidId uuid.UUID, username string, verdict decision.Verdict) (dto.BidDto, error)
-	CreateBidFeedback(ctx context.Context, bidId uuid.UUID, bidFeedback, username string) (dto.BidDto, error)
+	WithdrawBidDecision(ctx context.Context, bidId uuid.UUID, username string) (dto.BidDto, error)
+	GetBidDecisions(ctx context.Context, bidId uuid.UUID, username string) ([]dto.DecisionDto, error)
+	CreateBidFeedback(ctx context.Context, bidId uuid.UUID, bidFeedback, username string, scores *dto.RatingScoresDto) (dto.BidDto, error)
 	RollbackBid(ctx context.Context, bidId uuid.UUID, username string, version int) (dto.BidDto, error)
-	GetBidReviews(ctx context.Context, page util.Page, tenderId uuid.UUID, authorUsername, requesterUsername string) ([]dto.FeedbackDto, error)
+	GetBidReviews(ctx context.Context, page util.Page, tenderId uuid.UUID, authorUsername, requesterUsername string) ([]dto.FeedbackDto, util.PageInfo, error)
+	RecomputeBidDecisions(ctx context.Context, tenderId uuid.UUID) ([]bid.Bid, error)
+	WithdrawBid(ctx context.Context, bidId uuid.UUID, username, reason string) (dto.BidDto, error)
 }
 
 type OrganizationService interface {
@@ -43,11 +60,66 @@ type OrganizationService interface {
 	ValidateEmployeeBelongsToOrganization(ctx context.Context, orgId uuid.UUID, username string) error
 	GetOrganizationEmployeeCount(ctx context.Context, id uuid.UUID) (int, error)
 	ValidateEmployeeInAnyOrganization(ctx context.Context, userId uuid.UUID) error
+	GetEmployeeOrganizationIds(ctx context.Context, username string) ([]uuid.UUID, error)
+	CreateOrganization(ctx context.Context, org organization.Organization) (organization.Organization, error)
+	AddOrganizationEmployee(ctx context.Context, orgId uuid.UUID, employee entity.Employee) error
+	RemoveOrganizationEmployee(ctx context.Context, orgId uuid.UUID, employee entity.Employee) error
 }
 
 type EmployeeService interface {
+	CreateEmployee(ctx context.Context, employee entity.Employee) (entity.Employee, error)
 	GetEmployeeByUsername(ctx context.Context, username string) (entity.Employee, error)
 	ValidateEmployeeExistsByUsername(ctx context.Context, username string) error
 	GetEmployeeByUsernameById(ctx context.Context, id uuid.UUID) (entity.Employee, error)
 	ValidateEmployeeExistsById(ctx context.Context, id uuid.UUID) error
 }
+
+type CategoryService interface {
+	CreateCategory(ctx context.Context, categoryDto dto.CreateCategoryDto) (dto.CategoryDto, error)
+	GetCategories(ctx context.Context) ([]dto.CategoryDto, error)
+	GetCategory(ctx context.Context, categoryId uuid.UUID) (dto.CategoryDto, error)
+	UpdateCategory(ctx context.Context, categoryId uuid.UUID, categoryDto dto.UpdateCategoryDto) (dto.CategoryDto, error)
+	DeleteCategory(ctx context.Context, categoryId uuid.UUID) error
+	ResolveTenderCategories(ctx context.Context, serviceType tender.ServiceType, categoryIds []uuid.UUID) (tender.ServiceType, []uuid.UUID, error)
+	ValidateCategoriesExist(ctx context.Context, categoryIds []uuid.UUID) error
+}
+
+type SavedSearchService interface {
+	CreateSavedSearch(ctx context.Context, searchDto dto.CreateSavedSearchDto, username string) (dto.SavedSearchDto, error)
+	GetUserSavedSearches(ctx context.Context, username string) ([]dto.SavedSearchDto, error)
+	DeleteSavedSearch(ctx context.Context, searchId uuid.UUID, username string) error
+}
+
+type ContractService interface {
+	CreateContract(ctx context.Context, contractDto dto.CreateContractDto, username string) (dto.ContractDto, error)
+	GetContract(ctx context.Context, contractId uuid.UUID, username string) (dto.ContractDto, error)
+	GetUserContracts(ctx context.Context, username string) ([]dto.ContractDto, error)
+	AddContractMilestone(ctx context.Context, contractId uuid.UUID, username string, milestoneDto dto.CreateMilestoneDto) (dto.MilestoneDto, error)
+	CompleteContractMilestone(ctx context.Context, contractId, milestoneId uuid.UUID, username string) (dto.MilestoneDto, error)
+	RateContract(ctx context.Context, contractId uuid.UUID, username string, scoresDto dto.RatingScoresDto) (dto.RatingDto, error)
+}
+
+type RatingService interface {
+	GetEmployeeReputation(ctx context.Context, employeeId uuid.UUID, username string) (dto.ReputationDto, error)
+	GetOrganizationReputation(ctx context.Context, organizationId uuid.UUID, username string) (dto.ReputationDto, error)
+	GetEmployeeRatingHistory(ctx context.Context, page util.Page, employeeId uuid.UUID, username string) ([]dto.RatingDto, util.PageInfo, error)
+}
+
+type NotificationService interface {
+	EnqueueTenderAlerts(ctx context.Context, tenderId uuid.UUID) error
+	EnqueueTenderCancellationNotices(ctx context.Context, tenderId uuid.UUID) error
+	DispatchPending(ctx context.Context) (int, error)
+	ReplayNotifications(ctx context.Context, filter notification.ReplayFilter) (int, error)
+}
+
+type IdempotencyService interface {
+	BeginRequest(ctx context.Context, key, caller, requestHash string) (idempotency.Key, bool, error)
+	CompleteRequest(ctx context.Context, key, caller string, statusCode int, contentType string, body []byte) error
+	AbandonRequest(ctx context.Context, key, caller string) error
+	DeleteExpiredKeys(ctx context.Context) (int64, error)
+}
+
+type DumpService interface {
+	Export(ctx context.Context, w io.Writer) error
+	Import(ctx context.Context, r io.Reader) ([]dump.ImportedTable, error)
+}
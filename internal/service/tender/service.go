@@ -2,56 +2,143 @@ package tender
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"github.com/google/uuid"
+	"log/slog"
+	"tender-service/internal/events"
+	"tender-service/internal/i18n"
 	"tender-service/internal/mapper"
+	"tender-service/internal/metrics"
 	"tender-service/internal/model"
 	"tender-service/internal/model/dto"
 	"tender-service/internal/model/entity/tender"
 	"tender-service/internal/repository"
 	service2 "tender-service/internal/service"
+	"tender-service/internal/tracing"
 	"tender-service/internal/util"
+	"time"
 )
 
 type service struct {
 	tenderRepository    repository.TenderRepository
+	awardRepository     repository.AwardRepository
 	employeeService     service2.EmployeeService
 	organizationService service2.OrganizationService
+	notificationService service2.NotificationService
+	categoryService     service2.CategoryService
+	metrics             *metrics.Metrics
+	events              *events.TenderBroker
 }
 
-var errTenderVersionDoesNotExists = fmt.Errorf("given tender version dont exists")
+var (
+	errTenderVersionNotFound            = i18n.NewError(i18n.TenderVersionNotFound)
+	errSearchByPrivateStatusesForbidden = i18n.NewError(i18n.TenderPrivateStatusesSearch)
+	errBidDeadlineInPast                = i18n.NewError(i18n.TenderBidDeadlineInPast)
+	errCancelWithoutReason              = i18n.NewError(i18n.TenderCancelWithoutReason)
+	errAwardNotPublished                = i18n.NewError(i18n.TenderAwardNotFound)
+	errReopenCancelled                  = i18n.NewError(i18n.TenderReopenCancelled)
+	errReopenAwarded                    = i18n.NewError(i18n.TenderReopenAwarded)
+)
 
 func NewTenderService(
 	tenderRepository repository.TenderRepository,
+	awardRepository repository.AwardRepository,
 	employeeService service2.EmployeeService,
 	organizationService service2.OrganizationService,
+	notificationService service2.NotificationService,
+	categoryService service2.CategoryService,
+	metrics *metrics.Metrics,
+	events *events.TenderBroker,
 ) *service {
 	return &service{
 		tenderRepository:    tenderRepository,
+		awardRepository:     awardRepository,
 		employeeService:     employeeService,
 		organizationService: organizationService,
+		notificationService: notificationService,
+		categoryService:     categoryService,
+		metrics:             metrics,
+		events:              events,
 	}
 }
 
 func (s *service) ValidateTenderExists(ctx context.Context, tenderId uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "tender_service.validate_tender_exists")
+	defer span.End()
+
 	_, err := s.tenderRepository.GetTenderById(ctx, tenderId)
 	return err
 }
 
 func (s *service) GetTenderById(ctx context.Context, tenderId uuid.UUID) (tender.Tender, error) {
+	ctx, span := tracing.Start(ctx, "tender_service.get_tender_by_id")
+	defer span.End()
+
 	return s.tenderRepository.GetTenderById(ctx, tenderId)
 }
 
-func (s *service) GetTenders(ctx context.Context, page util.Page, serviceTypes []tender.ServiceType) ([]dto.TenderDto, error) {
-	tenders, err := s.tenderRepository.GetTenderList(ctx, page, serviceTypes, "", true)
+func (s *service) GetTenders(ctx context.Context, page util.Page, filter tender.ListFilter) ([]dto.TenderDto, util.PageInfo, error) {
+	ctx, span := tracing.Start(ctx, "tender_service.get_tenders")
+	defer span.End()
+
+	filter.Username = ""
+	filter.OnlyPublished = true
+
+	tenders, err := s.tenderRepository.GetTenderList(ctx, page, filter)
+	if err != nil {
+		return nil, util.PageInfo{}, err
+	}
+
+	info, err := s.getTenderListPageInfo(ctx, page, tenders, filter)
+	if err != nil {
+		return nil, util.PageInfo{}, err
+	}
+
+	return mapper.TenderListToTenderDtoList(tenders), info, nil
+}
+
+func (s *service) SearchTenders(ctx context.Context, page util.Page, filter tender.SearchFilter, username string) ([]dto.TenderSearchResultDto, error) {
+	op := "tender_service.search_tenders"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	if len(filter.Statuses) == 0 {
+		filter.Statuses = []tender.Status{tender.Published}
+	}
+
+	for _, status := range filter.Statuses {
+		if status == tender.Published {
+			continue
+		}
+		if username == "" || filter.OrganizationId == uuid.Nil {
+			return nil, model.NewForbiddenError(op, errSearchByPrivateStatusesForbidden)
+		}
+		if err := s.employeeService.ValidateEmployeeExistsByUsername(ctx, username); err != nil {
+			return nil, err
+		}
+		if err := s.organizationService.ValidateEmployeeBelongsToOrganization(ctx, filter.OrganizationId, username); err != nil {
+			return nil, err
+		}
+		break
+	}
+
+	results, err := s.tenderRepository.SearchTenders(ctx, page, filter)
 	if err != nil {
 		return nil, err
 	}
 
-	return mapper.TenderListToTenderDtoList(tenders), nil
+	return mapper.SearchResultListToTenderSearchResultDtoList(results), nil
 }
 
 func (s *service) CreateNewTender(ctx context.Context, tenderDto dto.CreateTenderDto) (dto.TenderDto, error) {
+	op := "tender_service.create_new_tender"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	if tenderDto.BidDeadline != nil && !tenderDto.BidDeadline.After(time.Now()) {
+		return dto.TenderDto{}, model.NewBadRequestError(op, errBidDeadlineInPast)
+	}
+
 	if err := s.employeeService.ValidateEmployeeExistsByUsername(ctx, tenderDto.CreatorUsername); err != nil {
 		return dto.TenderDto{}, err
 	}
@@ -63,29 +150,71 @@ func (s *service) CreateNewTender(ctx context.Context, tenderDto dto.CreateTende
 
 	entity := mapper.CreateTenderDtoToTender(tenderDto)
 
+	entity.ServiceType, entity.CategoryIds, err = s.categoryService.ResolveTenderCategories(ctx, tenderDto.ServiceType, tenderDto.CategoryIds)
+	if err != nil {
+		return dto.TenderDto{}, err
+	}
+
 	saved, err := s.tenderRepository.SaveTender(ctx, entity)
 	if err != nil {
-		fmt.Println(err.Error())
 		return dto.TenderDto{}, err
 	}
 
-	return mapper.TenderToTenderDto(saved), nil
+	s.metrics.TenderCreated()
+
+	result := mapper.TenderToTenderDto(saved)
+	s.events.Publish(ctx, events.TenderCreated, result)
+
+	return result, nil
 }
 
-func (s *service) GetUserTenders(ctx context.Context, page util.Page, username string) ([]dto.TenderDto, error) {
+func (s *service) GetUserTenders(ctx context.Context, page util.Page, username string, outcomes []tender.Outcome) ([]dto.TenderDto, util.PageInfo, error) {
+	ctx, span := tracing.Start(ctx, "tender_service.get_user_tenders")
+	defer span.End()
+
 	if err := s.employeeService.ValidateEmployeeExistsByUsername(ctx, username); err != nil {
-		return nil, err
+		return nil, util.PageInfo{}, err
 	}
 
-	tenders, err := s.tenderRepository.GetTenderList(ctx, page, nil, username, false)
+	filter := tender.ListFilter{Username: username, Outcomes: outcomes}
+
+	tenders, err := s.tenderRepository.GetTenderList(ctx, page, filter)
 	if err != nil {
-		return nil, err
+		return nil, util.PageInfo{}, err
+	}
+
+	info, err := s.getTenderListPageInfo(ctx, page, tenders, filter)
+	if err != nil {
+		return nil, util.PageInfo{}, err
+	}
+
+	return mapper.TenderListToTenderDtoList(tenders), info, nil
+}
+
+func (s *service) getTenderListPageInfo(ctx context.Context, page util.Page, tenders []tender.Tender, filter tender.ListFilter) (util.PageInfo, error) {
+	var last util.Cursor
+	if len(tenders) > 0 {
+		last = util.NewCursor(page.Sort, tenders[len(tenders)-1].Id, tenders[len(tenders)-1].SortValue)
+	}
+
+	info := util.NewPageInfo(page, len(tenders), last)
+	if !page.WithTotal {
+		return info, nil
 	}
 
-	return mapper.TenderListToTenderDtoList(tenders), nil
+	total, err := s.tenderRepository.CountTenderList(ctx, filter)
+	if err != nil {
+		return util.PageInfo{}, err
+	}
+	info.Total = &total
+
+	return info, nil
 }
 
 func (s *service) GetTenderStatus(ctx context.Context, tenderId uuid.UUID, username string) (tender.Status, error) {
+	ctx, span := tracing.Start(ctx, "tender_service.get_tender_status")
+	defer span.End()
+
 	entity, err := s.tenderRepository.GetTenderById(ctx, tenderId)
 	if err != nil {
 		return "", err
@@ -104,35 +233,228 @@ func (s *service) GetTenderStatus(ctx context.Context, tenderId uuid.UUID, usern
 }
 
 func (s *service) UpdateTenderStatus(ctx context.Context, tenderId uuid.UUID, username string, status tender.Status) (dto.TenderDto, error) {
+	op := "tender_service.update_tender_status"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	if status == tender.Cancelled {
+		return dto.TenderDto{}, model.NewBadRequestError(op, errCancelWithoutReason)
+	}
+
 	err := s.ValidateEmployeeRightsOnTender(ctx, tenderId, username)
 	if err != nil {
 		return dto.TenderDto{}, err
 	}
 
-	updated, err := s.tenderRepository.UpdateTenderStatus(ctx, tenderId, status)
+	curTender, err := s.tenderRepository.GetTenderById(ctx, tenderId)
+	if err != nil {
+		return dto.TenderDto{}, err
+	}
+
+	if err = curTender.CheckTransition(status, time.Now()); err != nil {
+		return dto.TenderDto{}, model.NewConflictError(op, err)
+	}
+
+	return s.setTenderStatus(ctx, tenderId, status)
+}
+
+// ForceTenderStatus changes the status without checking employee rights and the transition table,
+// it is meant for operator tooling, publishing goes through ReopenTender and keeps the award
+func (s *service) ForceTenderStatus(ctx context.Context, tenderId uuid.UUID, status tender.Status) (dto.TenderDto, error) {
+	ctx, span := tracing.Start(ctx, "tender_service.force_tender_status")
+	defer span.End()
+
+	if status == tender.Published {
+		return s.ReopenTender(ctx, tenderId, false)
+	}
+
+	if err := s.ValidateTenderExists(ctx, tenderId); err != nil {
+		return dto.TenderDto{}, err
+	}
+
+	return s.setTenderStatus(ctx, tenderId, status)
+}
+
+// ReopenTender publishes a closed tender again for operator tooling, cancelled tenders stay cancelled
+// and an awarded tender is only reopened when its award is cleared
+func (s *service) ReopenTender(ctx context.Context, tenderId uuid.UUID, clearAward bool) (dto.TenderDto, error) {
+	op := "tender_service.reopen_tender"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	curTender, err := s.tenderRepository.GetTenderById(ctx, tenderId)
+	if err != nil {
+		return dto.TenderDto{}, err
+	}
+
+	if curTender.Status == tender.Cancelled {
+		return dto.TenderDto{}, model.NewConflictError(op, errReopenCancelled)
+	}
+
+	_, err = s.awardRepository.GetAwardByTenderId(ctx, tenderId)
+	if err == nil && !clearAward {
+		return dto.TenderDto{}, model.NewConflictError(op, errReopenAwarded)
+	}
+	var apiErr model.ApiError
+	if err != nil && (!errors.As(err, &apiErr) || apiErr.Code != model.NotFoundCode) {
+		return dto.TenderDto{}, err
+	}
+
+	reopened, err := s.tenderRepository.ReopenTender(ctx, tenderId)
+	if err != nil {
+		return dto.TenderDto{}, err
+	}
+
+	return s.tenderStatusChanged(ctx, reopened), nil
+}
+
+// setTenderStatus closes tenders with the same bid finalization for employees and operator tooling
+func (s *service) setTenderStatus(ctx context.Context, tenderId uuid.UUID, status tender.Status) (dto.TenderDto, error) {
+	var (
+		updated tender.Tender
+		err     error
+	)
+	if status == tender.Closed {
+		updated, err = s.tenderRepository.CloseTender(ctx, tenderId)
+	} else {
+		updated, err = s.tenderRepository.UpdateTenderStatus(ctx, tenderId, status)
+	}
+	if err != nil {
+		return dto.TenderDto{}, err
+	}
+
+	return s.tenderStatusChanged(ctx, updated), nil
+}
+
+func (s *service) tenderStatusChanged(ctx context.Context, updated tender.Tender) dto.TenderDto {
+	s.metrics.TenderStatusChanged(updated.Status)
+
+	if updated.Status == tender.Published {
+		if err := s.notificationService.EnqueueTenderAlerts(ctx, updated.Id); err != nil {
+			slog.ErrorContext(ctx, "cannot enqueue tender alerts", slog.String("tender_id", updated.Id.String()), slog.Any("error", err))
+		}
+	}
+
+	result := mapper.TenderToTenderDto(updated)
+	s.events.Publish(ctx, events.TenderStatusChanged, result)
+
+	return result
+}
+
+// GetTenderAward is public, the award is only shown once the tender is closed
+func (s *service) GetTenderAward(ctx context.Context, tenderId uuid.UUID) (dto.AwardDto, error) {
+	op := "tender_service.get_tender_award"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	ten, err := s.tenderRepository.GetTenderById(ctx, tenderId)
+	if err != nil {
+		return dto.AwardDto{}, err
+	}
+
+	if ten.Status != tender.Closed {
+		return dto.AwardDto{}, model.NewNotFoundError(op, errAwardNotPublished)
+	}
+
+	award, err := s.awardRepository.GetAwardByTenderId(ctx, tenderId)
+	if err != nil {
+		return dto.AwardDto{}, err
+	}
+
+	return mapper.AwardToAwardDto(award), nil
+}
+
+// AwardBid is called once the bid reaches the quorum, the tender is closed in the same transaction as the award is recorded
+func (s *service) AwardBid(ctx context.Context, bidId uuid.UUID) (dto.TenderDto, error) {
+	ctx, span := tracing.Start(ctx, "tender_service.award_bid")
+	defer span.End()
+
+	awarded, err := s.awardRepository.AwardBid(ctx, bidId)
+	if err != nil {
+		return dto.TenderDto{}, err
+	}
+
+	closed, err := s.tenderRepository.GetTenderById(ctx, awarded.TenderId)
+	if err != nil {
+		return dto.TenderDto{}, err
+	}
+
+	s.metrics.TenderStatusChanged(closed.Status)
+
+	result := mapper.TenderToTenderDto(closed)
+	s.events.Publish(ctx, events.TenderStatusChanged, result)
+
+	return result, nil
+}
+
+// CancelTender stops the tender without an award, authors of its open bids are notified
+func (s *service) CancelTender(ctx context.Context, tenderId uuid.UUID, username, reason string) (dto.TenderDto, error) {
+	op := "tender_service.cancel_tender"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	if err := s.ValidateEmployeeRightsOnTender(ctx, tenderId, username); err != nil {
+		return dto.TenderDto{}, err
+	}
+
+	curTender, err := s.tenderRepository.GetTenderById(ctx, tenderId)
+	if err != nil {
+		return dto.TenderDto{}, err
+	}
+
+	if err = curTender.CheckTransition(tender.Cancelled, time.Now()); err != nil {
+		return dto.TenderDto{}, model.NewConflictError(op, err)
+	}
+	if curTender.Status == tender.Cancelled {
+		return mapper.TenderToTenderDto(curTender), nil
+	}
+
+	cancelled, err := s.tenderRepository.CancelTender(ctx, tenderId, reason)
 	if err != nil {
 		return dto.TenderDto{}, err
 	}
 
-	return mapper.TenderToTenderDto(updated), nil
+	s.metrics.TenderStatusChanged(cancelled.Status)
+
+	if err = s.notificationService.EnqueueTenderCancellationNotices(ctx, tenderId); err != nil {
+		slog.ErrorContext(ctx, "cannot enqueue tender cancellation notices", slog.String("tender_id", tenderId.String()), slog.Any("error", err))
+	}
+
+	result := mapper.TenderToTenderDto(cancelled)
+	s.events.Publish(ctx, events.TenderStatusChanged, result)
+
+	return result, nil
 }
 
 func (s *service) EditTender(ctx context.Context, tenderDto dto.UpdateTenderDto, tenderId uuid.UUID, username string) (dto.TenderDto, error) {
+	ctx, span := tracing.Start(ctx, "tender_service.edit_tender")
+	defer span.End()
+
 	err := s.ValidateEmployeeRightsOnTender(ctx, tenderId, username)
 	if err != nil {
 		return dto.TenderDto{}, err
 	}
 
-	updated, err := s.tenderRepository.UpdateTender(ctx, tenderId, tenderDto.Name, tenderDto.Description, tenderDto.ServiceType)
+	serviceType, categoryIds, err := s.categoryService.ResolveTenderCategories(ctx, tenderDto.ServiceType, tenderDto.CategoryIds)
 	if err != nil {
 		return dto.TenderDto{}, err
 	}
 
-	return mapper.TenderToTenderDto(updated), nil
+	updated, err := s.tenderRepository.UpdateTender(ctx, tenderId, tenderDto.Name, tenderDto.Description, serviceType, categoryIds, tenderDto.Budget)
+	if err != nil {
+		return dto.TenderDto{}, err
+	}
+
+	result := mapper.TenderToTenderDto(updated)
+	s.events.Publish(ctx, events.TenderEdited, result)
+
+	return result, nil
 }
 
 func (s *service) RollbackTender(ctx context.Context, tenderId uuid.UUID, username string, version int) (dto.TenderDto, error) {
 	op := "tender_service.rollback_tender"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
 
 	tend, err := s.tenderRepository.GetTenderById(ctx, tenderId)
 	if err != nil {
@@ -140,7 +462,7 @@ func (s *service) RollbackTender(ctx context.Context, tenderId uuid.UUID, userna
 	}
 
 	if tend.Version < version {
-		return dto.TenderDto{}, model.NewBadRequestError(op, errTenderVersionDoesNotExists)
+		return dto.TenderDto{}, model.NewBadRequestError(op, errTenderVersionNotFound)
 	}
 
 	err = s.ValidateEmployeeRightsOnTender(ctx, tenderId, username)
@@ -152,10 +474,40 @@ func (s *service) RollbackTender(ctx context.Context, tenderId uuid.UUID, userna
 	if err != nil {
 		return dto.TenderDto{}, err
 	}
-	return mapper.TenderToTenderDto(updated), err
+
+	result := mapper.TenderToTenderDto(updated)
+	s.events.Publish(ctx, events.TenderRolledBack, result)
+
+	return result, nil
+}
+
+func (s *service) WatchTenderEvents(ctx context.Context, organizationId uuid.UUID, username string) (<-chan events.TenderEvent, error) {
+	ctx, span := tracing.Start(ctx, "tender_service.watch_tender_events")
+	defer span.End()
+
+	if organizationId == uuid.Nil {
+		return s.events.Subscribe(ctx, func(event events.TenderEvent) bool {
+			return event.Tender.Status == tender.Published
+		}), nil
+	}
+
+	if err := s.employeeService.ValidateEmployeeExistsByUsername(ctx, username); err != nil {
+		return nil, err
+	}
+
+	if err := s.organizationService.ValidateEmployeeBelongsToOrganization(ctx, organizationId, username); err != nil {
+		return nil, err
+	}
+
+	return s.events.Subscribe(ctx, func(event events.TenderEvent) bool {
+		return event.Tender.OrganizationId == organizationId
+	}), nil
 }
 
 func (s *service) ValidateEmployeeRightsOnTender(ctx context.Context, tenderId uuid.UUID, username string) error {
+	ctx, span := tracing.Start(ctx, "tender_service.validate_employee_rights_on_tender")
+	defer span.End()
+
 	curTender, err := s.tenderRepository.GetTenderById(ctx, tenderId)
 	if err != nil {
 		return err
@@ -1,39 +1,175 @@
 package util
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"github.com/google/uuid"
 	"net/http"
+	"net/url"
+	"slices"
 	"strconv"
+	"tender-service/internal/i18n"
 )
 
 type Page struct {
-	Offset int
-	Limit  int
+	Offset    int
+	Limit     int
+	Cursor    *Cursor
+	WithTotal bool
+	Sort      []SortKey
+}
+
+// Cursor keeps the sort it was built for, so it cannot be replayed with another one
+type Cursor struct {
+	Values []string  `json:"values"`
+	Sort   []SortKey `json:"sort"`
+	Id     uuid.UUID `json:"id"`
+}
+
+type PageInfo struct {
+	NextCursor string
+	Total      *int
+}
+
+type PageLimits struct {
+	DefaultLimit int
+	MaxLimit     int
 }
 
 const (
-	offsetQueryParam = "offset"
-	limitQueryParam  = "limit"
+	offsetQueryParam    = "offset"
+	limitQueryParam     = "limit"
+	cursorQueryParam    = "cursor"
+	withTotalQueryParam = "with_total"
+
+	NextCursorHeader = "X-Next-Cursor"
+	TotalCountHeader = "X-Total-Count"
+
+	fallbackDefaultLimit = 5
+	fallbackMaxLimit     = 50
 )
 
-func NewPage(offset, limit int) Page {
-	return Page{
-		Offset: 0,
-		Limit:  0,
+var (
+	errIncorrectOffset       = i18n.NewError(i18n.PageIncorrectOffset)
+	errIncorrectLimit        = i18n.NewError(i18n.PageIncorrectLimit)
+	errIncorrectCursor       = i18n.NewError(i18n.PageIncorrectCursor)
+	errIncorrectWithTotal    = i18n.NewError(i18n.PageIncorrectWithTotal)
+	errCursorWithOffsetGiven = i18n.NewError(i18n.PageCursorWithOffset)
+)
+
+func NewPageLimits(defaultLimit, maxLimit int) PageLimits {
+	limits := PageLimits{DefaultLimit: defaultLimit, MaxLimit: maxLimit}
+	if limits.MaxLimit <= 0 {
+		limits.MaxLimit = fallbackMaxLimit
+	}
+	if limits.DefaultLimit <= 0 {
+		limits.DefaultLimit = fallbackDefaultLimit
+	}
+	if limits.DefaultLimit > limits.MaxLimit {
+		limits.DefaultLimit = limits.MaxLimit
+	}
+	return limits
+}
+
+func NewPageFromRequest(request *http.Request, limits PageLimits, sortFields []string) (Page, error) {
+	return NewPageFromQuery(request.URL.Query(), limits, sortFields)
+}
+
+// NewPageFromQuery reads the page from offset, limit, cursor, sort and with_total values
+func NewPageFromQuery(query url.Values, limits PageLimits, sortFields []string) (Page, error) {
+	offset, err := getQueryParamOrDefault(offsetQueryParam, 0, query)
+	if err != nil || offset < 0 {
+		return Page{}, errIncorrectOffset
+	}
+
+	limit, err := getQueryParamOrDefault(limitQueryParam, limits.DefaultLimit, query)
+	if err != nil || limit < 0 {
+		return Page{}, errIncorrectLimit
+	}
+
+	sort, err := newSortFromQuery(query, sortFields)
+	if err != nil {
+		return Page{}, err
+	}
+
+	page := Page{
+		Offset: offset,
+		Limit:  min(limit, limits.MaxLimit),
+		Sort:   sort,
+	}
+
+	if rawWithTotal := query.Get(withTotalQueryParam); rawWithTotal != "" {
+		page.WithTotal, err = strconv.ParseBool(rawWithTotal)
+		if err != nil {
+			return Page{}, errIncorrectWithTotal
+		}
+	}
+
+	if rawCursor := query.Get(cursorQueryParam); rawCursor != "" {
+		if page.Offset != 0 {
+			return Page{}, errCursorWithOffsetGiven
+		}
+		cursor, err := DecodeCursor(rawCursor)
+		if err != nil {
+			return Page{}, err
+		}
+		if len(cursor.Values) != len(page.Sort) || !slices.Equal(cursor.Sort, page.Sort) {
+			return Page{}, errCursorSortMismatch
+		}
+		page.Cursor = &cursor
+	}
+
+	return page, nil
+}
+
+func NewPageInfo(page Page, size int, last Cursor) PageInfo {
+	info := PageInfo{}
+	if page.Limit > 0 && size == page.Limit {
+		info.NextCursor = last.Encode()
+	}
+	return info
+}
+
+func WritePageInfo(writer http.ResponseWriter, info PageInfo) {
+	if info.NextCursor != "" {
+		writer.Header().Set(NextCursorHeader, info.NextCursor)
+	}
+	if info.Total != nil {
+		writer.Header().Set(TotalCountHeader, strconv.Itoa(*info.Total))
 	}
 }
 
-func NewPageFromRequest(request *http.Request) Page {
-	return Page{
-		Offset: getUrlRequestParamOrDefault(offsetQueryParam, 0, request),
-		Limit:  getUrlRequestParamOrDefault(limitQueryParam, 5, request),
+func NewCursor(sort []SortKey, id uuid.UUID, value func(field string) string) Cursor {
+	values := make([]string, len(sort))
+	for i, key := range sort {
+		values[i] = value(key.Field)
 	}
+	return Cursor{Values: values, Sort: sort, Id: id}
 }
 
-func getUrlRequestParamOrDefault(requestParamName string, def int, request *http.Request) int {
-	requestParam := request.URL.Query().Get(requestParamName)
-	value, err := strconv.Atoi(requestParam)
+func (c Cursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func DecodeCursor(encoded string) (Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
 	if err != nil {
-		return def
+		return Cursor{}, errIncorrectCursor
+	}
+
+	var cursor Cursor
+	if err = json.Unmarshal(raw, &cursor); err != nil || cursor.Id == uuid.Nil {
+		return Cursor{}, errIncorrectCursor
+	}
+
+	return cursor, nil
+}
+
+func getQueryParamOrDefault(requestParamName string, def int, query url.Values) (int, error) {
+	requestParam := query.Get(requestParamName)
+	if requestParam == "" {
+		return def, nil
 	}
-	return value
+	return strconv.Atoi(requestParam)
 }
@@ -0,0 +1,56 @@
+package util
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+	"tender-service/internal/i18n"
+)
+
+type SortKey struct {
+	Field string `json:"field"`
+	Desc  bool   `json:"desc,omitempty"`
+}
+
+const (
+	sortQueryParam = "sort"
+	descSortPrefix = "-"
+
+	SortByCreatedAt = "created_at"
+)
+
+var (
+	errIncorrectSort      = i18n.NewError(i18n.SortIncorrect)
+	errSortNotSupported   = i18n.NewError(i18n.SortNotSupported)
+	errCursorSortMismatch = i18n.NewError(i18n.SortCursorMismatch)
+)
+
+var DefaultSort = []SortKey{{Field: SortByCreatedAt}}
+
+func newSortFromQuery(query url.Values, fields []string) ([]SortKey, error) {
+	rawSort := query.Get(sortQueryParam)
+	if rawSort == "" {
+		return DefaultSort, nil
+	}
+
+	if len(fields) == 0 {
+		return nil, errSortNotSupported
+	}
+
+	rawKeys := strings.Split(rawSort, ",")
+	keys := make([]SortKey, 0, len(rawKeys))
+	seen := make(map[string]bool, len(rawKeys))
+	for _, rawKey := range rawKeys {
+		key := SortKey{Field: strings.TrimPrefix(rawKey, descSortPrefix), Desc: strings.HasPrefix(rawKey, descSortPrefix)}
+		if !slices.Contains(fields, key.Field) {
+			return nil, i18n.NewError(i18n.SortUnknownField, key.Field, strings.Join(fields, ", "))
+		}
+		if seen[key.Field] {
+			return nil, errIncorrectSort
+		}
+		seen[key.Field] = true
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,24 @@
+package validation
+
+import (
+	"github.com/go-playground/validator/v10"
+	"reflect"
+	"strings"
+)
+
+func New() *validator.Validate {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		if name == "" {
+			return field.Name
+		}
+		return name
+	})
+
+	return validate
+}
@@ -0,0 +1,36 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"tender-service/internal/service"
+	"time"
+)
+
+const idempotencyCleanupInterval = 10 * time.Minute
+
+type idempotencyKeyCleaner struct {
+	idempotencyService service.IdempotencyService
+}
+
+func NewIdempotencyKeyCleaner(idempotencyService service.IdempotencyService) *idempotencyKeyCleaner {
+	return &idempotencyKeyCleaner{
+		idempotencyService: idempotencyService,
+	}
+}
+
+func (c *idempotencyKeyCleaner) Run(ctx context.Context) {
+	ticker := time.NewTicker(idempotencyCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := c.idempotencyService.DeleteExpiredKeys(ctx); err != nil {
+				slog.ErrorContext(ctx, "cannot delete expired idempotency keys", slog.Any("error", err))
+			}
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"tender-service/internal/service"
+	"time"
+)
+
+type notificationDispatcher struct {
+	notificationService service.NotificationService
+	interval            time.Duration
+}
+
+const fallbackDispatchInterval = time.Second
+
+func NewNotificationDispatcher(notificationService service.NotificationService, interval time.Duration) *notificationDispatcher {
+	if interval <= 0 {
+		interval = fallbackDispatchInterval
+	}
+	return &notificationDispatcher{
+		notificationService: notificationService,
+		interval:            interval,
+	}
+}
+
+func (d *notificationDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.dispatch(ctx)
+		}
+	}
+}
+
+func (d *notificationDispatcher) dispatch(ctx context.Context) {
+	for ctx.Err() == nil {
+		sent, err := d.notificationService.DispatchPending(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "cannot dispatch notifications", slog.Any("error", err))
+			return
+		}
+		if sent == 0 {
+			return
+		}
+	}
+}
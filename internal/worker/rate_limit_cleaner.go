@@ -0,0 +1,38 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"tender-service/internal/repository"
+	"time"
+)
+
+const rateLimitCleanupInterval = time.Minute
+
+type rateLimitCleaner struct {
+	rateLimitRepository repository.RateLimitRepository
+	staleAge            time.Duration
+}
+
+func NewRateLimitCleaner(rateLimitRepository repository.RateLimitRepository, staleAge time.Duration) *rateLimitCleaner {
+	return &rateLimitCleaner{
+		rateLimitRepository: rateLimitRepository,
+		staleAge:            staleAge,
+	}
+}
+
+func (c *rateLimitCleaner) Run(ctx context.Context) {
+	ticker := time.NewTicker(rateLimitCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := c.rateLimitRepository.DeleteStaleBuckets(ctx, time.Now().Add(-c.staleAge)); err != nil {
+				slog.ErrorContext(ctx, "cannot delete stale rate limit buckets", slog.Any("error", err))
+			}
+		}
+	}
+}
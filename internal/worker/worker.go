@@ -0,0 +1,7 @@
+package worker
+
+import "context"
+
+type Worker interface {
+	Run(ctx context.Context)
+}
@@ -36,4 +36,6 @@ ALTER TABLE tender_version ADD CONSTRAINT fk_tender_id FOREIGN KEY (tender_id) R
 
 -- +goose Down
 -- +goose StatementBegin
+DROP TABLE IF EXISTS tender_version, tender;
+DROP TYPE IF EXISTS tender_version_service_type;
 -- +goose StatementEnd
@@ -74,4 +74,11 @@ ALTER TABLE feedback ADD CONSTRAINT fk_username FOREIGN KEY (username) REFERENCE
 
 -- +goose Down
 -- +goose StatementBegin
+DROP TABLE IF EXISTS feedback;
+DROP TABLE IF EXISTS decision;
+DROP TYPE IF EXISTS decision_verdict_type;
+DROP TABLE IF EXISTS bid_version, bid;
+DROP TYPE IF EXISTS bid_decision_type;
+DROP TYPE IF EXISTS bid_status;
+DROP TYPE IF EXISTS bid_author_type;
 -- +goose StatementEnd
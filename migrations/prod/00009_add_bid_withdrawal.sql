@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TYPE bid_status ADD VALUE IF NOT EXISTS 'Withdrawn';
+
+ALTER TABLE bid ADD COLUMN IF NOT EXISTS withdrawal_reason TEXT;
+ALTER TABLE bid ADD COLUMN IF NOT EXISTS withdrawn_at TIMESTAMPTZ;
+
+ALTER TABLE tender ADD COLUMN IF NOT EXISTS bid_deadline TIMESTAMPTZ;
+ALTER TABLE tender ADD COLUMN IF NOT EXISTS allow_late_withdrawal BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE tender DROP COLUMN IF EXISTS allow_late_withdrawal;
+ALTER TABLE tender DROP COLUMN IF EXISTS bid_deadline;
+
+ALTER TABLE bid DROP COLUMN IF EXISTS withdrawn_at;
+ALTER TABLE bid DROP COLUMN IF EXISTS withdrawal_reason;
+
+UPDATE bid SET status = 'Canceled' WHERE status = 'Withdrawn';
+ALTER TYPE bid_status RENAME TO bid_status_old;
+CREATE TYPE bid_status AS ENUM (
+    'Created',
+    'Published',
+    'Canceled'
+);
+ALTER TABLE bid ALTER COLUMN status TYPE bid_status USING status::text::bid_status;
+DROP TYPE bid_status_old;
+-- +goose StatementEnd
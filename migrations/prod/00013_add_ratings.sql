@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS rating (
+    id uuid PRIMARY KEY DEFAULT public.uuid_generate_v4(),
+    supplier_id uuid NOT NULL,
+    tender_id uuid NOT NULL,
+    bid_id uuid NOT NULL,
+    feedback_id uuid UNIQUE,
+    contract_id uuid UNIQUE,
+    username VARCHAR(50) NOT NULL,
+    quality SMALLINT NOT NULL CHECK (quality BETWEEN 1 AND 5),
+    timeliness SMALLINT NOT NULL CHECK (timeliness BETWEEN 1 AND 5),
+    communication SMALLINT NOT NULL CHECK (communication BETWEEN 1 AND 5),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (num_nonnulls(feedback_id, contract_id) = 1)
+);
+
+ALTER TABLE rating ADD CONSTRAINT fk_supplier_id FOREIGN KEY (supplier_id) REFERENCES employee(id) ON DELETE CASCADE;
+ALTER TABLE rating ADD CONSTRAINT fk_tender_id FOREIGN KEY (tender_id) REFERENCES tender(id) ON DELETE CASCADE;
+ALTER TABLE rating ADD CONSTRAINT fk_bid_id FOREIGN KEY (bid_id) REFERENCES bid(id) ON DELETE CASCADE;
+ALTER TABLE rating ADD CONSTRAINT fk_feedback_id FOREIGN KEY (feedback_id) REFERENCES feedback(id) ON DELETE CASCADE;
+ALTER TABLE rating ADD CONSTRAINT fk_contract_id FOREIGN KEY (contract_id) REFERENCES contract(id) ON DELETE CASCADE;
+ALTER TABLE rating ADD CONSTRAINT fk_username FOREIGN KEY (username) REFERENCES employee(username);
+
+-- an evaluator rates a bid through feedback only once
+CREATE UNIQUE INDEX IF NOT EXISTS rating_bid_id_username_idx ON rating (bid_id, username) WHERE feedback_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS rating_supplier_id_created_at_idx ON rating (supplier_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS rating;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE saved_search ADD COLUMN IF NOT EXISTS category_ids uuid[] NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE saved_search DROP COLUMN IF EXISTS category_ids;
+-- +goose StatementEnd
@@ -36,4 +36,8 @@ CREATE TABLE IF NOT EXISTS organization_responsible (
 
 -- +goose Down
 -- +goose StatementBegin
+DROP TABLE IF EXISTS organization_responsible;
+DROP TABLE IF EXISTS organization;
+DROP TYPE IF EXISTS organization_type;
+DROP TABLE IF EXISTS employee;
 -- +goose StatementEnd
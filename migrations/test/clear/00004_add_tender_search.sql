@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE tender_version ADD COLUMN IF NOT EXISTS budget NUMERIC(15, 2);
+
+CREATE OR REPLACE FUNCTION tender_search_document(name TEXT, description TEXT) RETURNS tsvector AS $$
+    SELECT setweight(to_tsvector('russian', coalesce(name, '')), 'A') ||
+           setweight(to_tsvector('english', coalesce(name, '')), 'A') ||
+           setweight(to_tsvector('russian', coalesce(description, '')), 'B') ||
+           setweight(to_tsvector('english', coalesce(description, '')), 'B')
+$$ LANGUAGE SQL IMMUTABLE;
+
+CREATE OR REPLACE FUNCTION tender_search_query(query TEXT) RETURNS tsquery AS $$
+    SELECT websearch_to_tsquery('russian', query) || websearch_to_tsquery('english', query)
+$$ LANGUAGE SQL IMMUTABLE;
+
+CREATE TABLE IF NOT EXISTS tender_search (
+    tender_id uuid PRIMARY KEY,
+    document tsvector NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE tender_search ADD CONSTRAINT fk_tender_id FOREIGN KEY (tender_id) REFERENCES tender(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS tender_search_document_idx ON tender_search USING GIN (document);
+
+INSERT INTO tender_search (tender_id, document)
+SELECT tender.id, tender_search_document(tender_version.name, tender_version.description)
+FROM tender JOIN tender_version ON tender.tender_version_id = tender_version.id
+ON CONFLICT (tender_id) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS tender_search;
+DROP FUNCTION IF EXISTS tender_search_query(TEXT);
+DROP FUNCTION IF EXISTS tender_search_document(TEXT, TEXT);
+ALTER TABLE tender_version DROP COLUMN IF EXISTS budget;
+-- +goose StatementEnd
@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS saved_search (
+    id uuid PRIMARY KEY DEFAULT public.uuid_generate_v4(),
+    name VARCHAR(100) NOT NULL,
+    username VARCHAR(50) NOT NULL,
+    query VARCHAR(200) NOT NULL DEFAULT '',
+    service_types VARCHAR(50)[] NOT NULL DEFAULT '{}',
+    organization_ids uuid[] NOT NULL DEFAULT '{}',
+    budget_from NUMERIC(15, 2),
+    budget_to NUMERIC(15, 2),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE saved_search ADD CONSTRAINT fk_username FOREIGN KEY (username) REFERENCES employee(username) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS saved_search_username_idx ON saved_search (username);
+
+DROP TYPE IF EXISTS notification_status;
+CREATE TYPE notification_status AS ENUM (
+    'Pending',
+    'Processing',
+    'Sent',
+    'Failed'
+);
+
+CREATE TABLE IF NOT EXISTS notification (
+    id uuid PRIMARY KEY DEFAULT public.uuid_generate_v4(),
+    type VARCHAR(50) NOT NULL,
+    recipient VARCHAR(50) NOT NULL,
+    dedup_key VARCHAR(255) NOT NULL UNIQUE,
+    payload JSONB NOT NULL,
+    status notification_status NOT NULL DEFAULT 'Pending',
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS notification_next_attempt_idx ON notification (next_attempt_at) WHERE status IN ('Pending', 'Processing');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS notification;
+DROP TYPE IF EXISTS notification_status;
+DROP TABLE IF EXISTS saved_search;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS category (
+    id uuid PRIMARY KEY DEFAULT public.uuid_generate_v4(),
+    code VARCHAR(50) NOT NULL UNIQUE,
+    name VARCHAR(255) NOT NULL,
+    parent_id uuid,
+    service_type VARCHAR(50) UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE category ADD CONSTRAINT fk_parent_id FOREIGN KEY (parent_id) REFERENCES category(id);
+
+CREATE INDEX IF NOT EXISTS category_parent_id_idx ON category (parent_id);
+
+INSERT INTO category (code, name, service_type) VALUES
+    ('C', 'Продукция обрабатывающих производств', 'Manufacture'),
+    ('F', 'Сооружения и строительные работы', 'Construction'),
+    ('H', 'Услуги транспорта и складского хозяйства', 'Delivery')
+ON CONFLICT (code) DO NOTHING;
+
+ALTER TABLE tender_version ADD COLUMN IF NOT EXISTS category_ids uuid[] NOT NULL DEFAULT '{}';
+
+UPDATE tender_version SET category_ids = ARRAY(
+    SELECT category.id FROM category WHERE category.service_type = tender_version.service_type::text
+) WHERE service_type IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS tender_version_category_ids_idx ON tender_version USING GIN (category_ids);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS tender_version_category_ids_idx;
+ALTER TABLE tender_version DROP COLUMN IF EXISTS category_ids;
+DROP TABLE IF EXISTS category;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE decision ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+ALTER TABLE decision ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+DELETE FROM decision duplicate USING decision kept
+WHERE duplicate.bid_id = kept.bid_id AND duplicate.username = kept.username AND duplicate.id > kept.id;
+
+ALTER TABLE decision ADD CONSTRAINT decision_bid_id_username_key UNIQUE (bid_id, username);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE decision DROP CONSTRAINT IF EXISTS decision_bid_id_username_key;
+ALTER TABLE decision DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE decision DROP COLUMN IF EXISTS created_at;
+-- +goose StatementEnd
@@ -51,4 +51,8 @@ INSERT INTO organization_responsible (organization_id, user_id) VALUES
 
 -- +goose Down
 -- +goose StatementBegin
+DROP TABLE IF EXISTS organization_responsible;
+DROP TABLE IF EXISTS organization;
+DROP TYPE IF EXISTS organization_type;
+DROP TABLE IF EXISTS employee;
 -- +goose StatementEnd
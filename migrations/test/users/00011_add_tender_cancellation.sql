@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TYPE bid_decision_type ADD VALUE IF NOT EXISTS 'NotAwarded';
+
+ALTER TABLE tender ADD COLUMN IF NOT EXISTS cancellation_reason TEXT;
+ALTER TABLE tender ADD COLUMN IF NOT EXISTS cancelled_at TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE tender SET status = 'Closed' WHERE status = 'Cancelled';
+ALTER TABLE tender DROP COLUMN IF EXISTS cancelled_at;
+ALTER TABLE tender DROP COLUMN IF EXISTS cancellation_reason;
+
+UPDATE bid SET decision = 'None' WHERE decision = 'NotAwarded';
+ALTER TYPE bid_decision_type RENAME TO bid_decision_type_old;
+CREATE TYPE bid_decision_type AS ENUM (
+    'Approved',
+    'None',
+    'Rejected'
+);
+ALTER TABLE bid ALTER COLUMN decision DROP DEFAULT;
+ALTER TABLE bid ALTER COLUMN decision TYPE bid_decision_type USING decision::text::bid_decision_type;
+ALTER TABLE bid ALTER COLUMN decision SET DEFAULT 'None';
+DROP TYPE bid_decision_type_old;
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TYPE bid_decision_type ADD VALUE IF NOT EXISTS 'Lost';
+
+ALTER TABLE bid_version ADD COLUMN IF NOT EXISTS price NUMERIC(15, 2);
+
+CREATE TABLE IF NOT EXISTS award (
+    id uuid PRIMARY KEY DEFAULT public.uuid_generate_v4(),
+    tender_id uuid NOT NULL UNIQUE,
+    bid_id uuid NOT NULL,
+    bid_version INT NOT NULL,
+    amount NUMERIC(15, 2),
+    approvers VARCHAR(50)[] NOT NULL DEFAULT '{}',
+    awarded_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE award ADD CONSTRAINT fk_tender_id FOREIGN KEY (tender_id) REFERENCES tender(id) ON DELETE CASCADE;
+ALTER TABLE award ADD CONSTRAINT fk_bid_id FOREIGN KEY (bid_id) REFERENCES bid(id) ON DELETE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS award;
+
+ALTER TABLE bid_version DROP COLUMN IF EXISTS price;
+
+UPDATE bid SET decision = 'NotAwarded' WHERE decision = 'Lost';
+ALTER TYPE bid_decision_type RENAME TO bid_decision_type_old;
+CREATE TYPE bid_decision_type AS ENUM (
+    'Approved',
+    'None',
+    'Rejected',
+    'NotAwarded'
+);
+ALTER TABLE bid ALTER COLUMN decision DROP DEFAULT;
+ALTER TABLE bid ALTER COLUMN decision TYPE bid_decision_type USING decision::text::bid_decision_type;
+ALTER TABLE bid ALTER COLUMN decision SET DEFAULT 'None';
+DROP TYPE bid_decision_type_old;
+-- +goose StatementEnd
@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE SEQUENCE IF NOT EXISTS contract_number_seq;
+
+CREATE TABLE IF NOT EXISTS contract (
+    id uuid PRIMARY KEY DEFAULT public.uuid_generate_v4(),
+    number VARCHAR(50) NOT NULL UNIQUE DEFAULT 'C-' || lpad(nextval('contract_number_seq')::text, 6, '0'),
+    award_id uuid NOT NULL UNIQUE,
+    tender_id uuid NOT NULL,
+    bid_id uuid NOT NULL,
+    customer_organization_id uuid NOT NULL,
+    supplier_type bid_author_type NOT NULL,
+    supplier_id uuid NOT NULL,
+    value NUMERIC(15, 2),
+    start_date TIMESTAMPTZ NOT NULL,
+    end_date TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (end_date > start_date)
+);
+
+CREATE TABLE IF NOT EXISTS contract_milestone (
+    id uuid PRIMARY KEY DEFAULT public.uuid_generate_v4(),
+    contract_id uuid NOT NULL,
+    title VARCHAR(100) NOT NULL,
+    due_date TIMESTAMPTZ NOT NULL,
+    completed_at TIMESTAMPTZ,
+    completed_by VARCHAR(50),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE contract ADD CONSTRAINT fk_award_id FOREIGN KEY (award_id) REFERENCES award(id) ON DELETE CASCADE;
+ALTER TABLE contract ADD CONSTRAINT fk_tender_id FOREIGN KEY (tender_id) REFERENCES tender(id) ON DELETE CASCADE;
+ALTER TABLE contract ADD CONSTRAINT fk_bid_id FOREIGN KEY (bid_id) REFERENCES bid(id) ON DELETE CASCADE;
+ALTER TABLE contract ADD CONSTRAINT fk_customer_organization_id FOREIGN KEY (customer_organization_id) REFERENCES organization(id);
+ALTER TABLE contract ADD CONSTRAINT fk_supplier_id FOREIGN KEY (supplier_id) REFERENCES employee(id);
+
+ALTER TABLE contract_milestone ADD CONSTRAINT fk_contract_id FOREIGN KEY (contract_id) REFERENCES contract(id) ON DELETE CASCADE;
+ALTER TABLE contract_milestone ADD CONSTRAINT fk_completed_by FOREIGN KEY (completed_by) REFERENCES employee(username);
+
+CREATE INDEX IF NOT EXISTS contract_customer_organization_id_idx ON contract (customer_organization_id);
+CREATE INDEX IF NOT EXISTS contract_supplier_id_idx ON contract (supplier_id);
+CREATE INDEX IF NOT EXISTS contract_milestone_contract_id_idx ON contract_milestone (contract_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS contract_milestone;
+DROP TABLE IF EXISTS contract;
+DROP SEQUENCE IF EXISTS contract_number_seq;
+-- +goose StatementEnd
@@ -0,0 +1,634 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: tender/v1/bid.proto
+
+package tenderv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	BidService_CreateBid_FullMethodName           = "/tender.v1.BidService/CreateBid"
+	BidService_GetUserBids_FullMethodName         = "/tender.v1.BidService/GetUserBids"
+	BidService_GetTenderBids_FullMethodName       = "/tender.v1.BidService/GetTenderBids"
+	BidService_StreamTenderBids_FullMethodName    = "/tender.v1.BidService/StreamTenderBids"
+	BidService_GetBidStatus_FullMethodName        = "/tender.v1.BidService/GetBidStatus"
+	BidService_UpdateBidStatus_FullMethodName     = "/tender.v1.BidService/UpdateBidStatus"
+	BidService_EditBid_FullMethodName             = "/tender.v1.BidService/EditBid"
+	BidService_SubmitBidDecision_FullMethodName   = "/tender.v1.BidService/SubmitBidDecision"
+	BidService_WithdrawBidDecision_FullMethodName = "/tender.v1.BidService/WithdrawBidDecision"
+	BidService_GetBidDecisions_FullMethodName     = "/tender.v1.BidService/GetBidDecisions"
+	BidService_CreateBidFeedback_FullMethodName   = "/tender.v1.BidService/CreateBidFeedback"
+	BidService_RollbackBid_FullMethodName         = "/tender.v1.BidService/RollbackBid"
+	BidService_GetBidReviews_FullMethodName       = "/tender.v1.BidService/GetBidReviews"
+	BidService_WithdrawBid_FullMethodName         = "/tender.v1.BidService/WithdrawBid"
+)
+
+// BidServiceClient is the client API for BidService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BidServiceClient interface {
+	CreateBid(ctx context.Context, in *CreateBidRequest, opts ...grpc.CallOption) (*CreateBidResponse, error)
+	GetUserBids(ctx context.Context, in *GetUserBidsRequest, opts ...grpc.CallOption) (*GetUserBidsResponse, error)
+	GetTenderBids(ctx context.Context, in *GetTenderBidsRequest, opts ...grpc.CallOption) (*GetTenderBidsResponse, error)
+	// StreamTenderBids pages through all bids of the tender and streams them one by one
+	StreamTenderBids(ctx context.Context, in *StreamTenderBidsRequest, opts ...grpc.CallOption) (BidService_StreamTenderBidsClient, error)
+	GetBidStatus(ctx context.Context, in *GetBidStatusRequest, opts ...grpc.CallOption) (*GetBidStatusResponse, error)
+	UpdateBidStatus(ctx context.Context, in *UpdateBidStatusRequest, opts ...grpc.CallOption) (*UpdateBidStatusResponse, error)
+	EditBid(ctx context.Context, in *EditBidRequest, opts ...grpc.CallOption) (*EditBidResponse, error)
+	SubmitBidDecision(ctx context.Context, in *SubmitBidDecisionRequest, opts ...grpc.CallOption) (*SubmitBidDecisionResponse, error)
+	WithdrawBidDecision(ctx context.Context, in *WithdrawBidDecisionRequest, opts ...grpc.CallOption) (*WithdrawBidDecisionResponse, error)
+	GetBidDecisions(ctx context.Context, in *GetBidDecisionsRequest, opts ...grpc.CallOption) (*GetBidDecisionsResponse, error)
+	CreateBidFeedback(ctx context.Context, in *CreateBidFeedbackRequest, opts ...grpc.CallOption) (*CreateBidFeedbackResponse, error)
+	RollbackBid(ctx context.Context, in *RollbackBidRequest, opts ...grpc.CallOption) (*RollbackBidResponse, error)
+	GetBidReviews(ctx context.Context, in *GetBidReviewsRequest, opts ...grpc.CallOption) (*GetBidReviewsResponse, error)
+	WithdrawBid(ctx context.Context, in *WithdrawBidRequest, opts ...grpc.CallOption) (*WithdrawBidResponse, error)
+}
+
+type bidServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBidServiceClient(cc grpc.ClientConnInterface) BidServiceClient {
+	return &bidServiceClient{cc}
+}
+
+func (c *bidServiceClient) CreateBid(ctx context.Context, in *CreateBidRequest, opts ...grpc.CallOption) (*CreateBidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBidResponse)
+	err := c.cc.Invoke(ctx, BidService_CreateBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) GetUserBids(ctx context.Context, in *GetUserBidsRequest, opts ...grpc.CallOption) (*GetUserBidsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserBidsResponse)
+	err := c.cc.Invoke(ctx, BidService_GetUserBids_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) GetTenderBids(ctx context.Context, in *GetTenderBidsRequest, opts ...grpc.CallOption) (*GetTenderBidsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTenderBidsResponse)
+	err := c.cc.Invoke(ctx, BidService_GetTenderBids_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) StreamTenderBids(ctx context.Context, in *StreamTenderBidsRequest, opts ...grpc.CallOption) (BidService_StreamTenderBidsClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BidService_ServiceDesc.Streams[0], BidService_StreamTenderBids_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bidServiceStreamTenderBidsClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BidService_StreamTenderBidsClient interface {
+	Recv() (*StreamTenderBidsResponse, error)
+	grpc.ClientStream
+}
+
+type bidServiceStreamTenderBidsClient struct {
+	grpc.ClientStream
+}
+
+func (x *bidServiceStreamTenderBidsClient) Recv() (*StreamTenderBidsResponse, error) {
+	m := new(StreamTenderBidsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bidServiceClient) GetBidStatus(ctx context.Context, in *GetBidStatusRequest, opts ...grpc.CallOption) (*GetBidStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBidStatusResponse)
+	err := c.cc.Invoke(ctx, BidService_GetBidStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) UpdateBidStatus(ctx context.Context, in *UpdateBidStatusRequest, opts ...grpc.CallOption) (*UpdateBidStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateBidStatusResponse)
+	err := c.cc.Invoke(ctx, BidService_UpdateBidStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) EditBid(ctx context.Context, in *EditBidRequest, opts ...grpc.CallOption) (*EditBidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditBidResponse)
+	err := c.cc.Invoke(ctx, BidService_EditBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) SubmitBidDecision(ctx context.Context, in *SubmitBidDecisionRequest, opts ...grpc.CallOption) (*SubmitBidDecisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitBidDecisionResponse)
+	err := c.cc.Invoke(ctx, BidService_SubmitBidDecision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) WithdrawBidDecision(ctx context.Context, in *WithdrawBidDecisionRequest, opts ...grpc.CallOption) (*WithdrawBidDecisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WithdrawBidDecisionResponse)
+	err := c.cc.Invoke(ctx, BidService_WithdrawBidDecision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) GetBidDecisions(ctx context.Context, in *GetBidDecisionsRequest, opts ...grpc.CallOption) (*GetBidDecisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBidDecisionsResponse)
+	err := c.cc.Invoke(ctx, BidService_GetBidDecisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) CreateBidFeedback(ctx context.Context, in *CreateBidFeedbackRequest, opts ...grpc.CallOption) (*CreateBidFeedbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBidFeedbackResponse)
+	err := c.cc.Invoke(ctx, BidService_CreateBidFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) RollbackBid(ctx context.Context, in *RollbackBidRequest, opts ...grpc.CallOption) (*RollbackBidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackBidResponse)
+	err := c.cc.Invoke(ctx, BidService_RollbackBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) GetBidReviews(ctx context.Context, in *GetBidReviewsRequest, opts ...grpc.CallOption) (*GetBidReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBidReviewsResponse)
+	err := c.cc.Invoke(ctx, BidService_GetBidReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bidServiceClient) WithdrawBid(ctx context.Context, in *WithdrawBidRequest, opts ...grpc.CallOption) (*WithdrawBidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WithdrawBidResponse)
+	err := c.cc.Invoke(ctx, BidService_WithdrawBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BidServiceServer is the server API for BidService service.
+// All implementations must embed UnimplementedBidServiceServer
+// for forward compatibility
+type BidServiceServer interface {
+	CreateBid(context.Context, *CreateBidRequest) (*CreateBidResponse, error)
+	GetUserBids(context.Context, *GetUserBidsRequest) (*GetUserBidsResponse, error)
+	GetTenderBids(context.Context, *GetTenderBidsRequest) (*GetTenderBidsResponse, error)
+	// StreamTenderBids pages through all bids of the tender and streams them one by one
+	StreamTenderBids(*StreamTenderBidsRequest, BidService_StreamTenderBidsServer) error
+	GetBidStatus(context.Context, *GetBidStatusRequest) (*GetBidStatusResponse, error)
+	UpdateBidStatus(context.Context, *UpdateBidStatusRequest) (*UpdateBidStatusResponse, error)
+	EditBid(context.Context, *EditBidRequest) (*EditBidResponse, error)
+	SubmitBidDecision(context.Context, *SubmitBidDecisionRequest) (*SubmitBidDecisionResponse, error)
+	WithdrawBidDecision(context.Context, *WithdrawBidDecisionRequest) (*WithdrawBidDecisionResponse, error)
+	GetBidDecisions(context.Context, *GetBidDecisionsRequest) (*GetBidDecisionsResponse, error)
+	CreateBidFeedback(context.Context, *CreateBidFeedbackRequest) (*CreateBidFeedbackResponse, error)
+	RollbackBid(context.Context, *RollbackBidRequest) (*RollbackBidResponse, error)
+	GetBidReviews(context.Context, *GetBidReviewsRequest) (*GetBidReviewsResponse, error)
+	WithdrawBid(context.Context, *WithdrawBidRequest) (*WithdrawBidResponse, error)
+	mustEmbedUnimplementedBidServiceServer()
+}
+
+// UnimplementedBidServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBidServiceServer struct {
+}
+
+func (UnimplementedBidServiceServer) CreateBid(context.Context, *CreateBidRequest) (*CreateBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBid not implemented")
+}
+func (UnimplementedBidServiceServer) GetUserBids(context.Context, *GetUserBidsRequest) (*GetUserBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserBids not implemented")
+}
+func (UnimplementedBidServiceServer) GetTenderBids(context.Context, *GetTenderBidsRequest) (*GetTenderBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenderBids not implemented")
+}
+func (UnimplementedBidServiceServer) StreamTenderBids(*StreamTenderBidsRequest, BidService_StreamTenderBidsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTenderBids not implemented")
+}
+func (UnimplementedBidServiceServer) GetBidStatus(context.Context, *GetBidStatusRequest) (*GetBidStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBidStatus not implemented")
+}
+func (UnimplementedBidServiceServer) UpdateBidStatus(context.Context, *UpdateBidStatusRequest) (*UpdateBidStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBidStatus not implemented")
+}
+func (UnimplementedBidServiceServer) EditBid(context.Context, *EditBidRequest) (*EditBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditBid not implemented")
+}
+func (UnimplementedBidServiceServer) SubmitBidDecision(context.Context, *SubmitBidDecisionRequest) (*SubmitBidDecisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBidDecision not implemented")
+}
+func (UnimplementedBidServiceServer) WithdrawBidDecision(context.Context, *WithdrawBidDecisionRequest) (*WithdrawBidDecisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawBidDecision not implemented")
+}
+func (UnimplementedBidServiceServer) GetBidDecisions(context.Context, *GetBidDecisionsRequest) (*GetBidDecisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBidDecisions not implemented")
+}
+func (UnimplementedBidServiceServer) CreateBidFeedback(context.Context, *CreateBidFeedbackRequest) (*CreateBidFeedbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBidFeedback not implemented")
+}
+func (UnimplementedBidServiceServer) RollbackBid(context.Context, *RollbackBidRequest) (*RollbackBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackBid not implemented")
+}
+func (UnimplementedBidServiceServer) GetBidReviews(context.Context, *GetBidReviewsRequest) (*GetBidReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBidReviews not implemented")
+}
+func (UnimplementedBidServiceServer) WithdrawBid(context.Context, *WithdrawBidRequest) (*WithdrawBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawBid not implemented")
+}
+func (UnimplementedBidServiceServer) mustEmbedUnimplementedBidServiceServer() {}
+
+// UnsafeBidServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BidServiceServer will
+// result in compilation errors.
+type UnsafeBidServiceServer interface {
+	mustEmbedUnimplementedBidServiceServer()
+}
+
+func RegisterBidServiceServer(s grpc.ServiceRegistrar, srv BidServiceServer) {
+	s.RegisterService(&BidService_ServiceDesc, srv)
+}
+
+func _BidService_CreateBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).CreateBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_CreateBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).CreateBid(ctx, req.(*CreateBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_GetUserBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserBidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).GetUserBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_GetUserBids_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).GetUserBids(ctx, req.(*GetUserBidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_GetTenderBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenderBidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).GetTenderBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_GetTenderBids_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).GetTenderBids(ctx, req.(*GetTenderBidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_StreamTenderBids_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamTenderBidsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BidServiceServer).StreamTenderBids(m, &bidServiceStreamTenderBidsServer{ServerStream: stream})
+}
+
+type BidService_StreamTenderBidsServer interface {
+	Send(*StreamTenderBidsResponse) error
+	grpc.ServerStream
+}
+
+type bidServiceStreamTenderBidsServer struct {
+	grpc.ServerStream
+}
+
+func (x *bidServiceStreamTenderBidsServer) Send(m *StreamTenderBidsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BidService_GetBidStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBidStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).GetBidStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_GetBidStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).GetBidStatus(ctx, req.(*GetBidStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_UpdateBidStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBidStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).UpdateBidStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_UpdateBidStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).UpdateBidStatus(ctx, req.(*UpdateBidStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_EditBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).EditBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_EditBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).EditBid(ctx, req.(*EditBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_SubmitBidDecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitBidDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).SubmitBidDecision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_SubmitBidDecision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).SubmitBidDecision(ctx, req.(*SubmitBidDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_WithdrawBidDecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawBidDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).WithdrawBidDecision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_WithdrawBidDecision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).WithdrawBidDecision(ctx, req.(*WithdrawBidDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_GetBidDecisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBidDecisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).GetBidDecisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_GetBidDecisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).GetBidDecisions(ctx, req.(*GetBidDecisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_CreateBidFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBidFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).CreateBidFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_CreateBidFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).CreateBidFeedback(ctx, req.(*CreateBidFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_RollbackBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).RollbackBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_RollbackBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).RollbackBid(ctx, req.(*RollbackBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_GetBidReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBidReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).GetBidReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_GetBidReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).GetBidReviews(ctx, req.(*GetBidReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BidService_WithdrawBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).WithdrawBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_WithdrawBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).WithdrawBid(ctx, req.(*WithdrawBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BidService_ServiceDesc is the grpc.ServiceDesc for BidService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BidService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tender.v1.BidService",
+	HandlerType: (*BidServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateBid",
+			Handler:    _BidService_CreateBid_Handler,
+		},
+		{
+			MethodName: "GetUserBids",
+			Handler:    _BidService_GetUserBids_Handler,
+		},
+		{
+			MethodName: "GetTenderBids",
+			Handler:    _BidService_GetTenderBids_Handler,
+		},
+		{
+			MethodName: "GetBidStatus",
+			Handler:    _BidService_GetBidStatus_Handler,
+		},
+		{
+			MethodName: "UpdateBidStatus",
+			Handler:    _BidService_UpdateBidStatus_Handler,
+		},
+		{
+			MethodName: "EditBid",
+			Handler:    _BidService_EditBid_Handler,
+		},
+		{
+			MethodName: "SubmitBidDecision",
+			Handler:    _BidService_SubmitBidDecision_Handler,
+		},
+		{
+			MethodName: "WithdrawBidDecision",
+			Handler:    _BidService_WithdrawBidDecision_Handler,
+		},
+		{
+			MethodName: "GetBidDecisions",
+			Handler:    _BidService_GetBidDecisions_Handler,
+		},
+		{
+			MethodName: "CreateBidFeedback",
+			Handler:    _BidService_CreateBidFeedback_Handler,
+		},
+		{
+			MethodName: "RollbackBid",
+			Handler:    _BidService_RollbackBid_Handler,
+		},
+		{
+			MethodName: "GetBidReviews",
+			Handler:    _BidService_GetBidReviews_Handler,
+		},
+		{
+			MethodName: "WithdrawBid",
+			Handler:    _BidService_WithdrawBid_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamTenderBids",
+			Handler:       _BidService_StreamTenderBids_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "tender/v1/bid.proto",
+}
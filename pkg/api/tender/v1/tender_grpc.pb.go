@@ -0,0 +1,482 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: tender/v1/tender.proto
+
+package tenderv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	TenderService_GetTenders_FullMethodName         = "/tender.v1.TenderService/GetTenders"
+	TenderService_CreateTender_FullMethodName       = "/tender.v1.TenderService/CreateTender"
+	TenderService_GetUserTenders_FullMethodName     = "/tender.v1.TenderService/GetUserTenders"
+	TenderService_GetTenderStatus_FullMethodName    = "/tender.v1.TenderService/GetTenderStatus"
+	TenderService_UpdateTenderStatus_FullMethodName = "/tender.v1.TenderService/UpdateTenderStatus"
+	TenderService_CancelTender_FullMethodName       = "/tender.v1.TenderService/CancelTender"
+	TenderService_GetTenderAward_FullMethodName     = "/tender.v1.TenderService/GetTenderAward"
+	TenderService_EditTender_FullMethodName         = "/tender.v1.TenderService/EditTender"
+	TenderService_RollbackTender_FullMethodName     = "/tender.v1.TenderService/RollbackTender"
+	TenderService_WatchTenderEvents_FullMethodName  = "/tender.v1.TenderService/WatchTenderEvents"
+)
+
+// TenderServiceClient is the client API for TenderService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TenderServiceClient interface {
+	GetTenders(ctx context.Context, in *GetTendersRequest, opts ...grpc.CallOption) (*GetTendersResponse, error)
+	CreateTender(ctx context.Context, in *CreateTenderRequest, opts ...grpc.CallOption) (*CreateTenderResponse, error)
+	GetUserTenders(ctx context.Context, in *GetUserTendersRequest, opts ...grpc.CallOption) (*GetUserTendersResponse, error)
+	GetTenderStatus(ctx context.Context, in *GetTenderStatusRequest, opts ...grpc.CallOption) (*GetTenderStatusResponse, error)
+	UpdateTenderStatus(ctx context.Context, in *UpdateTenderStatusRequest, opts ...grpc.CallOption) (*UpdateTenderStatusResponse, error)
+	CancelTender(ctx context.Context, in *CancelTenderRequest, opts ...grpc.CallOption) (*CancelTenderResponse, error)
+	GetTenderAward(ctx context.Context, in *GetTenderAwardRequest, opts ...grpc.CallOption) (*GetTenderAwardResponse, error)
+	EditTender(ctx context.Context, in *EditTenderRequest, opts ...grpc.CallOption) (*EditTenderResponse, error)
+	RollbackTender(ctx context.Context, in *RollbackTenderRequest, opts ...grpc.CallOption) (*RollbackTenderResponse, error)
+	// WatchTenderEvents streams tender changes until the client cancels the call
+	WatchTenderEvents(ctx context.Context, in *WatchTenderEventsRequest, opts ...grpc.CallOption) (TenderService_WatchTenderEventsClient, error)
+}
+
+type tenderServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTenderServiceClient(cc grpc.ClientConnInterface) TenderServiceClient {
+	return &tenderServiceClient{cc}
+}
+
+func (c *tenderServiceClient) GetTenders(ctx context.Context, in *GetTendersRequest, opts ...grpc.CallOption) (*GetTendersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTendersResponse)
+	err := c.cc.Invoke(ctx, TenderService_GetTenders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) CreateTender(ctx context.Context, in *CreateTenderRequest, opts ...grpc.CallOption) (*CreateTenderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTenderResponse)
+	err := c.cc.Invoke(ctx, TenderService_CreateTender_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) GetUserTenders(ctx context.Context, in *GetUserTendersRequest, opts ...grpc.CallOption) (*GetUserTendersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserTendersResponse)
+	err := c.cc.Invoke(ctx, TenderService_GetUserTenders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) GetTenderStatus(ctx context.Context, in *GetTenderStatusRequest, opts ...grpc.CallOption) (*GetTenderStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTenderStatusResponse)
+	err := c.cc.Invoke(ctx, TenderService_GetTenderStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) UpdateTenderStatus(ctx context.Context, in *UpdateTenderStatusRequest, opts ...grpc.CallOption) (*UpdateTenderStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTenderStatusResponse)
+	err := c.cc.Invoke(ctx, TenderService_UpdateTenderStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) CancelTender(ctx context.Context, in *CancelTenderRequest, opts ...grpc.CallOption) (*CancelTenderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelTenderResponse)
+	err := c.cc.Invoke(ctx, TenderService_CancelTender_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) GetTenderAward(ctx context.Context, in *GetTenderAwardRequest, opts ...grpc.CallOption) (*GetTenderAwardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTenderAwardResponse)
+	err := c.cc.Invoke(ctx, TenderService_GetTenderAward_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) EditTender(ctx context.Context, in *EditTenderRequest, opts ...grpc.CallOption) (*EditTenderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditTenderResponse)
+	err := c.cc.Invoke(ctx, TenderService_EditTender_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) RollbackTender(ctx context.Context, in *RollbackTenderRequest, opts ...grpc.CallOption) (*RollbackTenderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackTenderResponse)
+	err := c.cc.Invoke(ctx, TenderService_RollbackTender_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) WatchTenderEvents(ctx context.Context, in *WatchTenderEventsRequest, opts ...grpc.CallOption) (TenderService_WatchTenderEventsClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TenderService_ServiceDesc.Streams[0], TenderService_WatchTenderEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tenderServiceWatchTenderEventsClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TenderService_WatchTenderEventsClient interface {
+	Recv() (*WatchTenderEventsResponse, error)
+	grpc.ClientStream
+}
+
+type tenderServiceWatchTenderEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *tenderServiceWatchTenderEventsClient) Recv() (*WatchTenderEventsResponse, error) {
+	m := new(WatchTenderEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// TenderServiceServer is the server API for TenderService service.
+// All implementations must embed UnimplementedTenderServiceServer
+// for forward compatibility
+type TenderServiceServer interface {
+	GetTenders(context.Context, *GetTendersRequest) (*GetTendersResponse, error)
+	CreateTender(context.Context, *CreateTenderRequest) (*CreateTenderResponse, error)
+	GetUserTenders(context.Context, *GetUserTendersRequest) (*GetUserTendersResponse, error)
+	GetTenderStatus(context.Context, *GetTenderStatusRequest) (*GetTenderStatusResponse, error)
+	UpdateTenderStatus(context.Context, *UpdateTenderStatusRequest) (*UpdateTenderStatusResponse, error)
+	CancelTender(context.Context, *CancelTenderRequest) (*CancelTenderResponse, error)
+	GetTenderAward(context.Context, *GetTenderAwardRequest) (*GetTenderAwardResponse, error)
+	EditTender(context.Context, *EditTenderRequest) (*EditTenderResponse, error)
+	RollbackTender(context.Context, *RollbackTenderRequest) (*RollbackTenderResponse, error)
+	// WatchTenderEvents streams tender changes until the client cancels the call
+	WatchTenderEvents(*WatchTenderEventsRequest, TenderService_WatchTenderEventsServer) error
+	mustEmbedUnimplementedTenderServiceServer()
+}
+
+// UnimplementedTenderServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTenderServiceServer struct {
+}
+
+func (UnimplementedTenderServiceServer) GetTenders(context.Context, *GetTendersRequest) (*GetTendersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenders not implemented")
+}
+func (UnimplementedTenderServiceServer) CreateTender(context.Context, *CreateTenderRequest) (*CreateTenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTender not implemented")
+}
+func (UnimplementedTenderServiceServer) GetUserTenders(context.Context, *GetUserTendersRequest) (*GetUserTendersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserTenders not implemented")
+}
+func (UnimplementedTenderServiceServer) GetTenderStatus(context.Context, *GetTenderStatusRequest) (*GetTenderStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenderStatus not implemented")
+}
+func (UnimplementedTenderServiceServer) UpdateTenderStatus(context.Context, *UpdateTenderStatusRequest) (*UpdateTenderStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenderStatus not implemented")
+}
+func (UnimplementedTenderServiceServer) CancelTender(context.Context, *CancelTenderRequest) (*CancelTenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTender not implemented")
+}
+func (UnimplementedTenderServiceServer) GetTenderAward(context.Context, *GetTenderAwardRequest) (*GetTenderAwardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenderAward not implemented")
+}
+func (UnimplementedTenderServiceServer) EditTender(context.Context, *EditTenderRequest) (*EditTenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditTender not implemented")
+}
+func (UnimplementedTenderServiceServer) RollbackTender(context.Context, *RollbackTenderRequest) (*RollbackTenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackTender not implemented")
+}
+func (UnimplementedTenderServiceServer) WatchTenderEvents(*WatchTenderEventsRequest, TenderService_WatchTenderEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTenderEvents not implemented")
+}
+func (UnimplementedTenderServiceServer) mustEmbedUnimplementedTenderServiceServer() {}
+
+// UnsafeTenderServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TenderServiceServer will
+// result in compilation errors.
+type UnsafeTenderServiceServer interface {
+	mustEmbedUnimplementedTenderServiceServer()
+}
+
+func RegisterTenderServiceServer(s grpc.ServiceRegistrar, srv TenderServiceServer) {
+	s.RegisterService(&TenderService_ServiceDesc, srv)
+}
+
+func _TenderService_GetTenders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTendersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).GetTenders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_GetTenders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).GetTenders(ctx, req.(*GetTendersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_CreateTender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).CreateTender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_CreateTender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).CreateTender(ctx, req.(*CreateTenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_GetUserTenders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserTendersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).GetUserTenders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_GetUserTenders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).GetUserTenders(ctx, req.(*GetUserTendersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_GetTenderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).GetTenderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_GetTenderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).GetTenderStatus(ctx, req.(*GetTenderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_UpdateTenderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTenderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).UpdateTenderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_UpdateTenderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).UpdateTenderStatus(ctx, req.(*UpdateTenderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_CancelTender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelTenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).CancelTender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_CancelTender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).CancelTender(ctx, req.(*CancelTenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_GetTenderAward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenderAwardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).GetTenderAward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_GetTenderAward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).GetTenderAward(ctx, req.(*GetTenderAwardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_EditTender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditTenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).EditTender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_EditTender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).EditTender(ctx, req.(*EditTenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_RollbackTender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackTenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).RollbackTender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_RollbackTender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).RollbackTender(ctx, req.(*RollbackTenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_WatchTenderEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTenderEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TenderServiceServer).WatchTenderEvents(m, &tenderServiceWatchTenderEventsServer{ServerStream: stream})
+}
+
+type TenderService_WatchTenderEventsServer interface {
+	Send(*WatchTenderEventsResponse) error
+	grpc.ServerStream
+}
+
+type tenderServiceWatchTenderEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *tenderServiceWatchTenderEventsServer) Send(m *WatchTenderEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// TenderService_ServiceDesc is the grpc.ServiceDesc for TenderService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TenderService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tender.v1.TenderService",
+	HandlerType: (*TenderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTenders",
+			Handler:    _TenderService_GetTenders_Handler,
+		},
+		{
+			MethodName: "CreateTender",
+			Handler:    _TenderService_CreateTender_Handler,
+		},
+		{
+			MethodName: "GetUserTenders",
+			Handler:    _TenderService_GetUserTenders_Handler,
+		},
+		{
+			MethodName: "GetTenderStatus",
+			Handler:    _TenderService_GetTenderStatus_Handler,
+		},
+		{
+			MethodName: "UpdateTenderStatus",
+			Handler:    _TenderService_UpdateTenderStatus_Handler,
+		},
+		{
+			MethodName: "CancelTender",
+			Handler:    _TenderService_CancelTender_Handler,
+		},
+		{
+			MethodName: "GetTenderAward",
+			Handler:    _TenderService_GetTenderAward_Handler,
+		},
+		{
+			MethodName: "EditTender",
+			Handler:    _TenderService_EditTender_Handler,
+		},
+		{
+			MethodName: "RollbackTender",
+			Handler:    _TenderService_RollbackTender_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchTenderEvents",
+			Handler:       _TenderService_WatchTenderEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "tender/v1/tender.proto",
+}
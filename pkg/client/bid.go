@@ -0,0 +1,158 @@
+package client
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"net/http"
+	"net/url"
+	"strconv"
+	"tender-service/internal/model/dto"
+	"tender-service/internal/model/entity/bid"
+	"tender-service/internal/model/entity/decision"
+	"tender-service/internal/util"
+)
+
+func (c *Client) CreateBid(ctx context.Context, createDto dto.CreateBidDto) (dto.BidDto, error) {
+	var created dto.BidDto
+	_, err := c.do(ctx, call{method: http.MethodPost, path: "/bids/new", body: createDto, idempotencyKey: true}, &created)
+	return created, err
+}
+
+func (c *Client) GetUserBids(ctx context.Context, page Page, username string) ([]dto.BidDto, util.PageInfo, error) {
+	query := page.query()
+	query.Set("username", username)
+
+	var bids []dto.BidDto
+	header, err := c.do(ctx, call{method: http.MethodGet, path: "/bids/my", query: query}, &bids)
+	if err != nil {
+		return nil, util.PageInfo{}, err
+	}
+	return bids, pageInfo(header), nil
+}
+
+func (c *Client) GetTenderBids(ctx context.Context, page Page, tenderId uuid.UUID, username string) ([]dto.BidDto, util.PageInfo, error) {
+	query := page.query()
+	query.Set("username", username)
+
+	var bids []dto.BidDto
+	header, err := c.do(ctx, call{method: http.MethodGet, path: "/bids/" + tenderId.String() + "/list", query: query}, &bids)
+	if err != nil {
+		return nil, util.PageInfo{}, err
+	}
+	return bids, pageInfo(header), nil
+}
+
+func (c *Client) GetBidStatus(ctx context.Context, bidId uuid.UUID, username string) (bid.Status, error) {
+	var status bid.Status
+	_, err := c.do(ctx, call{
+		method: http.MethodGet,
+		path:   "/bids/" + bidId.String() + "/status",
+		query:  url.Values{"username": {username}},
+	}, &status)
+	return status, err
+}
+
+func (c *Client) UpdateBidStatus(ctx context.Context, bidId uuid.UUID, username string, status bid.Status) (dto.BidDto, error) {
+	var updated dto.BidDto
+	_, err := c.do(ctx, call{
+		method: http.MethodPut,
+		path:   "/bids/" + bidId.String() + "/status",
+		query:  url.Values{"username": {username}, "status": {string(status)}},
+	}, &updated)
+	return updated, err
+}
+
+func (c *Client) EditBid(ctx context.Context, bidId uuid.UUID, username string, updateDto dto.UpdateBidDto) (dto.BidDto, error) {
+	var updated dto.BidDto
+	_, err := c.do(ctx, call{
+		method: http.MethodPatch,
+		path:   "/bids/" + bidId.String() + "/edit",
+		query:  url.Values{"username": {username}},
+		body:   updateDto,
+	}, &updated)
+	return updated, err
+}
+
+func (c *Client) SubmitBidDecision(ctx context.Context, bidId uuid.UUID, username string, verdict decision.Verdict) (dto.BidDto, error) {
+	var updated dto.BidDto
+	_, err := c.do(ctx, call{
+		method:         http.MethodPut,
+		path:           "/bids/" + bidId.String() + "/submit_decision",
+		query:          url.Values{"username": {username}, "decision": {string(verdict)}},
+		idempotencyKey: true,
+	}, &updated)
+	return updated, err
+}
+
+func (c *Client) WithdrawBidDecision(ctx context.Context, bidId uuid.UUID, username string) (dto.BidDto, error) {
+	var updated dto.BidDto
+	_, err := c.do(ctx, call{
+		method: http.MethodDelete,
+		path:   "/bids/" + bidId.String() + "/submit_decision",
+		query:  url.Values{"username": {username}},
+	}, &updated)
+	return updated, err
+}
+
+func (c *Client) WithdrawBid(ctx context.Context, bidId uuid.UUID, username, reason string) (dto.BidDto, error) {
+	var withdrawn dto.BidDto
+	_, err := c.do(ctx, call{
+		method: http.MethodPut,
+		path:   "/bids/" + bidId.String() + "/withdraw",
+		query:  url.Values{"username": {username}},
+		body:   dto.WithdrawBidDto{Reason: reason},
+	}, &withdrawn)
+	return withdrawn, err
+}
+
+func (c *Client) GetBidDecisions(ctx context.Context, bidId uuid.UUID, username string) ([]dto.DecisionDto, error) {
+	var decisions []dto.DecisionDto
+	_, err := c.do(ctx, call{
+		method: http.MethodGet,
+		path:   "/bids/" + bidId.String() + "/decisions",
+		query:  url.Values{"username": {username}},
+	}, &decisions)
+	return decisions, err
+}
+
+// CreateBidFeedback rates the bid author together with the feedback when scores are given
+func (c *Client) CreateBidFeedback(ctx context.Context, bidId uuid.UUID, username string, feedback string, scores *dto.RatingScoresDto) (dto.BidDto, error) {
+	query := url.Values{"username": {username}, "bidFeedback": {feedback}}
+	if scores != nil {
+		query.Set("quality", strconv.Itoa(scores.Quality))
+		query.Set("timeliness", strconv.Itoa(scores.Timeliness))
+		query.Set("communication", strconv.Itoa(scores.Communication))
+	}
+
+	var updated dto.BidDto
+	_, err := c.do(ctx, call{
+		method:         http.MethodPut,
+		path:           "/bids/" + bidId.String() + "/feedback",
+		query:          query,
+		idempotencyKey: true,
+	}, &updated)
+	return updated, err
+}
+
+func (c *Client) RollbackBid(ctx context.Context, bidId uuid.UUID, version int, username string) (dto.BidDto, error) {
+	var updated dto.BidDto
+	_, err := c.do(ctx, call{
+		method: http.MethodPut,
+		path:   "/bids/" + bidId.String() + "/rollback/" + strconv.Itoa(version),
+		query:  url.Values{"username": {username}},
+	}, &updated)
+	return updated, err
+}
+
+func (c *Client) GetBidReviews(ctx context.Context, page Page, tenderId uuid.UUID, authorUsername, requesterUsername string) ([]dto.FeedbackDto, util.PageInfo, error) {
+	query := page.query()
+	query.Set("authorUsername", authorUsername)
+	query.Set("requesterUsername", requesterUsername)
+
+	var reviews []dto.FeedbackDto
+	header, err := c.do(ctx, call{method: http.MethodGet, path: "/bids/" + tenderId.String() + "/reviews", query: query}, &reviews)
+	if err != nil {
+		return nil, util.PageInfo{}, err
+	}
+	return reviews, pageInfo(header), nil
+}
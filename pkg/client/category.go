@@ -0,0 +1,43 @@
+package client
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"net/http"
+	"tender-service/internal/model/dto"
+)
+
+func (c *Client) GetCategories(ctx context.Context) ([]dto.CategoryDto, error) {
+	var categories []dto.CategoryDto
+	_, err := c.do(ctx, call{method: http.MethodGet, path: "/categories"}, &categories)
+	return categories, err
+}
+
+func (c *Client) GetCategory(ctx context.Context, categoryId uuid.UUID) (dto.CategoryDto, error) {
+	var category dto.CategoryDto
+	_, err := c.do(ctx, call{method: http.MethodGet, path: "/categories/" + categoryId.String()}, &category)
+	return category, err
+}
+
+// CreateCategory and other admin calls need WithAdminToken
+func (c *Client) CreateCategory(ctx context.Context, createDto dto.CreateCategoryDto) (dto.CategoryDto, error) {
+	var created dto.CategoryDto
+	_, err := c.do(ctx, call{method: http.MethodPost, path: "/admin/categories/new", body: createDto, admin: true}, &created)
+	return created, err
+}
+
+func (c *Client) EditCategory(ctx context.Context, categoryId uuid.UUID, updateDto dto.UpdateCategoryDto) (dto.CategoryDto, error) {
+	var updated dto.CategoryDto
+	_, err := c.do(ctx, call{
+		method: http.MethodPatch,
+		path:   "/admin/categories/" + categoryId.String() + "/edit",
+		body:   updateDto,
+		admin:  true,
+	}, &updated)
+	return updated, err
+}
+
+func (c *Client) DeleteCategory(ctx context.Context, categoryId uuid.UUID) error {
+	_, err := c.do(ctx, call{method: http.MethodDelete, path: "/admin/categories/" + categoryId.String(), admin: true}, nil)
+	return err
+}
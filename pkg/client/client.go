@@ -0,0 +1,279 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	contentTypeHeader     = "Content-Type"
+	acceptLanguageHeader  = "Accept-Language"
+	authorizationHeader   = "Authorization"
+	idempotencyKeyHeader  = "Idempotency-Key"
+	requestIdHeader       = "X-Request-ID"
+	retryAfterHeader      = "Retry-After"
+	nextCursorHeader      = "X-Next-Cursor"
+	totalCountHeader      = "X-Total-Count"
+	jsonContentType       = "application/json"
+	bearerPrefix          = "Bearer "
+	defaultTimeout        = 30 * time.Second
+	defaultMaxAttempts    = 3
+	defaultMinBackoff     = 100 * time.Millisecond
+	defaultMaxBackoff     = 2 * time.Second
+	maxErrorBodySize      = 1 << 20
+	idempotencyContextKey = contextKey("idempotency_key")
+	requestIdContextKey   = contextKey("request_id")
+)
+
+type contextKey string
+
+// Client calls the tender service REST API, base url must point to the /api root, e.g. http://localhost:8080/api
+type Client struct {
+	baseUrl    *url.URL
+	httpClient *http.Client
+	retry      RetryPolicy
+	language   string
+	adminToken string
+}
+
+// RetryPolicy controls retries of idempotent calls, attempts include the first one
+type RetryPolicy struct {
+	MaxAttempts int
+	MinBackoff  time.Duration
+	MaxBackoff  time.Duration
+}
+
+type Option func(*Client)
+
+func WithHttpClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
+
+// WithLanguage sets Accept-Language, so error details come back in that language
+func WithLanguage(language string) Option {
+	return func(c *Client) {
+		c.language = language
+	}
+}
+
+// WithAdminToken sets the bearer token for /admin endpoints
+func WithAdminToken(token string) Option {
+	return func(c *Client) {
+		c.adminToken = token
+	}
+}
+
+func New(baseUrl string, opts ...Option) (*Client, error) {
+	parsed, err := url.Parse(strings.TrimSuffix(baseUrl, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("incorrect base url: %w", err)
+	}
+	if parsed.Scheme == "" || parsed.Host == "" {
+		return nil, fmt.Errorf("incorrect base url: %s", baseUrl)
+	}
+
+	c := &Client{
+		baseUrl:    parsed,
+		httpClient: &http.Client{Timeout: defaultTimeout},
+		retry: RetryPolicy{
+			MaxAttempts: defaultMaxAttempts,
+			MinBackoff:  defaultMinBackoff,
+			MaxBackoff:  defaultMaxBackoff,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.retry.MaxAttempts <= 0 {
+		c.retry.MaxAttempts = 1
+	}
+	if c.retry.MinBackoff <= 0 {
+		c.retry.MinBackoff = defaultMinBackoff
+	}
+	if c.retry.MaxBackoff < c.retry.MinBackoff {
+		c.retry.MaxBackoff = c.retry.MinBackoff
+	}
+
+	return c, nil
+}
+
+// WithIdempotencyKey makes create and decision calls send key instead of a generated one,
+// so the same operation can be safely repeated across process restarts
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyContextKey, key)
+}
+
+// WithRequestId propagates a request id to the service logs
+func WithRequestId(ctx context.Context, requestId string) context.Context {
+	return context.WithValue(ctx, requestIdContextKey, requestId)
+}
+
+type call struct {
+	method string
+	path   string
+	query  url.Values
+	body   any
+	// idempotencyKey sends Idempotency-Key, which makes the call safe to retry
+	idempotencyKey bool
+	admin          bool
+}
+
+// do sends the call and decodes a successful response into out, GET calls and calls with
+// Idempotency-Key are retried on network errors, 429 and 502-504
+func (c *Client) do(ctx context.Context, cl call, out any) (http.Header, error) {
+	var body []byte
+	if cl.body != nil {
+		var err error
+		body, err = json.Marshal(cl.body)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode request body: %w", err)
+		}
+	}
+
+	headers := c.headers(ctx, cl)
+	retryable := cl.method == http.MethodGet || cl.idempotencyKey
+
+	for attempt := 1; ; attempt++ {
+		resp, err := c.send(ctx, cl, headers, body)
+		if err != nil {
+			if ctx.Err() != nil || !retryable || attempt >= c.retry.MaxAttempts {
+				return nil, err
+			}
+			if err = c.wait(ctx, attempt, 0); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if resp.StatusCode >= http.StatusBadRequest {
+			apiErr := readError(resp)
+			if !retryable || attempt >= c.retry.MaxAttempts || !isRetryableStatus(resp.StatusCode) {
+				return resp.Header, apiErr
+			}
+			if err = c.wait(ctx, attempt, retryAfter(resp.Header)); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		err = readBody(resp, out)
+		return resp.Header, err
+	}
+}
+
+func (c *Client) headers(ctx context.Context, cl call) http.Header {
+	headers := make(http.Header)
+	if cl.body != nil {
+		headers.Set(contentTypeHeader, jsonContentType)
+	}
+	if c.language != "" {
+		headers.Set(acceptLanguageHeader, c.language)
+	}
+	if cl.admin && c.adminToken != "" {
+		headers.Set(authorizationHeader, bearerPrefix+c.adminToken)
+	}
+	if requestId, ok := ctx.Value(requestIdContextKey).(string); ok && requestId != "" {
+		headers.Set(requestIdHeader, requestId)
+	}
+	if cl.idempotencyKey {
+		key, ok := ctx.Value(idempotencyContextKey).(string)
+		if !ok || key == "" {
+			key = uuid.NewString()
+		}
+		headers.Set(idempotencyKeyHeader, key)
+	}
+	return headers
+}
+
+func (c *Client) send(ctx context.Context, cl call, headers http.Header, body []byte) (*http.Response, error) {
+	target := c.baseUrl.JoinPath(cl.path)
+	if len(cl.query) > 0 {
+		target.RawQuery = cl.query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, cl.method, target.String(), reader)
+	if err != nil {
+		return nil, err
+	}
+	req.Header = headers.Clone()
+
+	return c.httpClient.Do(req)
+}
+
+func (c *Client) wait(ctx context.Context, attempt int, hint time.Duration) error {
+	delay := c.retry.MinBackoff << (attempt - 1)
+	if delay <= 0 || delay > c.retry.MaxBackoff {
+		delay = c.retry.MaxBackoff
+	}
+	// full jitter keeps concurrent clients from retrying in lockstep
+	delay = time.Duration(rand.Int63n(int64(delay)) + 1)
+	if hint > delay {
+		delay = hint
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func isRetryableStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func retryAfter(header http.Header) time.Duration {
+	seconds, err := strconv.Atoi(header.Get(retryAfterHeader))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+func readBody(resp *http.Response, out any) error {
+	defer resp.Body.Close()
+
+	if out == nil {
+		_, err := io.Copy(io.Discard, resp.Body)
+		return err
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("cannot decode response body: %w", err)
+	}
+	return nil
+}
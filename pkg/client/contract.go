@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"net/http"
+	"net/url"
+	"tender-service/internal/model/dto"
+)
+
+func (c *Client) CreateContract(ctx context.Context, username string, createDto dto.CreateContractDto) (dto.ContractDto, error) {
+	var created dto.ContractDto
+	_, err := c.do(ctx, call{
+		method: http.MethodPost,
+		path:   "/contracts/new",
+		query:  url.Values{"username": {username}},
+		body:   createDto,
+	}, &created)
+	return created, err
+}
+
+func (c *Client) GetUserContracts(ctx context.Context, username string) ([]dto.ContractDto, error) {
+	var contracts []dto.ContractDto
+	_, err := c.do(ctx, call{
+		method: http.MethodGet,
+		path:   "/contracts/my",
+		query:  url.Values{"username": {username}},
+	}, &contracts)
+	return contracts, err
+}
+
+func (c *Client) GetContract(ctx context.Context, contractId uuid.UUID, username string) (dto.ContractDto, error) {
+	var contract dto.ContractDto
+	_, err := c.do(ctx, call{
+		method: http.MethodGet,
+		path:   "/contracts/" + contractId.String(),
+		query:  url.Values{"username": {username}},
+	}, &contract)
+	return contract, err
+}
+
+func (c *Client) AddContractMilestone(ctx context.Context, contractId uuid.UUID, username string, createDto dto.CreateMilestoneDto) (dto.MilestoneDto, error) {
+	var created dto.MilestoneDto
+	_, err := c.do(ctx, call{
+		method: http.MethodPost,
+		path:   "/contracts/" + contractId.String() + "/milestones",
+		query:  url.Values{"username": {username}},
+		body:   createDto,
+	}, &created)
+	return created, err
+}
+
+func (c *Client) CompleteContractMilestone(ctx context.Context, contractId, milestoneId uuid.UUID, username string) (dto.MilestoneDto, error) {
+	var completed dto.MilestoneDto
+	_, err := c.do(ctx, call{
+		method: http.MethodPut,
+		path:   "/contracts/" + contractId.String() + "/milestones/" + milestoneId.String() + "/complete",
+		query:  url.Values{"username": {username}},
+	}, &completed)
+	return completed, err
+}
+
+func (c *Client) RateContract(ctx context.Context, contractId uuid.UUID, username string, scores dto.RatingScoresDto) (dto.RatingDto, error) {
+	var created dto.RatingDto
+	_, err := c.do(ctx, call{
+		method: http.MethodPost,
+		path:   "/contracts/" + contractId.String() + "/rating",
+		query:  url.Values{"username": {username}},
+		body:   scores,
+	}, &created)
+	return created, err
+}
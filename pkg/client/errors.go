@@ -0,0 +1,75 @@
+package client
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"tender-service/internal/httperr"
+)
+
+// Error is a problem document returned by the service, compare it with errors.Is against ErrNotFound and others
+type Error struct {
+	httperr.ErrorDto
+}
+
+var (
+	ErrNotAuthorized    = &Error{httperr.ErrorDto{Type: httperr.TypeNotAuthorized}}
+	ErrForbidden        = &Error{httperr.ErrorDto{Type: httperr.TypeForbidden}}
+	ErrBadRequest       = &Error{httperr.ErrorDto{Type: httperr.TypeBadRequest}}
+	ErrValidationFailed = &Error{httperr.ErrorDto{Type: httperr.TypeValidationFailed}}
+	ErrNotFound         = &Error{httperr.ErrorDto{Type: httperr.TypeNotFound}}
+	ErrConflict         = &Error{httperr.ErrorDto{Type: httperr.TypeConflict}}
+	ErrAlreadyExists    = &Error{httperr.ErrorDto{Type: httperr.TypeAlreadyExists}}
+	ErrInvalidReference = &Error{httperr.ErrorDto{Type: httperr.TypeInvalidReference}}
+	ErrUnprocessable    = &Error{httperr.ErrorDto{Type: httperr.TypeUnprocessable}}
+	ErrTooManyRequests  = &Error{httperr.ErrorDto{Type: httperr.TypeTooManyRequests}}
+	ErrInternal         = &Error{httperr.ErrorDto{Type: httperr.TypeInternal}}
+)
+
+var statusTypes = map[int]string{
+	http.StatusUnauthorized:        httperr.TypeNotAuthorized,
+	http.StatusForbidden:           httperr.TypeForbidden,
+	http.StatusBadRequest:          httperr.TypeBadRequest,
+	http.StatusNotFound:            httperr.TypeNotFound,
+	http.StatusConflict:            httperr.TypeConflict,
+	http.StatusUnprocessableEntity: httperr.TypeUnprocessable,
+	http.StatusTooManyRequests:     httperr.TypeTooManyRequests,
+	http.StatusInternalServerError: httperr.TypeInternal,
+}
+
+func (e *Error) Error() string {
+	if e.Detail == "" {
+		return e.Title
+	}
+	return e.Title + ": " + e.Detail
+}
+
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Type == e.Type
+}
+
+func readError(resp *http.Response) *Error {
+	defer resp.Body.Close()
+
+	raw, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+
+	apiErr := &Error{}
+	if err := json.Unmarshal(raw, &apiErr.ErrorDto); err != nil || apiErr.Type == "" {
+		// not a problem document, e.g. a proxy error page
+		apiErr.ErrorDto = httperr.ErrorDto{Type: statusTypes[resp.StatusCode], Detail: strings.TrimSpace(string(raw))}
+	}
+
+	if apiErr.Status == 0 {
+		apiErr.Status = resp.StatusCode
+	}
+	if apiErr.Title == "" {
+		apiErr.Title = http.StatusText(resp.StatusCode)
+	}
+	if apiErr.RequestId == "" {
+		apiErr.RequestId = resp.Header.Get(requestIdHeader)
+	}
+
+	return apiErr
+}
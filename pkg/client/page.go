@@ -0,0 +1,48 @@
+package client
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"tender-service/internal/util"
+)
+
+// Page selects a page of a list, zero values fall back to the service defaults
+type Page struct {
+	Offset int
+	Limit  int
+	// Cursor is the X-Next-Cursor of the previous page, it cannot be combined with Offset
+	Cursor string
+	// Sort holds field names, a "-" prefix sorts descending
+	Sort      []string
+	WithTotal bool
+}
+
+func (p Page) query() url.Values {
+	query := url.Values{}
+	if p.Offset > 0 {
+		query.Set("offset", strconv.Itoa(p.Offset))
+	}
+	if p.Limit > 0 {
+		query.Set("limit", strconv.Itoa(p.Limit))
+	}
+	if p.Cursor != "" {
+		query.Set("cursor", p.Cursor)
+	}
+	if len(p.Sort) > 0 {
+		query.Set("sort", strings.Join(p.Sort, ","))
+	}
+	if p.WithTotal {
+		query.Set("with_total", "true")
+	}
+	return query
+}
+
+func pageInfo(header http.Header) util.PageInfo {
+	info := util.PageInfo{NextCursor: header.Get(nextCursorHeader)}
+	if total, err := strconv.Atoi(header.Get(totalCountHeader)); err == nil {
+		info.Total = &total
+	}
+	return info
+}
@@ -0,0 +1,11 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+func (c *Client) Ping(ctx context.Context) error {
+	_, err := c.do(ctx, call{method: http.MethodGet, path: "/ping"}, nil)
+	return err
+}
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"net/http"
+	"net/url"
+	"tender-service/internal/model/dto"
+	"tender-service/internal/util"
+)
+
+func (c *Client) GetEmployeeReputation(ctx context.Context, employeeId uuid.UUID, username string) (dto.ReputationDto, error) {
+	var reputation dto.ReputationDto
+	_, err := c.do(ctx, call{
+		method: http.MethodGet,
+		path:   "/ratings/employees/" + employeeId.String(),
+		query:  url.Values{"username": {username}},
+	}, &reputation)
+	return reputation, err
+}
+
+func (c *Client) GetEmployeeRatingHistory(ctx context.Context, page Page, employeeId uuid.UUID, username string) ([]dto.RatingDto, util.PageInfo, error) {
+	query := page.query()
+	query.Set("username", username)
+
+	var ratings []dto.RatingDto
+	header, err := c.do(ctx, call{method: http.MethodGet, path: "/ratings/employees/" + employeeId.String() + "/history", query: query}, &ratings)
+	if err != nil {
+		return nil, util.PageInfo{}, err
+	}
+	return ratings, pageInfo(header), nil
+}
+
+func (c *Client) GetOrganizationReputation(ctx context.Context, organizationId uuid.UUID, username string) (dto.ReputationDto, error) {
+	var reputation dto.ReputationDto
+	_, err := c.do(ctx, call{
+		method: http.MethodGet,
+		path:   "/ratings/organizations/" + organizationId.String(),
+		query:  url.Values{"username": {username}},
+	}, &reputation)
+	return reputation, err
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"net/http"
+	"net/url"
+	"tender-service/internal/model/dto"
+)
+
+func (c *Client) CreateSavedSearch(ctx context.Context, username string, createDto dto.CreateSavedSearchDto) (dto.SavedSearchDto, error) {
+	var created dto.SavedSearchDto
+	_, err := c.do(ctx, call{
+		method: http.MethodPost,
+		path:   "/searches/new",
+		query:  url.Values{"username": {username}},
+		body:   createDto,
+	}, &created)
+	return created, err
+}
+
+func (c *Client) GetUserSavedSearches(ctx context.Context, username string) ([]dto.SavedSearchDto, error) {
+	var searches []dto.SavedSearchDto
+	_, err := c.do(ctx, call{
+		method: http.MethodGet,
+		path:   "/searches/my",
+		query:  url.Values{"username": {username}},
+	}, &searches)
+	return searches, err
+}
+
+func (c *Client) DeleteSavedSearch(ctx context.Context, searchId uuid.UUID, username string) error {
+	_, err := c.do(ctx, call{
+		method: http.MethodDelete,
+		path:   "/searches/" + searchId.String(),
+		query:  url.Values{"username": {username}},
+	}, nil)
+	return err
+}
@@ -0,0 +1,185 @@
+package client
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"tender-service/internal/model/dto"
+	"tender-service/internal/model/entity/tender"
+	"tender-service/internal/util"
+	"time"
+)
+
+type TenderFilter struct {
+	ServiceTypes []tender.ServiceType
+	CategoryIds  []uuid.UUID
+}
+
+type TenderSearch struct {
+	Query          string
+	OrganizationId uuid.UUID
+	Statuses       []tender.Status
+	CreatedFrom    time.Time
+	CreatedTo      time.Time
+	BudgetFrom     *float64
+	BudgetTo       *float64
+	// Username lets members see unpublished tenders of their organizations
+	Username string
+}
+
+func (c *Client) GetTenders(ctx context.Context, page Page, filter TenderFilter) ([]dto.TenderDto, util.PageInfo, error) {
+	query := page.query()
+	if len(filter.ServiceTypes) > 0 {
+		values := make([]string, len(filter.ServiceTypes))
+		for i, serviceType := range filter.ServiceTypes {
+			values[i] = string(serviceType)
+		}
+		query.Set("service_type", strings.Join(values, ","))
+	}
+	if len(filter.CategoryIds) > 0 {
+		query.Set("category_id", joinIds(filter.CategoryIds))
+	}
+
+	var tenders []dto.TenderDto
+	header, err := c.do(ctx, call{method: http.MethodGet, path: "/tenders", query: query}, &tenders)
+	if err != nil {
+		return nil, util.PageInfo{}, err
+	}
+	return tenders, pageInfo(header), nil
+}
+
+func (c *Client) SearchTenders(ctx context.Context, page Page, search TenderSearch) ([]dto.TenderSearchResultDto, error) {
+	query := page.query()
+	if search.Query != "" {
+		query.Set("q", search.Query)
+	}
+	if search.OrganizationId != uuid.Nil {
+		query.Set("organization_id", search.OrganizationId.String())
+	}
+	if len(search.Statuses) > 0 {
+		values := make([]string, len(search.Statuses))
+		for i, status := range search.Statuses {
+			values[i] = string(status)
+		}
+		query.Set("status", strings.Join(values, ","))
+	}
+	if !search.CreatedFrom.IsZero() {
+		query.Set("created_from", search.CreatedFrom.Format(time.RFC3339))
+	}
+	if !search.CreatedTo.IsZero() {
+		query.Set("created_to", search.CreatedTo.Format(time.RFC3339))
+	}
+	if search.BudgetFrom != nil {
+		query.Set("budget_from", strconv.FormatFloat(*search.BudgetFrom, 'f', -1, 64))
+	}
+	if search.BudgetTo != nil {
+		query.Set("budget_to", strconv.FormatFloat(*search.BudgetTo, 'f', -1, 64))
+	}
+	if search.Username != "" {
+		query.Set("username", search.Username)
+	}
+
+	var results []dto.TenderSearchResultDto
+	if _, err := c.do(ctx, call{method: http.MethodGet, path: "/tenders/search", query: query}, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (c *Client) CreateTender(ctx context.Context, createDto dto.CreateTenderDto) (dto.TenderDto, error) {
+	var created dto.TenderDto
+	_, err := c.do(ctx, call{method: http.MethodPost, path: "/tenders/new", body: createDto, idempotencyKey: true}, &created)
+	return created, err
+}
+
+// GetUserTenders lists tenders created by username, outcomes keep only finished tenders that ended that way
+func (c *Client) GetUserTenders(ctx context.Context, page Page, username string, outcomes ...tender.Outcome) ([]dto.TenderDto, util.PageInfo, error) {
+	query := page.query()
+	query.Set("username", username)
+	if len(outcomes) > 0 {
+		values := make([]string, len(outcomes))
+		for i, outcome := range outcomes {
+			values[i] = string(outcome)
+		}
+		query.Set("outcome", strings.Join(values, ","))
+	}
+
+	var tenders []dto.TenderDto
+	header, err := c.do(ctx, call{method: http.MethodGet, path: "/tenders/my", query: query}, &tenders)
+	if err != nil {
+		return nil, util.PageInfo{}, err
+	}
+	return tenders, pageInfo(header), nil
+}
+
+func (c *Client) GetTenderStatus(ctx context.Context, tenderId uuid.UUID, username string) (tender.Status, error) {
+	var status tender.Status
+	_, err := c.do(ctx, call{
+		method: http.MethodGet,
+		path:   "/tenders/" + tenderId.String() + "/status",
+		query:  url.Values{"username": {username}},
+	}, &status)
+	return status, err
+}
+
+func (c *Client) UpdateTenderStatus(ctx context.Context, tenderId uuid.UUID, username string, status tender.Status) (dto.TenderDto, error) {
+	var updated dto.TenderDto
+	_, err := c.do(ctx, call{
+		method: http.MethodPut,
+		path:   "/tenders/" + tenderId.String() + "/status",
+		query:  url.Values{"username": {username}, "status": {string(status)}},
+	}, &updated)
+	return updated, err
+}
+
+func (c *Client) CancelTender(ctx context.Context, tenderId uuid.UUID, username, reason string) (dto.TenderDto, error) {
+	var cancelled dto.TenderDto
+	_, err := c.do(ctx, call{
+		method: http.MethodPut,
+		path:   "/tenders/" + tenderId.String() + "/cancel",
+		query:  url.Values{"username": {username}},
+		body:   dto.CancelTenderDto{Reason: reason},
+	}, &cancelled)
+	return cancelled, err
+}
+
+func (c *Client) GetTenderAward(ctx context.Context, tenderId uuid.UUID) (dto.AwardDto, error) {
+	var award dto.AwardDto
+	_, err := c.do(ctx, call{
+		method: http.MethodGet,
+		path:   "/tenders/" + tenderId.String() + "/award",
+	}, &award)
+	return award, err
+}
+
+func (c *Client) EditTender(ctx context.Context, tenderId uuid.UUID, username string, updateDto dto.UpdateTenderDto) (dto.TenderDto, error) {
+	var updated dto.TenderDto
+	_, err := c.do(ctx, call{
+		method: http.MethodPatch,
+		path:   "/tenders/" + tenderId.String() + "/edit",
+		query:  url.Values{"username": {username}},
+		body:   updateDto,
+	}, &updated)
+	return updated, err
+}
+
+func (c *Client) RollbackTender(ctx context.Context, tenderId uuid.UUID, version int, username string) (dto.TenderDto, error) {
+	var updated dto.TenderDto
+	_, err := c.do(ctx, call{
+		method: http.MethodPut,
+		path:   "/tenders/" + tenderId.String() + "/rollback/" + strconv.Itoa(version),
+		query:  url.Values{"username": {username}},
+	}, &updated)
+	return updated, err
+}
+
+func joinIds(ids []uuid.UUID) string {
+	values := make([]string, len(ids))
+	for i, id := range ids {
+		values[i] = id.String()
+	}
+	return strings.Join(values, ",")
+}
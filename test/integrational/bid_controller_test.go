@@ -2,6 +2,7 @@ package integrational
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -12,7 +13,9 @@ import (
 	"tender-service/internal/model/entity/bid"
 	"tender-service/internal/model/entity/decision"
 	"tender-service/internal/model/entity/tender"
+	"tender-service/internal/util"
 	"tender-service/test"
+	"time"
 )
 
 func (s *ApiTestSuite) TestCreateBid() {
@@ -186,6 +189,53 @@ func (s *ApiTestSuite) TestReturn401WhenGetUserBidsAndEmployeeDontExists() {
 	test.ValidateJsonResponse(s.T(), actual, expected, 401)
 }
 
+func (s *ApiTestSuite) TestGetUserBidsSortedByPrice() {
+	ctx := context.Background()
+	orgId := s.createOrganization()
+	emplId := s.createEmployeeInOrg("test", orgId)
+
+	tend, _ := s.tenderRepository.SaveTender(ctx, tender.Tender{
+		Name:            "1",
+		Description:     "2",
+		Status:          tender.Published,
+		ServiceType:     "Delivery",
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+
+	high, low, middle := 300.0, 100.0, 200.0
+	for name, price := range map[string]*float64{"a": &high, "b": nil, "c": &low, "d": &middle} {
+		s.bidRepository.SaveBid(ctx, bid.Bid{
+			Name:        name,
+			Description: name,
+			Price:       price,
+			Status:      bid.Created,
+			TenderId:    tend.Id,
+			AuthorType:  bid.AuthorUser,
+			AuthorId:    emplId,
+		})
+	}
+
+	names := make([]string, 0, 4)
+	url := s.host + "/bids/my?username=test&sort=-price&limit=2"
+	cursor := ""
+	for i := 0; i < 2; i++ {
+		actual, err := http.Get(url + cursor)
+		if err != nil {
+			s.T().Fatalf("Failed to send request: %v", err)
+		}
+		var page []dto.BidDto
+		require.NoError(s.T(), json.NewDecoder(actual.Body).Decode(&page))
+		actual.Body.Close()
+		for _, b := range page {
+			names = append(names, b.Name)
+		}
+		cursor = "&cursor=" + actual.Header.Get(util.NextCursorHeader)
+	}
+
+	require.Equal(s.T(), []string{"a", "d", "c", "b"}, names)
+}
+
 func (s *ApiTestSuite) TestGetTenderBids() {
 	testCases := []struct {
 		name     string
@@ -490,7 +540,7 @@ func (s *ApiTestSuite) TestUpdateBidStatus() {
 	tend, _ := s.tenderRepository.SaveTender(ctx, tender.Tender{
 		Name:            "1",
 		Description:     "2",
-		Status:          "Created",
+		Status:          "Published",
 		ServiceType:     "Delivery",
 		OrganizationId:  orgId,
 		CreatorUsername: "test",
@@ -515,6 +565,53 @@ func (s *ApiTestSuite) TestUpdateBidStatus() {
 	test.ValidateJsonResponse(s.T(), actual, expected, 200)
 }
 
+func (s *ApiTestSuite) TestReturn409WhenUpdateBidStatusAndTransitionNotAllowed() {
+	testCases := []struct {
+		name         string
+		tenderStatus tender.Status
+		from         bid.Status
+		status       bid.Status
+	}{
+		{name: "WhenPublishOnClosedTender", tenderStatus: tender.Closed, from: bid.Created, status: bid.Published},
+		{name: "WhenRestoreCanceledBid", tenderStatus: tender.Published, from: bid.Canceled, status: bid.Created},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx := context.Background()
+			orgId := s.createOrganization()
+			s.createEmployeeInOrg("test", orgId)
+			bidCreatorId := s.createEmployee("creator")
+
+			tend, _ := s.tenderRepository.SaveTender(ctx, tender.Tender{
+				Name:            "1",
+				Description:     "2",
+				Status:          tc.tenderStatus,
+				ServiceType:     "Delivery",
+				OrganizationId:  orgId,
+				CreatorUsername: "test",
+			})
+
+			b, _ := s.bidRepository.SaveBid(ctx, bid.Bid{
+				Name:        "3",
+				Description: "3",
+				Status:      tc.from,
+				TenderId:    tend.Id,
+				AuthorType:  bid.AuthorUser,
+				AuthorId:    bidCreatorId,
+			})
+
+			actual, err := test.HttpPut(s.host+fmt.Sprintf("/bids/%s/status?username=%s&status=%s", b.Id.String(), "creator", tc.status), nil)
+			if err != nil {
+				s.T().Fatalf("Failed to send request: %v", err)
+			}
+			defer actual.Body.Close()
+
+			expected := test.ReadJson("/bid/response/TestReturn409WhenUpdateBidStatusAndTransitionNotAllowed/" + tc.name)
+			test.ValidateJsonResponse(s.T(), actual, expected, 409)
+		})
+	}
+}
+
 func (s *ApiTestSuite) TestReturn400WhenUpdateBidStatusAndChooseUnselectableStatus() {
 	ctx := context.Background()
 	orgId := s.createOrganization()
@@ -594,6 +691,53 @@ func (s *ApiTestSuite) TestEditBid() {
 	test.ValidateJsonResponse(s.T(), actual, expected, 200)
 }
 
+func (s *ApiTestSuite) TestEditBidDropsVotes() {
+	ctx := context.Background()
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	bidCreatorId := s.createEmployee("creator")
+	b := s.createPublishedBid(orgId, bidCreatorId)
+
+	s.decisionRepository.SaveDecision(ctx, decision.Decision{
+		Verdict:  decision.Approved,
+		Username: "test",
+		BidId:    b.Id,
+	})
+
+	price := 1000.0
+	actual, err := test.HttpPatch(s.host+fmt.Sprintf("/bids/%s/edit?username=%s", b.Id.String(), "creator"), dto.UpdateBidDto{Price: &price})
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	actual.Body.Close()
+	require.Equal(s.T(), 200, actual.StatusCode)
+
+	approveCount, err := s.decisionRepository.CountDecisionForBid(ctx, b.Id, decision.Approved)
+	require.NoError(s.T(), err)
+	require.Zero(s.T(), approveCount)
+}
+
+func (s *ApiTestSuite) TestReturn409WhenEditWithdrawnBid() {
+	ctx := context.Background()
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	bidCreatorId := s.createEmployee("creator")
+	b := s.createPublishedBid(orgId, bidCreatorId)
+
+	_, err := s.bidRepository.WithdrawBid(ctx, b.Id, "")
+	require.NoError(s.T(), err)
+
+	price := 1000.0
+	actual, err := test.HttpPatch(s.host+fmt.Sprintf("/bids/%s/edit?username=%s", b.Id.String(), "creator"), dto.UpdateBidDto{Price: &price})
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/bid/response/TestReturn409WhenEditWithdrawnBid")
+	test.ValidateJsonResponse(s.T(), actual, expected, 409)
+}
+
 func (s *ApiTestSuite) TestRollbackBid() {
 	ctx := context.Background()
 	orgId := s.createOrganization()
@@ -618,7 +762,7 @@ func (s *ApiTestSuite) TestRollbackBid() {
 		AuthorId:    bidCreatorId,
 	})
 
-	s.bidRepository.UpdateBid(ctx, b.Id, "upd", "upd")
+	s.bidRepository.UpdateBid(ctx, b.Id, "upd", "upd", nil)
 
 	actual, err := test.HttpPut(s.host+fmt.Sprintf("/bids/%s/rollback/1?username=%s", b.Id.String(), "creator"), nil)
 	if err != nil {
@@ -692,7 +836,7 @@ func (s *ApiTestSuite) TestPutBidFeedback() {
 	if err != nil {
 		s.T().Fatalf("Failed to send request: %v", err)
 	}
-	actualFromDb, _ := s.feedbackRepository.GetFeedbackListForGroup(ctx, tend.Id, bidCreatorId)
+	actualFromDb, _ := s.feedbackRepository.GetFeedbackListForGroup(ctx, util.Page{Limit: 5}, tend.Id, bidCreatorId)
 	defer actual.Body.Close()
 
 	expected := test.ReadJson("/bid/response/TestPutBidFeedback")
@@ -844,7 +988,7 @@ func (s *ApiTestSuite) TestSubmitDecisionApprove() {
 	if err != nil {
 		s.T().Fatalf("Failed to send request: %v", err)
 	}
-	actualAmountOfDecisionFromDb, _ := s.decisionRepository.CountDecisionForBid(ctx, b.Id)
+	actualAmountOfDecisionFromDb, _ := s.decisionRepository.CountDecisionForBid(ctx, b.Id, decision.Approved)
 	actualTenderFromDb, _ := s.tenderRepository.GetTenderById(ctx, tend.Id)
 	actualBidFromDb, _ := s.bidRepository.GetBidById(ctx, b.Id)
 	defer actual.Body.Close()
@@ -888,7 +1032,7 @@ func (s *ApiTestSuite) TestSubmitDecisionApproveWhenNotEnoughApproves() {
 	if err != nil {
 		s.T().Fatalf("Failed to send request: %v", err)
 	}
-	actualAmountOfDecisionFromDb, _ := s.decisionRepository.CountDecisionForBid(ctx, b.Id)
+	actualAmountOfDecisionFromDb, _ := s.decisionRepository.CountDecisionForBid(ctx, b.Id, decision.Approved)
 	actualTenderFromDb, _ := s.tenderRepository.GetTenderById(ctx, tend.Id)
 	actualBidFromDb, _ := s.bidRepository.GetBidById(ctx, b.Id)
 	defer actual.Body.Close()
@@ -938,7 +1082,7 @@ func (s *ApiTestSuite) TestSubmitDecisionApproveWhenEnoughApproves() {
 	if err != nil {
 		s.T().Fatalf("Failed to send request: %v", err)
 	}
-	actualAmountOfDecisionFromDb, _ := s.decisionRepository.CountDecisionForBid(ctx, b.Id)
+	actualAmountOfDecisionFromDb, _ := s.decisionRepository.CountDecisionForBid(ctx, b.Id, decision.Approved)
 	actualTenderFromDb, _ := s.tenderRepository.GetTenderById(ctx, tend.Id)
 	actualBidFromDb, _ := s.bidRepository.GetBidById(ctx, b.Id)
 	defer actual.Body.Close()
@@ -981,7 +1125,7 @@ func (s *ApiTestSuite) TestSubmitDecisionRejected() {
 	if err != nil {
 		s.T().Fatalf("Failed to send request: %v", err)
 	}
-	actualAmountOfDecisionFromDb, _ := s.decisionRepository.CountDecisionForBid(ctx, b.Id)
+	actualAmountOfDecisionFromDb, _ := s.decisionRepository.CountDecisionForBid(ctx, b.Id, decision.Approved)
 	actualTenderFromDb, _ := s.tenderRepository.GetTenderById(ctx, tend.Id)
 	actualBidFromDb, _ := s.bidRepository.GetBidById(ctx, b.Id)
 	defer actual.Body.Close()
@@ -992,3 +1136,408 @@ func (s *ApiTestSuite) TestSubmitDecisionRejected() {
 	require.Equal(s.T(), bid.Rejected, actualBidFromDb.Decision)
 	require.Equal(s.T(), tender.Published, actualTenderFromDb.Status)
 }
+
+func (s *ApiTestSuite) TestSubmitDecisionTwiceCountsOneVote() {
+	ctx := context.Background()
+
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	s.createEmployeeInOrg("test2", orgId)
+	s.createEmployeeInOrg("test3", orgId)
+	bidCreatorId := s.createEmployee("creator")
+
+	tend, _ := s.tenderRepository.SaveTender(ctx, tender.Tender{
+		Name:            "1",
+		Description:     "2",
+		Status:          tender.Published,
+		ServiceType:     "Delivery",
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+
+	b, _ := s.bidRepository.SaveBid(ctx, bid.Bid{
+		Name:        "3",
+		Description: "3",
+		Status:      bid.Published,
+		TenderId:    tend.Id,
+		AuthorType:  bid.AuthorUser,
+		AuthorId:    bidCreatorId,
+	})
+
+	s.bidRepository.UpdateBidStatus(ctx, b.Id, bid.Published)
+
+	first, err := test.HttpPut(s.host+fmt.Sprintf("/bids/%s/submit_decision?username=%s&decision=Approved", b.Id.String(), "test"), nil)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	first.Body.Close()
+
+	actual, err := test.HttpPut(s.host+fmt.Sprintf("/bids/%s/submit_decision?username=%s&decision=Approved", b.Id.String(), "test"), nil)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	actualAmountOfDecisionFromDb, _ := s.decisionRepository.CountDecisionForBid(ctx, b.Id, decision.Approved)
+	actualBidFromDb, _ := s.bidRepository.GetBidById(ctx, b.Id)
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/bid/response/TestSubmitDecisionTwiceCountsOneVote")
+	test.ValidateJsonResponse(s.T(), actual, expected, 200)
+	require.Equal(s.T(), 1, actualAmountOfDecisionFromDb)
+	require.Equal(s.T(), bid.None, actualBidFromDb.Decision)
+}
+
+func (s *ApiTestSuite) TestWithdrawDecision() {
+	ctx := context.Background()
+
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	s.createEmployeeInOrg("test2", orgId)
+	s.createEmployeeInOrg("test3", orgId)
+	bidCreatorId := s.createEmployee("creator")
+
+	tend, _ := s.tenderRepository.SaveTender(ctx, tender.Tender{
+		Name:            "1",
+		Description:     "2",
+		Status:          tender.Published,
+		ServiceType:     "Delivery",
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+
+	b, _ := s.bidRepository.SaveBid(ctx, bid.Bid{
+		Name:        "3",
+		Description: "3",
+		Status:      bid.Published,
+		TenderId:    tend.Id,
+		AuthorType:  bid.AuthorUser,
+		AuthorId:    bidCreatorId,
+	})
+
+	s.bidRepository.UpdateBidStatus(ctx, b.Id, bid.Published)
+
+	s.decisionRepository.SaveDecision(ctx, decision.Decision{
+		Verdict:  decision.Approved,
+		Username: "test",
+		BidId:    b.Id,
+	})
+
+	actual, err := test.HttpDelete(s.host + fmt.Sprintf("/bids/%s/submit_decision?username=%s", b.Id.String(), "test"))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	actualAmountOfDecisionFromDb, _ := s.decisionRepository.CountDecisionForBid(ctx, b.Id, decision.Approved)
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/bid/response/TestWithdrawDecision")
+	test.ValidateJsonResponse(s.T(), actual, expected, 200)
+	require.Equal(s.T(), 0, actualAmountOfDecisionFromDb)
+}
+
+func (s *ApiTestSuite) TestReturn404WhenWithdrawDecisionAndEmployeeDidNotVote() {
+	ctx := context.Background()
+
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	s.createEmployeeInOrg("test2", orgId)
+	s.createEmployeeInOrg("test3", orgId)
+	bidCreatorId := s.createEmployee("creator")
+
+	tend, _ := s.tenderRepository.SaveTender(ctx, tender.Tender{
+		Name:            "1",
+		Description:     "2",
+		Status:          tender.Published,
+		ServiceType:     "Delivery",
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+
+	b, _ := s.bidRepository.SaveBid(ctx, bid.Bid{
+		Name:        "3",
+		Description: "3",
+		Status:      bid.Published,
+		TenderId:    tend.Id,
+		AuthorType:  bid.AuthorUser,
+		AuthorId:    bidCreatorId,
+	})
+
+	s.bidRepository.UpdateBidStatus(ctx, b.Id, bid.Published)
+
+	actual, err := test.HttpDelete(s.host + fmt.Sprintf("/bids/%s/submit_decision?username=%s", b.Id.String(), "test"))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/bid/response/TestReturn404WhenWithdrawDecisionAndEmployeeDidNotVote")
+	test.ValidateJsonResponse(s.T(), actual, expected, 404)
+}
+
+func (s *ApiTestSuite) TestGetBidDecisions() {
+	ctx := context.Background()
+
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	s.createEmployeeInOrg("test2", orgId)
+	s.createEmployeeInOrg("test3", orgId)
+	bidCreatorId := s.createEmployee("creator")
+
+	tend, _ := s.tenderRepository.SaveTender(ctx, tender.Tender{
+		Name:            "1",
+		Description:     "2",
+		Status:          tender.Published,
+		ServiceType:     "Delivery",
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+
+	b, _ := s.bidRepository.SaveBid(ctx, bid.Bid{
+		Name:        "3",
+		Description: "3",
+		Status:      bid.Published,
+		TenderId:    tend.Id,
+		AuthorType:  bid.AuthorUser,
+		AuthorId:    bidCreatorId,
+	})
+
+	s.bidRepository.UpdateBidStatus(ctx, b.Id, bid.Published)
+
+	s.decisionRepository.SaveDecision(ctx, decision.Decision{
+		Verdict:  decision.Approved,
+		Username: "test2",
+		BidId:    b.Id,
+	})
+	s.decisionRepository.SaveDecision(ctx, decision.Decision{
+		Verdict:  decision.Approved,
+		Username: "test",
+		BidId:    b.Id,
+	})
+
+	actual, err := http.Get(s.host + fmt.Sprintf("/bids/%s/decisions?username=%s", b.Id.String(), "test3"))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/bid/response/TestGetBidDecisions")
+	test.ValidateJsonResponse(s.T(), actual, expected, 200)
+}
+
+func (s *ApiTestSuite) TestWithdrawBid() {
+	ctx := context.Background()
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	bidCreatorId := s.createEmployee("creator")
+
+	tend, _ := s.tenderRepository.SaveTender(ctx, tender.Tender{
+		Name:            "1",
+		Description:     "2",
+		Status:          tender.Published,
+		ServiceType:     "Delivery",
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+
+	b, _ := s.bidRepository.SaveBid(ctx, bid.Bid{
+		Name:        "3",
+		Description: "3",
+		Status:      bid.Published,
+		TenderId:    tend.Id,
+		AuthorType:  bid.AuthorUser,
+		AuthorId:    bidCreatorId,
+	})
+
+	s.decisionRepository.SaveDecision(ctx, decision.Decision{
+		Verdict:  decision.Approved,
+		Username: "test",
+		BidId:    b.Id,
+	})
+
+	actual, err := test.HttpPut(s.host+fmt.Sprintf("/bids/%s/withdraw?username=%s", b.Id.String(), "creator"), dto.WithdrawBidDto{Reason: "cannot deliver in time"})
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/bid/response/TestWithdrawBid")
+	test.ValidateJsonResponse(s.T(), actual, expected, 200)
+
+	decisions, _ := s.decisionRepository.GetDecisionsForBid(ctx, b.Id)
+	require.Empty(s.T(), decisions)
+
+	status, err := http.Get(s.host + fmt.Sprintf("/bids/%s/status?username=%s", b.Id.String(), "test"))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer status.Body.Close()
+
+	test.ValidateJsonStringResponse(s.T(), status, "Withdrawn", 200)
+}
+
+func (s *ApiTestSuite) TestReturn409WhenPublishWithdrawnBid() {
+	ctx := context.Background()
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	bidCreatorId := s.createEmployee("creator")
+
+	tend, _ := s.tenderRepository.SaveTender(ctx, tender.Tender{
+		Name:            "1",
+		Description:     "2",
+		Status:          tender.Published,
+		ServiceType:     "Delivery",
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+
+	b, _ := s.bidRepository.SaveBid(ctx, bid.Bid{
+		Name:        "3",
+		Description: "3",
+		Status:      bid.Published,
+		TenderId:    tend.Id,
+		AuthorType:  bid.AuthorUser,
+		AuthorId:    bidCreatorId,
+	})
+
+	s.bidRepository.WithdrawBid(ctx, b.Id, "reason")
+
+	actual, err := test.HttpPut(s.host+fmt.Sprintf("/bids/%s/status?username=%s&status=Published", b.Id.String(), "creator"), nil)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/bid/response/TestReturn409WhenPublishWithdrawnBid")
+	test.ValidateJsonResponse(s.T(), actual, expected, 409)
+}
+
+func (s *ApiTestSuite) TestReturn409WhenUpdateBidStatusAndBidHasVotes() {
+	ctx := context.Background()
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	bidCreatorId := s.createEmployee("creator")
+
+	tend, _ := s.tenderRepository.SaveTender(ctx, tender.Tender{
+		Name:            "1",
+		Description:     "2",
+		Status:          tender.Published,
+		ServiceType:     "Delivery",
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+
+	b, _ := s.bidRepository.SaveBid(ctx, bid.Bid{
+		Name:        "3",
+		Description: "3",
+		Status:      bid.Published,
+		TenderId:    tend.Id,
+		AuthorType:  bid.AuthorUser,
+		AuthorId:    bidCreatorId,
+	})
+
+	s.decisionRepository.SaveDecision(ctx, decision.Decision{
+		Verdict:  decision.Approved,
+		Username: "test",
+		BidId:    b.Id,
+	})
+
+	actual, err := test.HttpPut(s.host+fmt.Sprintf("/bids/%s/status?username=%s&status=Canceled", b.Id.String(), "creator"), nil)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/bid/response/TestReturn409WhenUpdateBidStatusAndBidHasVotes")
+	test.ValidateJsonResponse(s.T(), actual, expected, 409)
+}
+
+func (s *ApiTestSuite) TestWithdrawBidAfterDeadline() {
+	ctx := context.Background()
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	bidCreatorId := s.createEmployee("creator")
+	deadline := time.Now().Add(-time.Hour)
+
+	testCases := []struct {
+		name     string
+		policy   tender.Policy
+		expected string
+		status   int
+	}{
+		{
+			name:     "forbidden by tender policy",
+			policy:   tender.Policy{BidDeadline: &deadline},
+			expected: "TestReturn403WhenWithdrawBidAfterDeadline",
+			status:   403,
+		},
+		{
+			name:     "allowed by tender policy",
+			policy:   tender.Policy{BidDeadline: &deadline, AllowLateWithdrawal: true},
+			expected: "TestWithdrawBid",
+			status:   200,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tend, _ := s.tenderRepository.SaveTender(ctx, tender.Tender{
+				Name:            "1",
+				Description:     "2",
+				Status:          tender.Published,
+				ServiceType:     "Delivery",
+				OrganizationId:  orgId,
+				CreatorUsername: "test",
+				Policy:          tc.policy,
+			})
+
+			b, _ := s.bidRepository.SaveBid(ctx, bid.Bid{
+				Name:        "3",
+				Description: "3",
+				Status:      bid.Published,
+				TenderId:    tend.Id,
+				AuthorType:  bid.AuthorUser,
+				AuthorId:    bidCreatorId,
+			})
+
+			actual, err := test.HttpPut(s.host+fmt.Sprintf("/bids/%s/withdraw?username=%s", b.Id.String(), "creator"), dto.WithdrawBidDto{Reason: "cannot deliver in time"})
+			if err != nil {
+				s.T().Fatalf("Failed to send request: %v", err)
+			}
+			defer actual.Body.Close()
+
+			expected := test.ReadJson("/bid/response/" + tc.expected)
+			test.ValidateJsonResponse(s.T(), actual, expected, tc.status)
+		})
+	}
+}
+
+func (s *ApiTestSuite) TestReturn400WhenCreateBidAfterDeadline() {
+	orgId := s.createOrganization()
+	empId := s.createEmployeeInOrg("test", orgId)
+	deadline := time.Now().Add(-time.Hour)
+
+	tend, _ := s.tenderRepository.SaveTender(context.Background(), tender.Tender{
+		Name:            "1",
+		Description:     "2",
+		Status:          tender.Published,
+		ServiceType:     "Delivery",
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+		Policy:          tender.Policy{BidDeadline: &deadline},
+	})
+
+	given := dto.CreateBidDto{
+		Name:        "1",
+		Description: "1",
+		TenderId:    tend.Id,
+		AuthorType:  bid.AuthorUser,
+		AuthorId:    empId,
+	}
+
+	actual, err := http.Post(s.host+"/bids/new", typeJson, test.ToBuffer(given))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/bid/response/TestReturn400WhenCreateBidAfterDeadline")
+	test.ValidateJsonResponse(s.T(), actual, expected, 400)
+}
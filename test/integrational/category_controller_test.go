@@ -0,0 +1,268 @@
+package integrational
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"tender-service/internal/model/dto"
+	"tender-service/internal/model/entity/tender"
+	"tender-service/test"
+)
+
+func (s *ApiTestSuite) TestGetCategories() {
+	actual, err := http.Get(s.host + "/categories")
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/category/response/TestGetCategories")
+	test.ValidateJsonResponse(s.T(), actual, expected, 200)
+}
+
+func (s *ApiTestSuite) TestCreateCategory() {
+	parentId := s.getCategoryIdByCode("F")
+
+	given := dto.CreateCategoryDto{
+		Code:     "F.41",
+		Name:     "Здания и работы по возведению зданий",
+		ParentId: &parentId,
+	}
+
+	actual, err := test.HttpWithBearer(http.MethodPost, s.host+"/admin/categories/new", adminToken, given)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	var created dto.CategoryDto
+	require.NoError(s.T(), json.NewDecoder(actual.Body).Decode(&created))
+	require.Equal(s.T(), 200, actual.StatusCode)
+	require.Equal(s.T(), given.Code, created.Code)
+	require.Equal(s.T(), &parentId, created.ParentId)
+}
+
+func (s *ApiTestSuite) TestReturnErrorWhenCallAdminApiWithoutToken() {
+	testCases := []struct {
+		name   string
+		token  string
+		status int
+	}{
+		{name: "WhenNoToken", token: "", status: 401},
+		{name: "WhenIncorrectToken", token: "incorrect", status: 403},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			given := dto.CreateCategoryDto{Code: "X", Name: "X"}
+
+			var actual *http.Response
+			var err error
+			if tc.token == "" {
+				actual, err = http.Post(s.host+"/admin/categories/new", typeJson, test.ToBuffer(given))
+			} else {
+				actual, err = test.HttpWithBearer(http.MethodPost, s.host+"/admin/categories/new", tc.token, given)
+			}
+			if err != nil {
+				s.T().Fatalf("Failed to send request: %v", err)
+			}
+			defer actual.Body.Close()
+
+			require.Equal(s.T(), tc.status, actual.StatusCode)
+		})
+	}
+}
+
+func (s *ApiTestSuite) TestCreateTenderWithSubcategory() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+
+	parentId := s.getCategoryIdByCode("F")
+	subcategory := s.createCategory("F.41", &parentId)
+
+	given := dto.CreateTenderDto{
+		Name:            "Возведение склада",
+		Description:     "Строительство складского комплекса",
+		CategoryIds:     []uuid.UUID{subcategory},
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	}
+
+	created, err := http.Post(s.host+"/tenders/new", typeJson, test.ToBuffer(given))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer created.Body.Close()
+
+	var createdTender dto.TenderDto
+	require.NoError(s.T(), json.NewDecoder(created.Body).Decode(&createdTender))
+	require.Equal(s.T(), tender.Construction, createdTender.ServiceType)
+	require.Equal(s.T(), []uuid.UUID{subcategory}, createdTender.CategoryIds)
+
+	_, err = s.tenderRepository.UpdateTenderStatus(context.Background(), createdTender.Id, tender.Published)
+	require.NoError(s.T(), err)
+
+	for _, query := range []string{"service_type=Construction", fmt.Sprintf("category_id=%s", parentId)} {
+		actual, err := http.Get(s.host + "/tenders?" + query)
+		if err != nil {
+			s.T().Fatalf("Failed to send request: %v", err)
+		}
+
+		expected := test.ReadJson("/category/response/TestCreateTenderWithSubcategory")
+		test.ValidateJsonResponse(s.T(), actual, expected, 200)
+		actual.Body.Close()
+	}
+}
+
+func (s *ApiTestSuite) TestCreateTenderWithCategoryOutsideServiceTypes() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+
+	categoryId := s.createCategory("A", nil)
+
+	given := dto.CreateTenderDto{
+		Name:            "Поставка зерна",
+		Description:     "Закупка пшеницы",
+		CategoryIds:     []uuid.UUID{categoryId},
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	}
+
+	created, err := http.Post(s.host+"/tenders/new", typeJson, test.ToBuffer(given))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer created.Body.Close()
+
+	require.Equal(s.T(), 200, created.StatusCode)
+
+	var createdTender dto.TenderDto
+	require.NoError(s.T(), json.NewDecoder(created.Body).Decode(&createdTender))
+	require.Empty(s.T(), createdTender.ServiceType)
+	require.Equal(s.T(), []uuid.UUID{categoryId}, createdTender.CategoryIds)
+
+	edited, err := test.HttpPatch(s.host+fmt.Sprintf("/tenders/%s/edit?username=test", createdTender.Id), dto.UpdateTenderDto{Name: "Поставка ячменя"})
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer edited.Body.Close()
+
+	var editedTender dto.TenderDto
+	require.NoError(s.T(), json.NewDecoder(edited.Body).Decode(&editedTender))
+	require.Equal(s.T(), 200, edited.StatusCode)
+	require.Empty(s.T(), editedTender.ServiceType)
+}
+
+func (s *ApiTestSuite) TestReturn400WhenCreateTenderWithCategoriesOfDifferentServiceTypes() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+
+	construction := s.getCategoryIdByCode("F")
+	delivery := s.getCategoryIdByCode("H")
+
+	given := dto.CreateTenderDto{
+		Name:            "1",
+		Description:     "1",
+		CategoryIds:     []uuid.UUID{s.createCategory("A", nil), construction, delivery},
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	}
+
+	actual, err := http.Post(s.host+"/tenders/new", typeJson, test.ToBuffer(given))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/category/response/TestReturn400WhenCreateTenderWithCategoriesOfDifferentServiceTypes")
+	test.ValidateJsonResponse(s.T(), actual, expected, 400)
+}
+
+func (s *ApiTestSuite) TestReturn400WhenCreateTenderWithUnknownCategory() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+
+	given := dto.CreateTenderDto{
+		Name:            "1",
+		Description:     "1",
+		CategoryIds:     []uuid.UUID{uuid.New()},
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	}
+
+	actual, err := http.Post(s.host+"/tenders/new", typeJson, test.ToBuffer(given))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/category/response/TestReturn400WhenCreateTenderWithUnknownCategory")
+	test.ValidateJsonResponse(s.T(), actual, expected, 400)
+}
+
+func (s *ApiTestSuite) TestReturn400WhenMoveCategoryUnderDescendant() {
+	parentId := s.getCategoryIdByCode("F")
+	child := s.createCategory("F.41", &parentId)
+
+	given := dto.UpdateCategoryDto{ParentId: &child}
+
+	actual, err := test.HttpWithBearer(http.MethodPatch, s.host+fmt.Sprintf("/admin/categories/%s/edit", parentId), adminToken, given)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/category/response/TestReturn400WhenMoveCategoryUnderDescendant")
+	test.ValidateJsonResponse(s.T(), actual, expected, 400)
+}
+
+func (s *ApiTestSuite) TestDeleteCategory() {
+	parentId := s.getCategoryIdByCode("F")
+	categoryId := s.createCategory("F.41", &parentId)
+
+	actual, err := test.HttpWithBearer(http.MethodDelete, s.host+fmt.Sprintf("/admin/categories/%s", categoryId), adminToken, nil)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	require.Equal(s.T(), 204, actual.StatusCode)
+
+	deleted, err := http.Get(s.host + fmt.Sprintf("/categories/%s", categoryId))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer deleted.Body.Close()
+
+	require.Equal(s.T(), 404, deleted.StatusCode)
+}
+
+func (s *ApiTestSuite) TestReturn400WhenDeleteCategoryInUse() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+
+	parentId := s.getCategoryIdByCode("F")
+	categoryId := s.createCategory("F.41", &parentId)
+
+	_, err := s.tenderRepository.SaveTender(context.Background(), tender.Tender{
+		Name:            "1",
+		Description:     "1",
+		Status:          tender.Created,
+		ServiceType:     tender.Construction,
+		CategoryIds:     []uuid.UUID{categoryId},
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+	require.NoError(s.T(), err)
+
+	actual, err := test.HttpWithBearer(http.MethodDelete, s.host+fmt.Sprintf("/admin/categories/%s", categoryId), adminToken, nil)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/category/response/TestReturn400WhenDeleteCategoryInUse")
+	test.ValidateJsonResponse(s.T(), actual, expected, 400)
+}
@@ -0,0 +1,300 @@
+package integrational
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"tender-service/internal/httperr"
+	"tender-service/internal/model/dto"
+	"tender-service/internal/model/entity/bid"
+	"tender-service/internal/model/entity/decision"
+	"tender-service/internal/model/entity/tender"
+	"tender-service/pkg/client"
+	"testing"
+	"time"
+)
+
+const (
+	openApiPath         = "../../docs/openapi.yml"
+	componentParameters = "#/components/parameters/"
+)
+
+var pathParamPattern = regexp.MustCompile(`\{[^}]+}`)
+
+type openApiDoc struct {
+	Paths      map[string]map[string]openApiOperation `yaml:"paths"`
+	Components struct {
+		Parameters map[string]openApiParameter `yaml:"parameters"`
+	} `yaml:"components"`
+}
+
+type openApiOperation struct {
+	OperationId string             `yaml:"operationId"`
+	Parameters  []openApiParameter `yaml:"parameters"`
+}
+
+type openApiParameter struct {
+	Ref  string `yaml:"$ref"`
+	Name string `yaml:"name"`
+	In   string `yaml:"in"`
+}
+
+type recordedRequest struct {
+	method string
+	path   string
+	query  []string
+	header http.Header
+}
+
+func TestClientMatchesOpenApi(t *testing.T) {
+	raw, err := os.ReadFile(openApiPath)
+	require.NoError(t, err)
+
+	var doc openApiDoc
+	require.NoError(t, yaml.Unmarshal(raw, &doc))
+
+	var mu sync.Mutex
+	var recorded []recordedRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		query := make([]string, 0, len(r.URL.Query()))
+		for name := range r.URL.Query() {
+			query = append(query, name)
+		}
+		recorded = append(recorded, recordedRequest{
+			method: r.Method,
+			path:   strings.TrimPrefix(r.URL.Path, "/api"),
+			query:  query,
+			header: r.Header.Clone(),
+		})
+
+		w.Header().Set("Content-Type", typeJson)
+		w.Write([]byte("null"))
+	}))
+	defer server.Close()
+
+	c, err := client.New(server.URL+"/api", client.WithAdminToken(adminToken))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	id := uuid.New()
+	budget := 10.0
+	page := client.Page{Limit: 1, Cursor: "c", Sort: []string{"-created_at"}, WithTotal: true}
+
+	calls := []func() error{
+		func() error { return c.Ping(ctx) },
+		func() error {
+			_, _, err := c.GetTenders(ctx, page, client.TenderFilter{ServiceTypes: []tender.ServiceType{tender.Delivery}, CategoryIds: []uuid.UUID{id}})
+			return err
+		},
+		func() error {
+			_, err := c.SearchTenders(ctx, client.Page{Offset: 1, Limit: 1}, client.TenderSearch{
+				Query:          "q",
+				OrganizationId: id,
+				Statuses:       []tender.Status{tender.Published},
+				CreatedFrom:    time.Now(),
+				CreatedTo:      time.Now(),
+				BudgetFrom:     &budget,
+				BudgetTo:       &budget,
+				Username:       "test",
+			})
+			return err
+		},
+		func() error { _, err := c.CreateTender(ctx, dto.CreateTenderDto{}); return err },
+		func() error { _, _, err := c.GetUserTenders(ctx, page, "test"); return err },
+		func() error { _, err := c.GetTenderStatus(ctx, id, "test"); return err },
+		func() error { _, err := c.UpdateTenderStatus(ctx, id, "test", tender.Published); return err },
+		func() error { _, err := c.CancelTender(ctx, id, "test", "reason"); return err },
+		func() error { _, err := c.GetTenderAward(ctx, id); return err },
+		func() error { _, err := c.EditTender(ctx, id, "test", dto.UpdateTenderDto{}); return err },
+		func() error { _, err := c.RollbackTender(ctx, id, 1, "test"); return err },
+		func() error { _, err := c.CreateBid(ctx, dto.CreateBidDto{}); return err },
+		func() error { _, _, err := c.GetUserBids(ctx, page, "test"); return err },
+		func() error { _, _, err := c.GetTenderBids(ctx, page, id, "test"); return err },
+		func() error { _, err := c.GetBidStatus(ctx, id, "test"); return err },
+		func() error { _, err := c.UpdateBidStatus(ctx, id, "test", bid.Published); return err },
+		func() error { _, err := c.EditBid(ctx, id, "test", dto.UpdateBidDto{}); return err },
+		func() error { _, err := c.SubmitBidDecision(ctx, id, "test", decision.Approved); return err },
+		func() error { _, err := c.WithdrawBidDecision(ctx, id, "test"); return err },
+		func() error { _, err := c.WithdrawBid(ctx, id, "test", "reason"); return err },
+		func() error { _, err := c.GetBidDecisions(ctx, id, "test"); return err },
+		func() error {
+			_, err := c.CreateBidFeedback(ctx, id, "test", "feedback", &dto.RatingScoresDto{Quality: 5, Timeliness: 4, Communication: 3})
+			return err
+		},
+		func() error { _, err := c.RollbackBid(ctx, id, 1, "test"); return err },
+		func() error { _, _, err := c.GetBidReviews(ctx, client.Page{Limit: 1}, id, "author", "test"); return err },
+		func() error {
+			_, err := c.CreateSavedSearch(ctx, "test", dto.CreateSavedSearchDto{})
+			return err
+		},
+		func() error { _, err := c.GetUserSavedSearches(ctx, "test"); return err },
+		func() error { return c.DeleteSavedSearch(ctx, id, "test") },
+		func() error {
+			_, err := c.CreateContract(ctx, "test", dto.CreateContractDto{})
+			return err
+		},
+		func() error { _, err := c.GetUserContracts(ctx, "test"); return err },
+		func() error { _, err := c.GetContract(ctx, id, "test"); return err },
+		func() error {
+			_, err := c.AddContractMilestone(ctx, id, "test", dto.CreateMilestoneDto{})
+			return err
+		},
+		func() error { _, err := c.CompleteContractMilestone(ctx, id, id, "test"); return err },
+		func() error {
+			_, err := c.RateContract(ctx, id, "test", dto.RatingScoresDto{Quality: 5, Timeliness: 4, Communication: 3})
+			return err
+		},
+		func() error { _, err := c.GetEmployeeReputation(ctx, id, "test"); return err },
+		func() error { _, _, err := c.GetEmployeeRatingHistory(ctx, client.Page{Limit: 1}, id, "test"); return err },
+		func() error { _, err := c.GetOrganizationReputation(ctx, id, "test"); return err },
+		func() error { _, err := c.GetCategories(ctx); return err },
+		func() error { _, err := c.GetCategory(ctx, id); return err },
+		func() error { _, err := c.CreateCategory(ctx, dto.CreateCategoryDto{}); return err },
+		func() error { _, err := c.EditCategory(ctx, id, dto.UpdateCategoryDto{}); return err },
+		func() error { return c.DeleteCategory(ctx, id) },
+	}
+
+	for _, call := range calls {
+		require.NoError(t, call())
+	}
+
+	covered := make(map[string]bool)
+	for _, req := range recorded {
+		path, operation, ok := findOperation(doc, req.method, req.path)
+		require.Truef(t, ok, "%s %s is not described in openapi", req.method, req.path)
+		covered[operation.OperationId] = true
+
+		declared := make(map[string]bool)
+		for _, param := range operation.Parameters {
+			if param.Ref != "" {
+				param = doc.Components.Parameters[strings.TrimPrefix(param.Ref, componentParameters)]
+			}
+			declared[param.In+":"+strings.ToLower(param.Name)] = true
+		}
+
+		for _, name := range req.query {
+			require.Truef(t, declared["query:"+strings.ToLower(name)], "query parameter %s is not described for %s %s", name, req.method, path)
+		}
+		if req.header.Get("Idempotency-Key") != "" {
+			require.Truef(t, declared["header:idempotency-key"], "Idempotency-Key is not described for %s %s", req.method, path)
+		}
+	}
+
+	for path, operations := range doc.Paths {
+		for method, operation := range operations {
+			require.Truef(t, covered[operation.OperationId], "client does not cover %s %s", strings.ToUpper(method), path)
+		}
+	}
+}
+
+func TestClientRetriesIdempotentCallsAndMapsErrors(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", httperr.ProblemContentType)
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"type":"urn:tender-service:problem:internal","title":"Unavailable","status":503}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"type":"urn:tender-service:problem:not-found","title":"Not Found","status":404,"detail":"tender not found"}`))
+	}))
+	defer server.Close()
+
+	c, err := client.New(server.URL+"/api", client.WithRetryPolicy(client.RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}))
+	require.NoError(t, err)
+
+	_, err = c.GetTenderStatus(context.Background(), uuid.New(), "test")
+
+	require.Equal(t, int32(3), attempts.Load())
+	require.ErrorIs(t, err, client.ErrNotFound)
+
+	var apiErr *client.Error
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, http.StatusNotFound, apiErr.Status)
+	require.Equal(t, "tender not found", apiErr.Detail)
+
+	attempts.Store(0)
+	_, err = c.EditTender(context.Background(), uuid.New(), "test", dto.UpdateTenderDto{})
+
+	require.Equal(t, int32(1), attempts.Load())
+	require.ErrorIs(t, err, client.ErrInternal)
+}
+
+func (s *ApiTestSuite) TestClientCreateAndPublishTender() {
+	ctx := context.Background()
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+
+	c, err := client.New(s.host)
+	require.NoError(s.T(), err)
+
+	deadline := time.Now().Add(24 * time.Hour)
+	created, err := c.CreateTender(ctx, dto.CreateTenderDto{
+		Name:            "1",
+		Description:     "2",
+		ServiceType:     tender.Construction,
+		BidDeadline:     &deadline,
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), tender.Created, created.Status)
+	require.Equal(s.T(), []tender.Status{tender.Published, tender.Closed}, created.Transitions)
+
+	published, err := c.UpdateTenderStatus(ctx, created.Id, "test", tender.Published)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), tender.Published, published.Status)
+	require.Equal(s.T(), 2, published.Version)
+
+	tenders, _, err := c.GetTenders(ctx, client.Page{}, client.TenderFilter{})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), tenders, 1)
+	require.Equal(s.T(), created.Id, tenders[0].Id)
+}
+
+func (s *ApiTestSuite) TestClientReturnTypedErrorWhenEmployeeDoesNotExist() {
+	c, err := client.New(s.host, client.WithLanguage("ru"))
+	require.NoError(s.T(), err)
+
+	_, _, err = c.GetUserTenders(context.Background(), client.Page{}, "test")
+
+	require.ErrorIs(s.T(), err, client.ErrNotAuthorized)
+
+	var apiErr *client.Error
+	require.True(s.T(), errors.As(err, &apiErr))
+	require.Equal(s.T(), http.StatusUnauthorized, apiErr.Status)
+	require.Equal(s.T(), "сотрудник не найден", apiErr.Detail)
+	require.NotEmpty(s.T(), apiErr.RequestId)
+}
+
+func findOperation(doc openApiDoc, method, path string) (string, openApiOperation, bool) {
+	if operation, ok := doc.Paths[path][strings.ToLower(method)]; ok {
+		return path, operation, true
+	}
+	for template, operations := range doc.Paths {
+		if !pathParamPattern.MatchString(template) && template != path {
+			continue
+		}
+		pattern := "^" + pathParamPattern.ReplaceAllString(template, "[^/]+") + "$"
+		if !regexp.MustCompile(pattern).MatchString(path) {
+			continue
+		}
+		if operation, ok := operations[strings.ToLower(method)]; ok {
+			return template, operation, true
+		}
+	}
+	return "", openApiOperation{}, false
+}
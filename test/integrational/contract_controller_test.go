@@ -0,0 +1,226 @@
+package integrational
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"tender-service/internal/model/dto"
+	"tender-service/internal/model/entity/award"
+	"tender-service/internal/model/entity/contract"
+	"tender-service/test"
+	"time"
+)
+
+var (
+	contractStart = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	contractEnd   = time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func (s *ApiTestSuite) TestCreateContract() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	supplierId := s.createEmployee("supplier")
+	price := 120000.0
+	awarded := s.createAwardedTender(orgId, supplierId, &price)
+
+	given := dto.CreateContractDto{
+		TenderId:  awarded.TenderId,
+		StartDate: contractStart,
+		EndDate:   contractEnd,
+		Milestones: []dto.CreateMilestoneDto{
+			{Title: "Поставка", DueDate: contractStart.AddDate(0, 3, 0)},
+			{Title: "Аванс", DueDate: contractStart.AddDate(0, 1, 0)},
+		},
+	}
+
+	actual, err := http.Post(s.host+"/contracts/new?username=test", typeJson, test.ToBuffer(given))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/contract/response/TestCreateContract")
+	test.ValidateJsonResponse(s.T(), actual, expected, 200)
+}
+
+func (s *ApiTestSuite) TestReturn409WhenCreateContractTwice() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	supplierId := s.createEmployee("supplier")
+	awarded := s.createAwardedTender(orgId, supplierId, nil)
+
+	given := dto.CreateContractDto{TenderId: awarded.TenderId, StartDate: contractStart, EndDate: contractEnd}
+
+	created, err := http.Post(s.host+"/contracts/new?username=test", typeJson, test.ToBuffer(given))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	created.Body.Close()
+	require.Equal(s.T(), 200, created.StatusCode)
+
+	actual, err := http.Post(s.host+"/contracts/new?username=test", typeJson, test.ToBuffer(given))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/contract/response/TestReturn409WhenCreateContractTwice")
+	test.ValidateJsonResponse(s.T(), actual, expected, 409)
+}
+
+func (s *ApiTestSuite) TestReturn400WhenCreateContractAndMilestoneOutsideTerm() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	supplierId := s.createEmployee("supplier")
+	awarded := s.createAwardedTender(orgId, supplierId, nil)
+
+	given := dto.CreateContractDto{
+		TenderId:   awarded.TenderId,
+		StartDate:  contractStart,
+		EndDate:    contractEnd,
+		Milestones: []dto.CreateMilestoneDto{{Title: "Поставка", DueDate: contractEnd.AddDate(0, 1, 0)}},
+	}
+
+	actual, err := http.Post(s.host+"/contracts/new?username=test", typeJson, test.ToBuffer(given))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/contract/response/TestReturn400WhenCreateContractAndMilestoneOutsideTerm")
+	test.ValidateJsonResponse(s.T(), actual, expected, 400)
+}
+
+func (s *ApiTestSuite) TestGetContract() {
+	testCases := []struct {
+		name     string
+		username string
+		status   int
+	}{
+		{name: "WhenCustomer", username: "test", status: 200},
+		{name: "WhenSupplier", username: "supplier", status: 200},
+		{name: "WhenNotParty", username: "other", status: 403},
+		{name: "WhenEmployeeDontExists", username: "ghost", status: 401},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			orgId := s.createOrganization()
+			s.createEmployeeInOrg("test", orgId)
+			supplierId := s.createEmployee("supplier")
+			s.createEmployee("other")
+			awarded := s.createAwardedTender(orgId, supplierId, nil)
+
+			saved := s.createContract(awarded, orgId)
+
+			actual, err := http.Get(s.host + fmt.Sprintf("/contracts/%s?username=%s", saved.Id.String(), tc.username))
+			if err != nil {
+				s.T().Fatalf("Failed to send request: %v", err)
+			}
+			defer actual.Body.Close()
+
+			require.Equal(s.T(), tc.status, actual.StatusCode)
+		})
+	}
+}
+
+func (s *ApiTestSuite) TestReturn403WhenSupplierAddsContractMilestone() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	supplierId := s.createEmployee("supplier")
+	awarded := s.createAwardedTender(orgId, supplierId, nil)
+
+	saved := s.createContract(awarded, orgId)
+
+	given := dto.CreateMilestoneDto{Title: "Поставка", DueDate: contractStart.AddDate(0, 1, 0)}
+
+	actual, err := http.Post(s.host+fmt.Sprintf("/contracts/%s/milestones?username=supplier", saved.Id.String()), typeJson, test.ToBuffer(given))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/contract/response/TestReturn403WhenSupplierAddsContractMilestone")
+	test.ValidateJsonResponse(s.T(), actual, expected, 403)
+}
+
+func (s *ApiTestSuite) TestCompleteContractMilestone() {
+	ctx := context.Background()
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	supplierId := s.createEmployee("supplier")
+	awarded := s.createAwardedTender(orgId, supplierId, nil)
+
+	saved := s.createContract(awarded, orgId, contract.Milestone{Title: "Поставка", DueDate: contractStart.AddDate(0, 1, 0)})
+	milestoneId := saved.Milestones[0].Id
+
+	actual, err := test.HttpPut(s.host+fmt.Sprintf("/contracts/%s/milestones/%s/complete?username=supplier", saved.Id.String(), milestoneId.String()), nil)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/contract/response/TestCompleteContractMilestone")
+	test.ValidateJsonResponse(s.T(), actual, expected, 200)
+
+	again, err := test.HttpPut(s.host+fmt.Sprintf("/contracts/%s/milestones/%s/complete?username=test", saved.Id.String(), milestoneId.String()), nil)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	again.Body.Close()
+
+	fromDb, _ := s.contractRepository.GetContractById(ctx, saved.Id)
+	require.Equal(s.T(), "supplier", *fromDb.Milestones[0].CompletedBy)
+}
+
+func (s *ApiTestSuite) TestGetMyContracts() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	supplierId := s.createEmployee("supplier")
+	otherId := s.createEmployee("other")
+
+	s.createContract(s.createAwardedTender(orgId, supplierId, nil), orgId)
+	s.createContract(s.createAwardedTender(orgId, otherId, nil), orgId)
+
+	actual, err := http.Get(s.host + "/contracts/my?username=supplier")
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	var contracts []dto.ContractDto
+	require.NoError(s.T(), json.NewDecoder(actual.Body).Decode(&contracts))
+	require.Len(s.T(), contracts, 1)
+	require.Equal(s.T(), supplierId, contracts[0].SupplierId)
+
+	customer, err := http.Get(s.host + "/contracts/my?username=test")
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer customer.Body.Close()
+
+	require.NoError(s.T(), json.NewDecoder(customer.Body).Decode(&contracts))
+	require.Len(s.T(), contracts, 2)
+}
+
+func (s *ApiTestSuite) createContract(awarded award.Award, orgId uuid.UUID, milestones ...contract.Milestone) contract.Contract {
+	winner, _ := s.bidRepository.GetBidById(context.Background(), awarded.BidId)
+
+	saved, err := s.contractRepository.SaveContract(context.Background(), contract.Contract{
+		AwardId:                awarded.Id,
+		TenderId:               awarded.TenderId,
+		BidId:                  awarded.BidId,
+		CustomerOrganizationId: orgId,
+		SupplierType:           winner.AuthorType,
+		SupplierId:             winner.AuthorId,
+		StartDate:              contractStart,
+		EndDate:                contractEnd,
+		Milestones:             milestones,
+	})
+	if err != nil {
+		s.T().Fatalf("Failed to create contract: %v", err)
+	}
+	return saved
+}
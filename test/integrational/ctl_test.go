@@ -0,0 +1,255 @@
+package integrational
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"tender-service/internal/model"
+	"tender-service/internal/model/entity"
+	"tender-service/internal/model/entity/bid"
+	"tender-service/internal/model/entity/decision"
+	"tender-service/internal/model/entity/dump"
+	"tender-service/internal/model/entity/notification"
+	"tender-service/internal/model/entity/organization"
+	"tender-service/internal/model/entity/tender"
+)
+
+func (s *ApiTestSuite) TestCtlAddEmployeeToOrganization() {
+	ctx := context.Background()
+
+	employee, err := s.ctl.EmployeeService().CreateEmployee(ctx, entity.Employee{Username: "operator", FirstName: "Ivan"})
+	require.NoError(s.T(), err)
+
+	org, err := s.ctl.OrganizationService().CreateOrganization(ctx, organization.Organization{Name: "org", Type: organization.LLC})
+	require.NoError(s.T(), err)
+
+	require.NoError(s.T(), s.ctl.OrganizationService().AddOrganizationEmployee(ctx, org.Id, employee))
+	require.NoError(s.T(), s.ctl.OrganizationService().ValidateEmployeeBelongsToOrganization(ctx, org.Id, "operator"))
+
+	var apiErr model.ApiError
+	err = s.ctl.OrganizationService().AddOrganizationEmployee(ctx, org.Id, employee)
+	require.True(s.T(), errors.As(err, &apiErr))
+	require.Equal(s.T(), model.ConflictCode, apiErr.Code)
+
+	require.NoError(s.T(), s.ctl.OrganizationService().RemoveOrganizationEmployee(ctx, org.Id, employee))
+	err = s.ctl.OrganizationService().RemoveOrganizationEmployee(ctx, org.Id, employee)
+	require.True(s.T(), errors.As(err, &apiErr))
+	require.Equal(s.T(), model.NotFoundCode, apiErr.Code)
+}
+
+func (s *ApiTestSuite) TestCtlForceCloseAndReopenTender() {
+	ctx := context.Background()
+
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	bidCreatorId := s.createEmployee("creator")
+
+	tend, _ := s.tenderRepository.SaveTender(ctx, tender.Tender{
+		Name:            "1",
+		Description:     "2",
+		Status:          tender.Published,
+		ServiceType:     tender.Delivery,
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+
+	open, _ := s.bidRepository.SaveBid(ctx, bid.Bid{Name: "1", Description: "1", Status: bid.Published, TenderId: tend.Id, AuthorType: bid.AuthorUser, AuthorId: bidCreatorId})
+
+	closed, err := s.ctl.TenderService().ForceTenderStatus(ctx, tend.Id, tender.Closed)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), tender.Closed, closed.Status)
+
+	fromDb, _ := s.bidRepository.GetBidById(ctx, open.Id)
+	require.Equal(s.T(), bid.NotAwarded, fromDb.Decision)
+
+	reopened, err := s.ctl.TenderService().ForceTenderStatus(ctx, tend.Id, tender.Published)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), tender.Published, reopened.Status)
+
+	fromDb, _ = s.bidRepository.GetBidById(ctx, open.Id)
+	require.Equal(s.T(), bid.None, fromDb.Decision)
+}
+
+func (s *ApiTestSuite) TestCtlReopenAwardedTender() {
+	ctx := context.Background()
+
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	supplierId := s.createEmployee("supplier")
+
+	awarded := s.createAwardedTender(orgId, supplierId, nil)
+
+	var apiErr model.ApiError
+	_, err := s.ctl.TenderService().ReopenTender(ctx, awarded.TenderId, false)
+	require.True(s.T(), errors.As(err, &apiErr))
+	require.Equal(s.T(), model.ConflictCode, apiErr.Code)
+
+	reopened, err := s.ctl.TenderService().ReopenTender(ctx, awarded.TenderId, true)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), tender.Published, reopened.Status)
+
+	_, err = s.awardRepository.GetAwardByTenderId(ctx, awarded.TenderId)
+	require.True(s.T(), errors.As(err, &apiErr))
+	require.Equal(s.T(), model.NotFoundCode, apiErr.Code)
+
+	winner, _ := s.bidRepository.GetBidById(ctx, awarded.BidId)
+	require.Equal(s.T(), bid.None, winner.Decision)
+}
+
+func (s *ApiTestSuite) TestCtlReturnConflictWhenReopenCancelledTender() {
+	ctx := context.Background()
+
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+
+	tend, _ := s.tenderRepository.SaveTender(ctx, tender.Tender{
+		Name:            "1",
+		Description:     "2",
+		Status:          tender.Cancelled,
+		ServiceType:     tender.Delivery,
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+
+	var apiErr model.ApiError
+	_, err := s.ctl.TenderService().ReopenTender(ctx, tend.Id, true)
+	require.True(s.T(), errors.As(err, &apiErr))
+	require.Equal(s.T(), model.ConflictCode, apiErr.Code)
+}
+
+func (s *ApiTestSuite) TestCtlRecomputeBidDecisions() {
+	ctx := context.Background()
+
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	s.createEmployeeInOrg("test2", orgId)
+	bidCreatorId := s.createEmployee("creator")
+
+	tend, _ := s.tenderRepository.SaveTender(ctx, tender.Tender{
+		Name:            "1",
+		Description:     "2",
+		Status:          tender.Published,
+		ServiceType:     tender.Delivery,
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+
+	approved, _ := s.bidRepository.SaveBid(ctx, bid.Bid{Name: "1", Description: "1", Status: bid.Published, TenderId: tend.Id, AuthorType: bid.AuthorUser, AuthorId: bidCreatorId})
+	rejected, _ := s.bidRepository.SaveBid(ctx, bid.Bid{Name: "2", Description: "2", Status: bid.Published, TenderId: tend.Id, AuthorType: bid.AuthorUser, AuthorId: bidCreatorId})
+	open, _ := s.bidRepository.SaveBid(ctx, bid.Bid{Name: "3", Description: "3", Status: bid.Published, TenderId: tend.Id, AuthorType: bid.AuthorUser, AuthorId: bidCreatorId})
+
+	s.decisionRepository.SaveDecision(ctx, decision.Decision{Verdict: decision.Approved, Username: "test", BidId: approved.Id})
+	s.decisionRepository.SaveDecision(ctx, decision.Decision{Verdict: decision.Approved, Username: "test2", BidId: approved.Id})
+	s.decisionRepository.SaveDecision(ctx, decision.Decision{Verdict: decision.Approved, Username: "test", BidId: rejected.Id})
+	s.decisionRepository.SaveDecision(ctx, decision.Decision{Verdict: decision.Rejected, Username: "test2", BidId: rejected.Id})
+
+	changed, err := s.ctl.BidService().RecomputeBidDecisions(ctx, tend.Id)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), changed, 2)
+
+	actualApproved, _ := s.bidRepository.GetBidById(ctx, approved.Id)
+	actualRejected, _ := s.bidRepository.GetBidById(ctx, rejected.Id)
+	actualOpen, _ := s.bidRepository.GetBidById(ctx, open.Id)
+	require.Equal(s.T(), bid.Approved, actualApproved.Decision)
+	require.Equal(s.T(), bid.Rejected, actualRejected.Decision)
+	require.Equal(s.T(), bid.Lost, actualOpen.Decision)
+
+	award, err := s.awardRepository.GetAwardByTenderId(ctx, tend.Id)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), approved.Id, award.BidId)
+	closed, _ := s.tenderRepository.GetTenderById(ctx, tend.Id)
+	require.Equal(s.T(), tender.Closed, closed.Status)
+
+	changed, err = s.ctl.BidService().RecomputeBidDecisions(ctx, tend.Id)
+	require.NoError(s.T(), err)
+	require.Empty(s.T(), changed)
+}
+
+func (s *ApiTestSuite) TestCtlRecomputeBidDecisionsKeepsAwardWhenOrganizationGrows() {
+	ctx := context.Background()
+
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	bidCreatorId := s.createEmployee("creator")
+
+	tend, _ := s.tenderRepository.SaveTender(ctx, tender.Tender{
+		Name:            "1",
+		Description:     "2",
+		Status:          tender.Published,
+		ServiceType:     tender.Delivery,
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+	winner, _ := s.bidRepository.SaveBid(ctx, bid.Bid{Name: "1", Description: "1", Status: bid.Published, TenderId: tend.Id, AuthorType: bid.AuthorUser, AuthorId: bidCreatorId})
+
+	_, err := s.ctl.BidService().SubmitBidDecision(ctx, winner.Id, "test", decision.Approved)
+	require.NoError(s.T(), err)
+
+	s.createEmployeeInOrg("test2", orgId)
+	s.createEmployeeInOrg("test3", orgId)
+
+	changed, err := s.ctl.BidService().RecomputeBidDecisions(ctx, tend.Id)
+	require.NoError(s.T(), err)
+	require.Empty(s.T(), changed)
+
+	actualWinner, _ := s.bidRepository.GetBidById(ctx, winner.Id)
+	require.Equal(s.T(), bid.Approved, actualWinner.Decision)
+}
+
+func (s *ApiTestSuite) TestCtlReplayFailedNotifications() {
+	ctx := context.Background()
+
+	_, err := s.pool.Exec(ctx, "INSERT INTO notification (type, recipient, dedup_key, payload, status, attempts, last_error) VALUES "+
+		"('TenderAlert', 'test', 'failed', '{}', 'Failed', 5, 'timeout'), ('TenderAlert', 'test', 'sent', '{}', 'Sent', 1, NULL)")
+	require.NoError(s.T(), err)
+
+	count, err := s.ctl.NotificationService().ReplayNotifications(ctx, notification.ReplayFilter{Statuses: []notification.Status{notification.Failed}})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 1, count)
+
+	// the dispatcher may already be delivering the replayed notification, so only the reset of attempts is checked
+	var attempts int
+	require.NoError(s.T(), s.pool.QueryRow(ctx, "SELECT attempts FROM notification WHERE dedup_key = 'failed'").Scan(&attempts))
+	require.Less(s.T(), attempts, 5)
+
+	var status notification.Status
+	require.NoError(s.T(), s.pool.QueryRow(ctx, "SELECT status FROM notification WHERE dedup_key = 'sent'").Scan(&status))
+	require.Equal(s.T(), notification.Sent, status)
+}
+
+func (s *ApiTestSuite) TestCtlExportAndImport() {
+	ctx := context.Background()
+
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+
+	tend, _ := s.tenderRepository.SaveTender(ctx, tender.Tender{
+		Name:            "1",
+		Description:     "2",
+		Status:          tender.Published,
+		ServiceType:     tender.Delivery,
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+	s.tenderRepository.UpdateTender(ctx, tend.Id, "new", "", "", nil, nil)
+
+	var exported bytes.Buffer
+	require.NoError(s.T(), s.ctl.DumpService().Export(ctx, &exported))
+
+	_, err := s.pool.Exec(ctx, "TRUNCATE employee, organization, organization_responsible, tender, tender_version, tender_search")
+	require.NoError(s.T(), err)
+
+	imported, err := s.ctl.DumpService().Import(ctx, bytes.NewReader(exported.Bytes()))
+	require.NoError(s.T(), err)
+	require.Contains(s.T(), imported, dump.ImportedTable{Name: "tender_version", Inserted: 2})
+
+	actual, err := s.tenderRepository.GetTenderById(ctx, tend.Id)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), "new", actual.Name)
+	require.Equal(s.T(), 2, actual.Version)
+
+	again, err := s.ctl.DumpService().Import(ctx, bytes.NewReader(exported.Bytes()))
+	require.NoError(s.T(), err)
+	require.Contains(s.T(), again, dump.ImportedTable{Name: "tender_version", Inserted: 0})
+}
@@ -0,0 +1,153 @@
+package integrational
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"tender-service/internal/model/entity/bid"
+	"tender-service/internal/model/entity/tender"
+	tenderv1 "tender-service/pkg/api/tender/v1"
+	"time"
+)
+
+func (s *ApiTestSuite) TestGrpcCreateTender() {
+	ctx := context.Background()
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+
+	created, err := s.tenderClient.CreateTender(ctx, &tenderv1.CreateTenderRequest{
+		Name:            "1",
+		Description:     "2",
+		ServiceType:     string(tender.Construction),
+		OrganizationId:  orgId.String(),
+		CreatorUsername: "test",
+	})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), "1", created.GetTender().GetName())
+	require.Equal(s.T(), string(tender.Created), created.GetTender().GetStatus())
+	require.Equal(s.T(), int32(1), created.GetTender().GetVersion())
+
+	actual, err := s.tenderClient.GetUserTenders(ctx, &tenderv1.GetUserTendersRequest{Username: "test"})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), actual.GetTenders(), 1)
+	require.Equal(s.T(), created.GetTender().GetId(), actual.GetTenders()[0].GetId())
+}
+
+func (s *ApiTestSuite) TestGrpcReturnInvalidArgumentWhenCreateTenderWithoutRequiredFields() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+
+	_, err := s.tenderClient.CreateTender(context.Background(), &tenderv1.CreateTenderRequest{
+		Name:            "1",
+		ServiceType:     string(tender.Construction),
+		OrganizationId:  orgId.String(),
+		CreatorUsername: "test",
+	})
+
+	st, _ := status.FromError(err)
+	require.Equal(s.T(), codes.InvalidArgument, st.Code())
+	require.Equal(s.T(), "request validation failed", st.Message())
+	require.Len(s.T(), st.Details(), 1)
+}
+
+func (s *ApiTestSuite) TestGrpcReturnUnauthenticatedInRussianWhenEmployeeDoesNotExist() {
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "accept-language", "ru")
+
+	_, err := s.tenderClient.GetUserTenders(ctx, &tenderv1.GetUserTendersRequest{Username: "test"})
+
+	st, _ := status.FromError(err)
+	require.Equal(s.T(), codes.Unauthenticated, st.Code())
+	require.Equal(s.T(), "сотрудник не найден", st.Message())
+}
+
+func (s *ApiTestSuite) TestGrpcStreamTenderBids() {
+	ctx := context.Background()
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	authorId := s.createEmployee("author")
+
+	tend, _ := s.tenderRepository.SaveTender(ctx, tender.Tender{
+		Name:            "1",
+		Description:     "2",
+		Status:          tender.Published,
+		ServiceType:     tender.Delivery,
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+
+	for _, name := range []string{"a", "b", "c"} {
+		s.bidRepository.SaveBid(ctx, bid.Bid{
+			Name:        name,
+			Description: name,
+			Status:      bid.Published,
+			TenderId:    tend.Id,
+			AuthorType:  bid.AuthorUser,
+			AuthorId:    authorId,
+		})
+	}
+
+	stream, err := s.bidClient.StreamTenderBids(ctx, &tenderv1.StreamTenderBidsRequest{
+		TenderId:  tend.Id.String(),
+		Username:  "test",
+		Sort:      "name",
+		BatchSize: 2,
+	})
+	require.NoError(s.T(), err)
+
+	var names []string
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(s.T(), err)
+		names = append(names, resp.GetBid().GetName())
+	}
+
+	require.Equal(s.T(), []string{"a", "b", "c"}, names)
+}
+
+func (s *ApiTestSuite) TestGrpcWatchTenderEvents() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	created, err := s.tenderClient.CreateTender(ctx, &tenderv1.CreateTenderRequest{
+		Name:            "1",
+		Description:     "2",
+		ServiceType:     string(tender.Construction),
+		OrganizationId:  orgId.String(),
+		CreatorUsername: "test",
+		BidDeadline:     timestamppb.New(time.Now().Add(24 * time.Hour)),
+	})
+	require.NoError(s.T(), err)
+
+	stream, err := s.tenderClient.WatchTenderEvents(ctx, &tenderv1.WatchTenderEventsRequest{
+		Username:       "test",
+		OrganizationId: orgId.String(),
+	})
+	require.NoError(s.T(), err)
+
+	// headers are sent once the subscription is registered
+	_, err = stream.Header()
+	require.NoError(s.T(), err)
+
+	_, err = s.tenderClient.UpdateTenderStatus(ctx, &tenderv1.UpdateTenderStatusRequest{
+		TenderId: created.GetTender().GetId(),
+		Username: "test",
+		Status:   string(tender.Published),
+	})
+	require.NoError(s.T(), err)
+
+	event, err := stream.Recv()
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), "status_changed", event.GetType())
+	require.Equal(s.T(), created.GetTender().GetId(), event.GetTender().GetId())
+	require.Equal(s.T(), string(tender.Published), event.GetTender().GetStatus())
+}
@@ -0,0 +1,27 @@
+package integrational
+
+import (
+	"net/http"
+	"strings"
+	"tender-service/test"
+)
+
+func (s *ApiTestSuite) TestGetHealthz() {
+	actual, err := http.Get(strings.TrimSuffix(s.host, "/api") + "/healthz")
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	test.ValidateJsonResponse(s.T(), actual, test.ReadJson("/health/response/healthz"), 200)
+}
+
+func (s *ApiTestSuite) TestGetReadyz() {
+	actual, err := http.Get(strings.TrimSuffix(s.host, "/api") + "/readyz")
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	test.ValidateJsonResponse(s.T(), actual, test.ReadJson("/health/response/readyz"), 200)
+}
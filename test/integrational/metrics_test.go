@@ -0,0 +1,55 @@
+package integrational
+
+import (
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"strings"
+	"tender-service/internal/model/dto"
+	"tender-service/internal/model/entity/tender"
+	"tender-service/test"
+)
+
+func (s *ApiTestSuite) TestGetMetrics() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+
+	given := dto.CreateTenderDto{
+		Name:            "1",
+		Description:     "1",
+		ServiceType:     tender.Construction,
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	}
+
+	created, err := http.Post(s.host+"/tenders/new", typeJson, test.ToBuffer(given))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	created.Body.Close()
+
+	notFound, err := http.Get(s.host + "/tenders/" + orgId.String() + "/status?username=test")
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	notFound.Body.Close()
+
+	actual, err := http.Get(strings.TrimSuffix(s.host, "/api") + "/metrics")
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	body, err := io.ReadAll(actual.Body)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 200, actual.StatusCode)
+
+	for _, expected := range []string{
+		`tender_service_http_requests_total{method="POST",route="/api/tenders/new",status="200"}`,
+		`tender_service_http_request_duration_seconds_count{method="GET",route="/api/tenders/{tenderId}/status",status="404"}`,
+		"tender_service_tenders_created_total",
+		"tender_service_pgxpool_total_conns",
+	} {
+		require.Contains(s.T(), string(body), expected)
+	}
+}
@@ -0,0 +1,99 @@
+package integrational
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"net/url"
+	"sync"
+	"tender-service/internal/migrate"
+)
+
+const clearMigrationsDir = "../../migrations/test/clear"
+
+func (s *ApiTestSuite) TestMigrateDownToZeroAndUpAgain() {
+	ctx := context.Background()
+	conn := s.createDatabase("migrate_down")
+	migrator := s.newMigrator(conn)
+
+	_, err := migrator.Up(ctx)
+	require.NoError(s.T(), err)
+	latest, err := migrator.Version(ctx)
+	require.NoError(s.T(), err)
+
+	results, err := migrator.To(ctx, 0)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), results, int(latest))
+
+	db, err := pgx.Connect(ctx, conn)
+	require.NoError(s.T(), err)
+	defer db.Close(ctx)
+
+	var tables int
+	require.NoError(s.T(), db.QueryRow(ctx, "SELECT COUNT(*) FROM pg_tables WHERE schemaname = 'public' AND tablename <> 'goose_db_version'").Scan(&tables))
+	require.Zero(s.T(), tables)
+
+	_, err = migrator.To(ctx, 3)
+	require.NoError(s.T(), err)
+	version, err := migrator.Version(ctx)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(3), version)
+
+	_, err = migrator.Down(ctx)
+	require.NoError(s.T(), err)
+	version, err = migrator.Version(ctx)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(2), version)
+
+	_, err = migrator.Up(ctx)
+	require.NoError(s.T(), err)
+	version, err = migrator.Version(ctx)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), latest, version)
+}
+
+func (s *ApiTestSuite) TestMigrateConcurrentReplicasApplyMigrationsOnce() {
+	ctx := context.Background()
+	conn := s.createDatabase("migrate_concurrent")
+	migrators := []*migrate.Migrator{s.newMigrator(conn), s.newMigrator(conn)}
+
+	applied := make([]int, len(migrators))
+	errs := make([]error, len(migrators))
+
+	var wg sync.WaitGroup
+	for i, migrator := range migrators {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results, err := migrator.Up(ctx)
+			applied[i], errs[i] = len(results), err
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		require.NoError(s.T(), err)
+	}
+
+	latest, err := migrators[0].Version(ctx)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int(latest), applied[0]+applied[1])
+}
+
+func (s *ApiTestSuite) createDatabase(name string) string {
+	_, err := s.pool.Exec(context.Background(), "CREATE DATABASE "+name)
+	require.NoError(s.T(), err)
+
+	conn, err := url.Parse(s.conn)
+	require.NoError(s.T(), err)
+	conn.Path = "/" + name
+
+	return conn.String()
+}
+
+func (s *ApiTestSuite) newMigrator(conn string) *migrate.Migrator {
+	migrator, err := migrate.NewMigrator(conn, clearMigrationsDir)
+	require.NoError(s.T(), err)
+	s.T().Cleanup(func() { _ = migrator.Close() })
+	return migrator
+}
@@ -14,3 +14,25 @@ func (s *ApiTestSuite) TestPing() {
 
 	test.ValidateResponse(s.T(), actual, "ok", 200)
 }
+
+func (s *ApiTestSuite) TestPingEchoesRequestId() {
+	actual, err := test.HttpWithHeaders(http.MethodGet, s.host+"/ping", map[string]string{"X-Request-ID": "given-request-id"}, nil)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	s.Require().Equal("given-request-id", actual.Header.Get("X-Request-ID"))
+	test.ValidateResponse(s.T(), actual, "ok", 200)
+}
+
+func (s *ApiTestSuite) TestPingGeneratesRequestId() {
+	actual, err := http.Get(s.host + "/ping")
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	s.Require().NotEmpty(actual.Header.Get("X-Request-ID"))
+	test.ValidateResponse(s.T(), actual, "ok", 200)
+}
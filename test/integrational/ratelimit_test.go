@@ -0,0 +1,123 @@
+package integrational
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"tender-service/internal/app"
+	"tender-service/internal/config"
+	"tender-service/internal/model/dto"
+	"tender-service/internal/model/entity/tender"
+	"tender-service/internal/ratelimit"
+	"tender-service/test"
+	"time"
+)
+
+func (s *ApiTestSuite) TestRateLimit() {
+	for _, backend := range []string{ratelimit.MemoryBackend, ratelimit.PostgresBackend} {
+		s.Run(backend, func() {
+			orgId := s.createOrganization()
+			s.createEmployeeInOrg("test", orgId)
+			s.createEmployee("other")
+
+			host := s.startRateLimitedApp(config.RateLimitConfig{
+				Enabled:       true,
+				Backend:       backend,
+				ReadEmployee:  "2/h",
+				WriteEmployee: "1/h",
+			})
+
+			for i := 0; i < 2; i++ {
+				s.requireStatus(http.MethodGet, host+"/tenders/my?username=test", nil, 200)
+			}
+
+			limited := s.requireStatus(http.MethodGet, host+"/tenders/my?username=test", nil, 429)
+			retryAfter, err := strconv.Atoi(limited.Header.Get("Retry-After"))
+			require.NoError(s.T(), err)
+			require.Positive(s.T(), retryAfter)
+
+			// buckets are kept per employee and per route class
+			s.requireStatus(http.MethodGet, host+"/tenders/my?username=other", nil, 200)
+
+			given := dto.CreateTenderDto{
+				Name:            "1",
+				Description:     "1",
+				ServiceType:     tender.Construction,
+				OrganizationId:  orgId,
+				CreatorUsername: "test",
+			}
+
+			s.requireStatus(http.MethodPost, host+"/tenders/new", given, 200)
+			s.requireStatus(http.MethodPost, host+"/tenders/new", given, 429)
+		})
+	}
+}
+
+func (s *ApiTestSuite) TestRateLimitDeniedRequestKeepsTokens() {
+	for _, backend := range []string{ratelimit.MemoryBackend, ratelimit.PostgresBackend} {
+		s.Run(backend, func() {
+			orgId := s.createOrganization()
+			s.createEmployeeInOrg("test", orgId)
+			s.createEmployee("other")
+
+			host := s.startRateLimitedApp(config.RateLimitConfig{
+				Enabled:      true,
+				Backend:      backend,
+				ReadEmployee: "1/h",
+				ReadIp:       "2/h",
+			})
+
+			s.requireStatus(http.MethodGet, host+"/tenders/my?username=test", nil, 200)
+			s.requireStatus(http.MethodGet, host+"/tenders/my?username=test", nil, 429)
+
+			// the request denied by the employee bucket must not take a token from the ip bucket
+			s.requireStatus(http.MethodGet, host+"/tenders/my?username=other", nil, 200)
+			s.requireStatus(http.MethodGet, host+"/tenders/my?username=other", nil, 429)
+		})
+	}
+}
+
+func (s *ApiTestSuite) requireStatus(method, url string, body any, status int) *http.Response {
+	actual, err := test.HttpWithHeaders(method, url, map[string]string{}, body)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	actual.Body.Close()
+
+	require.Equal(s.T(), status, actual.StatusCode)
+	return actual
+}
+
+// startRateLimitedApp runs one more app on the suite database, the suite app itself has rate limiting disabled
+func (s *ApiTestSuite) startRateLimitedApp(rateLimit config.RateLimitConfig) string {
+	port := rand.Intn(65535-1025+1) + 1025
+	root := fmt.Sprintf("http://localhost:%d", port)
+
+	limited, err := app.NewApp(context.Background(), config.Config{
+		Server:    config.ServerConfig{Address: fmt.Sprintf(":%d", port)},
+		Postgres:  config.PostgresConfig{Conn: s.conn},
+		RateLimit: rateLimit,
+	})
+	require.NoError(s.T(), err)
+
+	go func() {
+		_ = limited.Run()
+	}()
+	s.T().Cleanup(func() {
+		_ = limited.Stop()
+	})
+
+	require.Eventually(s.T(), func() bool {
+		actual, err := http.Get(root + "/healthz")
+		if err != nil {
+			return false
+		}
+		actual.Body.Close()
+		return actual.StatusCode == 200
+	}, 10*time.Second, 50*time.Millisecond)
+
+	return root + "/api"
+}
@@ -0,0 +1,230 @@
+package integrational
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"tender-service/internal/model/dto"
+	"tender-service/internal/model/entity"
+	"tender-service/internal/model/entity/bid"
+	"tender-service/internal/model/entity/contract"
+	"tender-service/internal/model/entity/rating"
+	"tender-service/internal/model/entity/tender"
+	"tender-service/test"
+)
+
+func (s *ApiTestSuite) TestPutBidFeedbackWithRating() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	bidCreatorId := s.createEmployee("creator")
+	b := s.createPublishedBid(orgId, bidCreatorId)
+
+	actual, err := test.HttpPut(s.host+fmt.Sprintf("/bids/%s/feedback?username=test&bidFeedback=amazing&quality=5&timeliness=4&communication=3", b.Id.String()), nil)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	actual.Body.Close()
+	require.Equal(s.T(), 200, actual.StatusCode)
+
+	reputation, err := http.Get(s.host + fmt.Sprintf("/ratings/employees/%s?username=test", bidCreatorId.String()))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer reputation.Body.Close()
+
+	expected := test.ReadJson("/rating/response/TestPutBidFeedbackWithRating")
+	test.ValidateJsonResponse(s.T(), reputation, expected, 200)
+}
+
+func (s *ApiTestSuite) TestReturn400WhenPutBidFeedbackWithIncompleteRating() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	bidCreatorId := s.createEmployee("creator")
+	b := s.createPublishedBid(orgId, bidCreatorId)
+
+	actual, err := test.HttpPut(s.host+fmt.Sprintf("/bids/%s/feedback?username=test&bidFeedback=amazing&quality=5", b.Id.String()), nil)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/rating/response/TestReturn400WhenPutBidFeedbackWithIncompleteRating")
+	test.ValidateJsonResponse(s.T(), actual, expected, 400)
+}
+
+func (s *ApiTestSuite) TestReturn409WhenRateBidTwice() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	bidCreatorId := s.createEmployee("creator")
+	b := s.createPublishedBid(orgId, bidCreatorId)
+	s.rateBid(b, "test", 5, 5, 5)
+
+	actual, err := test.HttpPut(s.host+fmt.Sprintf("/bids/%s/feedback?username=test&bidFeedback=again&quality=1&timeliness=1&communication=1", b.Id.String()), nil)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/rating/response/TestReturn409WhenRateBidTwice")
+	test.ValidateJsonResponse(s.T(), actual, expected, 409)
+}
+
+func (s *ApiTestSuite) TestRateContract() {
+	ctx := context.Background()
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	supplierId := s.createEmployee("supplier")
+	awarded := s.createAwardedTender(orgId, supplierId, nil)
+
+	saved := s.createContract(awarded, orgId, contract.Milestone{Title: "Поставка", DueDate: contractStart.AddDate(0, 1, 0)})
+	_, err := s.contractRepository.CompleteMilestone(ctx, saved.Id, saved.Milestones[0].Id, "supplier")
+	require.NoError(s.T(), err)
+
+	given := dto.RatingScoresDto{Quality: 4, Timeliness: 5, Communication: 3}
+
+	actual, err := http.Post(s.host+fmt.Sprintf("/contracts/%s/rating?username=test", saved.Id.String()), typeJson, test.ToBuffer(given))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/rating/response/TestRateContract")
+	test.ValidateJsonResponse(s.T(), actual, expected, 200)
+
+	history, err := http.Get(s.host + fmt.Sprintf("/ratings/employees/%s/history?username=supplier", supplierId.String()))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer history.Body.Close()
+
+	var ratings []dto.RatingDto
+	require.NoError(s.T(), json.NewDecoder(history.Body).Decode(&ratings))
+	require.Len(s.T(), ratings, 1)
+	require.Equal(s.T(), saved.Id, *ratings[0].ContractId)
+}
+
+func (s *ApiTestSuite) TestReturn409WhenRateContractNotCompleted() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	supplierId := s.createEmployee("supplier")
+	awarded := s.createAwardedTender(orgId, supplierId, nil)
+
+	saved := s.createContract(awarded, orgId, contract.Milestone{Title: "Поставка", DueDate: contractStart.AddDate(0, 1, 0)})
+
+	given := dto.RatingScoresDto{Quality: 4, Timeliness: 5, Communication: 3}
+
+	actual, err := http.Post(s.host+fmt.Sprintf("/contracts/%s/rating?username=test", saved.Id.String()), typeJson, test.ToBuffer(given))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/rating/response/TestReturn409WhenRateContractNotCompleted")
+	test.ValidateJsonResponse(s.T(), actual, expected, 409)
+}
+
+func (s *ApiTestSuite) TestGetOrganizationReputation() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	supplierOrgId := s.createOrganization()
+	firstId := s.createEmployeeInOrg("first", supplierOrgId)
+	secondId := s.createEmployeeInOrg("second", supplierOrgId)
+	outsiderId := s.createEmployee("outsider")
+
+	s.rateBid(s.createPublishedBidAs(orgId, firstId, bid.AuthorOrganization), "test", 5, 4, 3)
+	s.rateBid(s.createPublishedBidAs(orgId, secondId, bid.AuthorOrganization), "test", 4, 4, 4)
+	s.rateBid(s.createPublishedBid(orgId, firstId), "test", 1, 1, 1)
+	s.rateBid(s.createPublishedBidAs(orgId, outsiderId, bid.AuthorOrganization), "test", 1, 1, 1)
+
+	actual, err := http.Get(s.host + fmt.Sprintf("/ratings/organizations/%s?username=test", supplierOrgId.String()))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/rating/response/TestGetOrganizationReputation")
+	test.ValidateJsonResponse(s.T(), actual, expected, 200)
+}
+
+func (s *ApiTestSuite) TestGetTenderBidsShowsAuthorReputation() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	ratedId := s.createEmployee("rated")
+
+	s.rateBid(s.createPublishedBid(orgId, ratedId), "test", 3, 4, 5)
+	b := s.createPublishedBid(orgId, ratedId)
+
+	actual, err := http.Get(s.host + fmt.Sprintf("/bids/%s/list?username=test", b.TenderId.String()))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/rating/response/TestGetTenderBidsShowsAuthorReputation")
+	test.ValidateJsonResponse(s.T(), actual, expected, 200)
+}
+
+func (s *ApiTestSuite) TestReturn404WhenGetEmployeeReputationAndEmployeeDontExists() {
+	s.createEmployee("test")
+
+	actual, err := http.Get(s.host + fmt.Sprintf("/ratings/employees/%s?username=test", uuid.New().String()))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	require.Equal(s.T(), 404, actual.StatusCode)
+}
+
+func (s *ApiTestSuite) createPublishedBid(orgId, authorId uuid.UUID) bid.Bid {
+	return s.createPublishedBidAs(orgId, authorId, bid.AuthorUser)
+}
+
+func (s *ApiTestSuite) createPublishedBidAs(orgId, authorId uuid.UUID, authorType bid.AuthorType) bid.Bid {
+	ctx := context.Background()
+
+	tend, err := s.tenderRepository.SaveTender(ctx, tender.Tender{
+		Name:            "1",
+		Description:     "2",
+		Status:          tender.Published,
+		ServiceType:     "Delivery",
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+	if err != nil {
+		s.T().Fatalf("Failed to create tender: %v", err)
+	}
+
+	saved, err := s.bidRepository.SaveBid(ctx, bid.Bid{
+		Name:        "3",
+		Description: "3",
+		Status:      bid.Published,
+		TenderId:    tend.Id,
+		AuthorType:  authorType,
+		AuthorId:    authorId,
+	})
+	if err != nil {
+		s.T().Fatalf("Failed to create bid: %v", err)
+	}
+	return saved
+}
+
+func (s *ApiTestSuite) rateBid(b bid.Bid, username string, quality, timeliness, communication int) {
+	_, err := s.feedbackRepository.SaveRatedFeedback(context.Background(), entity.Feedback{
+		BidId:       b.Id,
+		Description: "feedback",
+		Username:    username,
+	}, rating.Rating{
+		SupplierId:    b.AuthorId,
+		TenderId:      b.TenderId,
+		Quality:       quality,
+		Timeliness:    timeliness,
+		Communication: communication,
+	})
+	if err != nil {
+		s.T().Fatalf("Failed to rate bid: %v", err)
+	}
+}
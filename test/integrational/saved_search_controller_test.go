@@ -0,0 +1,223 @@
+package integrational
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"tender-service/internal/model/dto"
+	"tender-service/internal/model/entity/tender"
+	"tender-service/test"
+	"time"
+)
+
+func (s *ApiTestSuite) TestCreateSavedSearch() {
+	s.createEmployee("supplier")
+
+	budgetTo := 1000000.0
+	given := dto.CreateSavedSearchDto{
+		Name:         "Стройка",
+		Query:        "строительство",
+		ServiceTypes: []tender.ServiceType{tender.Construction},
+		BudgetTo:     &budgetTo,
+	}
+
+	actual, err := http.Post(s.host+"/searches/new?username=supplier", typeJson, test.ToBuffer(given))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/search/response/TestCreateSavedSearch")
+	test.ValidateJsonResponse(s.T(), actual, expected, 200)
+}
+
+func (s *ApiTestSuite) TestReturn400WhenCreateSavedSearchWithIncorrectServiceType() {
+	s.createEmployee("supplier")
+
+	given := dto.CreateSavedSearchDto{
+		Name:         "Стройка",
+		ServiceTypes: []tender.ServiceType{"Something"},
+	}
+
+	actual, err := http.Post(s.host+"/searches/new?username=supplier", typeJson, test.ToBuffer(given))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/search/response/TestReturn400WhenCreateSavedSearchWithIncorrectServiceType")
+	test.ValidateJsonResponse(s.T(), actual, expected, 400)
+}
+
+func (s *ApiTestSuite) TestReturn400WhenCreateSavedSearchWithUnknownCategory() {
+	s.createEmployee("supplier")
+
+	given := dto.CreateSavedSearchDto{
+		Name:        "Стройка",
+		CategoryIds: []uuid.UUID{uuid.New()},
+	}
+
+	actual, err := http.Post(s.host+"/searches/new?username=supplier", typeJson, test.ToBuffer(given))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/search/response/TestReturn400WhenCreateSavedSearchWithUnknownCategory")
+	test.ValidateJsonResponse(s.T(), actual, expected, 400)
+}
+
+func (s *ApiTestSuite) TestGetMySavedSearches() {
+	s.createEmployee("supplier")
+	s.createEmployee("other")
+
+	ctx := context.Background()
+	s.savedSearchRepository.SaveSavedSearch(ctx, tender.SavedSearch{Name: "1", Username: "supplier"})
+	s.savedSearchRepository.SaveSavedSearch(ctx, tender.SavedSearch{Name: "2", Username: "supplier", Query: "поставка"})
+	s.savedSearchRepository.SaveSavedSearch(ctx, tender.SavedSearch{Name: "3", Username: "other"})
+
+	actual, err := http.Get(s.host + "/searches/my?username=supplier")
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/search/response/TestGetMySavedSearches")
+	test.ValidateJsonResponse(s.T(), actual, expected, 200)
+}
+
+func (s *ApiTestSuite) TestDeleteSavedSearch() {
+	testCases := []struct {
+		name     string
+		username string
+		status   int
+	}{
+		{name: "WhenOwner", username: "supplier", status: 204},
+		{name: "WhenNotOwner", username: "other", status: 403},
+		{name: "WhenEmployeeDontExists", username: "ghost", status: 401},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.createEmployee("supplier")
+			s.createEmployee("other")
+
+			search, _ := s.savedSearchRepository.SaveSavedSearch(context.Background(), tender.SavedSearch{Name: "1", Username: "supplier"})
+
+			actual, err := test.HttpDelete(s.host + fmt.Sprintf("/searches/%s?username=%s", search.Id.String(), tc.username))
+			if err != nil {
+				s.T().Fatalf("Failed to send request: %v", err)
+			}
+			defer actual.Body.Close()
+
+			require.Equal(s.T(), tc.status, actual.StatusCode)
+		})
+	}
+}
+
+func (s *ApiTestSuite) TestPublishTenderEnqueuesAlertsForMatchingSearches() {
+	ctx := context.Background()
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	s.createEmployee("matching")
+	s.createEmployee("other")
+
+	budgetFrom := 100.0
+	s.savedSearchRepository.SaveSavedSearch(ctx, tender.SavedSearch{
+		Name:         "Стройка",
+		Username:     "matching",
+		Query:        "строительство моста",
+		ServiceTypes: []tender.ServiceType{tender.Construction},
+		BudgetFrom:   &budgetFrom,
+	})
+	s.savedSearchRepository.SaveSavedSearch(ctx, tender.SavedSearch{
+		Name:         "Доставка",
+		Username:     "other",
+		ServiceTypes: []tender.ServiceType{tender.Delivery},
+	})
+
+	budget := 5000.0
+	deadline := time.Now().Add(24 * time.Hour)
+	tend, _ := s.tenderRepository.SaveTender(ctx, tender.Tender{
+		Name:            "Строительство моста",
+		Description:     "Строительство пешеходного моста через реку",
+		Status:          tender.Created,
+		ServiceType:     tender.Construction,
+		Budget:          &budget,
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+		Policy:          tender.Policy{BidDeadline: &deadline},
+	})
+
+	for i := 0; i < 2; i++ {
+		actual, err := test.HttpPut(s.host+fmt.Sprintf("/tenders/%s/status?username=test&status=%s", tend.Id.String(), tender.Published), nil)
+		if err != nil {
+			s.T().Fatalf("Failed to send request: %v", err)
+		}
+		actual.Body.Close()
+		require.Equal(s.T(), 200, actual.StatusCode)
+	}
+
+	var recipients []string
+	rows, err := s.pool.Query(ctx, "SELECT recipient FROM notification WHERE payload->>'tenderId' = $1", tend.Id.String())
+	require.NoError(s.T(), err)
+	for rows.Next() {
+		var recipient string
+		require.NoError(s.T(), rows.Scan(&recipient))
+		recipients = append(recipients, recipient)
+	}
+	rows.Close()
+
+	require.Equal(s.T(), []string{"matching"}, recipients)
+}
+
+func (s *ApiTestSuite) TestPublishTenderEnqueuesAlertsForCategorySearches() {
+	ctx := context.Background()
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	s.createEmployee("matching")
+	s.createEmployee("other")
+
+	rootId := s.createCategory("A", nil)
+	childId := s.createCategory("A01", &rootId)
+
+	s.savedSearchRepository.SaveSavedSearch(ctx, tender.SavedSearch{
+		Name:        "Сельское хозяйство",
+		Username:    "matching",
+		CategoryIds: []uuid.UUID{rootId},
+	})
+	s.savedSearchRepository.SaveSavedSearch(ctx, tender.SavedSearch{
+		Name:         "Стройка",
+		Username:     "other",
+		ServiceTypes: []tender.ServiceType{tender.Construction},
+	})
+
+	tend, _ := s.tenderRepository.SaveTender(ctx, tender.Tender{
+		Name:            "Уборка урожая",
+		Description:     "Уборка урожая пшеницы",
+		Status:          tender.Created,
+		CategoryIds:     []uuid.UUID{childId},
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+
+	actual, err := test.HttpPut(s.host+fmt.Sprintf("/tenders/%s/status?username=test&status=%s", tend.Id.String(), tender.Published), nil)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	actual.Body.Close()
+	require.Equal(s.T(), 200, actual.StatusCode)
+
+	var recipients []string
+	rows, err := s.pool.Query(ctx, "SELECT recipient FROM notification WHERE payload->>'tenderId' = $1", tend.Id.String())
+	require.NoError(s.T(), err)
+	for rows.Next() {
+		var recipient string
+		require.NoError(s.T(), rows.Scan(&recipient))
+		recipients = append(recipients, recipient)
+	}
+	rows.Close()
+
+	require.Equal(s.T(), []string{"matching"}, recipients)
+}
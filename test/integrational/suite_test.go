@@ -11,30 +11,51 @@ import (
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
 	"github.com/testcontainers/testcontainers-go/wait"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"log"
 	"math/rand"
 	"reflect"
 	"tender-service/internal/app"
 	"tender-service/internal/config"
+	"tender-service/internal/model/entity/award"
+	"tender-service/internal/model/entity/bid"
+	"tender-service/internal/model/entity/tender"
 	"tender-service/internal/repository"
+	tenderv1 "tender-service/pkg/api/tender/v1"
 	"testing"
 	"time"
 	"unsafe"
 )
 
-const typeJson = "application/json"
+const (
+	typeJson   = "application/json"
+	adminToken = "admin-token"
+)
 
 type ApiTestSuite struct {
 	suite.SetupAllSuite
 	suite.Suite
-	container          *postgres.PostgresContainer
-	app                *app.App
-	pool               *pgxpool.Pool
-	host               string
-	tenderRepository   repository.TenderRepository
-	bidRepository      repository.BidRepository
-	decisionRepository repository.DecisionRepository
-	feedbackRepository repository.FeedbackRepository
+	container             *postgres.PostgresContainer
+	app                   *app.App
+	ctl                   *app.Ctl
+	pool                  *pgxpool.Pool
+	conn                  string
+	host                  string
+	tenderRepository      repository.TenderRepository
+	bidRepository         repository.BidRepository
+	decisionRepository    repository.DecisionRepository
+	feedbackRepository    repository.FeedbackRepository
+	savedSearchRepository repository.SavedSearchRepository
+	awardRepository       repository.AwardRepository
+	contractRepository    repository.ContractRepository
+	grpcConn              *grpc.ClientConn
+	tenderClient          tenderv1.TenderServiceClient
+	bidClient             tenderv1.BidServiceClient
+	spans                 *tracetest.SpanRecorder
 }
 
 func TestControllers(t *testing.T) {
@@ -46,6 +67,10 @@ func (s *ApiTestSuite) SetupSuite() {
 
 	ctx := context.Background()
 
+	// the app leaves the global tracer provider alone without an exporter, so spans end up in the recorder
+	s.spans = tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(s.spans)))
+
 	psql, err := postgres.Run(ctx,
 		"docker.io/postgres:16-alpine",
 		postgres.WithDatabase("tender-service"),
@@ -64,17 +89,26 @@ func (s *ApiTestSuite) SetupSuite() {
 		log.Fatal("XD", err.Error())
 	}
 
-	randomPort := rand.Intn(65535-1024+1) + 1024
+	randomPort := rand.Intn(65535-1025+1) + 1025
 
 	s.host = fmt.Sprintf("http://localhost:%d/api", randomPort)
+	grpcAddress := fmt.Sprintf("localhost:%d", randomPort-1)
 
 	fmt.Println(conn)
 
 	curApp, err := app.NewApp(context.Background(), config.Config{
 		Server: config.ServerConfig{Address: fmt.Sprintf(":%d", randomPort)},
 		Postgres: config.PostgresConfig{
-			MigrationsDir: "../../migrations/test/clear",
-			Conn:          conn,
+			MigrationsDir:    "../../migrations/test/clear",
+			MigrateOnStartup: true,
+			Conn:             conn,
+		},
+		Admin: config.AdminConfig{Token: adminToken},
+		Grpc:  config.GrpcConfig{Enabled: true, Address: grpcAddress},
+		OpenApi: config.OpenApiConfig{
+			Path:              openApiPath,
+			ValidateRequests:  true,
+			ValidateResponses: true,
 		},
 	})
 	if err != nil {
@@ -94,7 +128,18 @@ func (s *ApiTestSuite) SetupSuite() {
 	}
 
 	s.pool = pool
+	s.conn = conn
 	s.app = curApp
+	s.ctl = app.NewCtl(config.Config{Postgres: config.PostgresConfig{Conn: conn}})
+
+	grpcConn, err := grpc.NewClient(grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatal("cannot create grpc client:", err.Error())
+	}
+
+	s.grpcConn = grpcConn
+	s.tenderClient = tenderv1.NewTenderServiceClient(grpcConn)
+	s.bidClient = tenderv1.NewBidServiceClient(grpcConn)
 
 	v := reflect.ValueOf(s.app).Elem()
 	providerField := v.FieldByName("provider")
@@ -111,9 +156,20 @@ func (s *ApiTestSuite) SetupSuite() {
 
 	decisionRepoField := providerValue.Elem().FieldByName("decisionRepository")
 	s.decisionRepository = reflect.NewAt(decisionRepoField.Type(), unsafe.Pointer(decisionRepoField.UnsafeAddr())).Elem().Interface().(repository.DecisionRepository)
+
+	savedSearchRepoField := providerValue.Elem().FieldByName("savedSearchRepository")
+	s.savedSearchRepository = reflect.NewAt(savedSearchRepoField.Type(), unsafe.Pointer(savedSearchRepoField.UnsafeAddr())).Elem().Interface().(repository.SavedSearchRepository)
+
+	awardRepoField := providerValue.Elem().FieldByName("awardRepository")
+	s.awardRepository = reflect.NewAt(awardRepoField.Type(), unsafe.Pointer(awardRepoField.UnsafeAddr())).Elem().Interface().(repository.AwardRepository)
+
+	contractRepoField := providerValue.Elem().FieldByName("contractRepository")
+	s.contractRepository = reflect.NewAt(contractRepoField.Type(), unsafe.Pointer(contractRepoField.UnsafeAddr())).Elem().Interface().(repository.ContractRepository)
 }
 
 func (s *ApiTestSuite) TearDownSuite() {
+	_ = s.grpcConn.Close()
+	s.ctl.Close()
 	_ = s.container.Terminate(context.Background())
 	_ = s.app.Stop()
 	_ = s.pool
@@ -122,13 +178,15 @@ func (s *ApiTestSuite) TearDownSuite() {
 func (s *ApiTestSuite) BeforeTest(suiteName, testName string) {
 	log.Println("clear")
 	_, _ = s.pool.Exec(context.Background(),
-		"TRUNCATE employee, organization, organization_responsible, tender, tender_version, bid, bid_version, decision, feedback;")
+		"TRUNCATE employee, organization, organization_responsible, tender, tender_version, tender_search, bid, bid_version, decision, award, contract, contract_milestone, feedback, rating, saved_search, notification, idempotency_key, rate_limit_bucket;"+
+			"DELETE FROM category WHERE service_type IS NULL;")
 }
 
 func (s *ApiTestSuite) SetupSubTest() {
 	log.Println("clear sub")
 	_, _ = s.pool.Exec(context.Background(),
-		"TRUNCATE employee, organization, organization_responsible, tender, tender_version, bid, bid_version, decision, feedback;")
+		"TRUNCATE employee, organization, organization_responsible, tender, tender_version, tender_search, bid, bid_version, decision, award, contract, contract_milestone, feedback, rating, saved_search, notification, idempotency_key, rate_limit_bucket;"+
+			"DELETE FROM category WHERE service_type IS NULL;")
 }
 
 func (s *ApiTestSuite) createEmployeeInOrg(username string, orgId uuid.UUID) uuid.UUID {
@@ -207,3 +265,56 @@ func (s *ApiTestSuite) bindEmployeeToOrg(emplId, orgId uuid.UUID) {
 
 	rows.Close()
 }
+
+func (s *ApiTestSuite) getCategoryIdByCode(code string) uuid.UUID {
+	var id uuid.UUID
+	if err := s.pool.QueryRow(context.Background(), "SELECT id FROM category WHERE code = $1", code).Scan(&id); err != nil {
+		log.Fatalf("Failed to get category: %s", err)
+	}
+	return id
+}
+
+func (s *ApiTestSuite) createCategory(code string, parentId *uuid.UUID) uuid.UUID {
+	var id uuid.UUID
+	err := s.pool.QueryRow(context.Background(),
+		"INSERT INTO category (code, name, parent_id) VALUES ($1, $1, $2) RETURNING id", code, parentId).Scan(&id)
+	if err != nil {
+		s.T().Fatalf("Failed to create category: %v", err)
+	}
+	return id
+}
+
+func (s *ApiTestSuite) createAwardedTender(orgId, supplierId uuid.UUID, price *float64) award.Award {
+	ctx := context.Background()
+
+	tend, err := s.tenderRepository.SaveTender(ctx, tender.Tender{
+		Name:            "1",
+		Description:     "2",
+		Status:          tender.Published,
+		ServiceType:     "Delivery",
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+	if err != nil {
+		s.T().Fatalf("Failed to create tender: %v", err)
+	}
+
+	winner, err := s.bidRepository.SaveBid(ctx, bid.Bid{
+		Name:        "3",
+		Description: "3",
+		Price:       price,
+		Status:      bid.Published,
+		TenderId:    tend.Id,
+		AuthorType:  bid.AuthorUser,
+		AuthorId:    supplierId,
+	})
+	if err != nil {
+		s.T().Fatalf("Failed to create bid: %v", err)
+	}
+
+	awarded, err := s.awardRepository.AwardBid(ctx, winner.Id)
+	if err != nil {
+		s.T().Fatalf("Failed to award bid: %v", err)
+	}
+	return awarded
+}
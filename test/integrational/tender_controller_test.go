@@ -2,12 +2,19 @@ package integrational
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
 	"net/http"
+	"strings"
 	"tender-service/internal/model/dto"
+	"tender-service/internal/model/entity/bid"
+	"tender-service/internal/model/entity/decision"
 	"tender-service/internal/model/entity/tender"
+	"tender-service/internal/util"
 	"tender-service/test"
+	"time"
 )
 
 func (s *ApiTestSuite) TestCreateTender() {
@@ -32,6 +39,181 @@ func (s *ApiTestSuite) TestCreateTender() {
 	test.ValidateJsonResponse(s.T(), actual, expected, 200)
 }
 
+func (s *ApiTestSuite) TestCreateTenderIsIdempotent() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+
+	given := dto.CreateTenderDto{
+		Name:            "1",
+		Description:     "1",
+		ServiceType:     tender.Construction,
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	}
+	headers := map[string]string{"Idempotency-Key": "create-tender-1"}
+
+	first, err := test.HttpWithHeaders(http.MethodPost, s.host+"/tenders/new", headers, given)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer first.Body.Close()
+
+	second, err := test.HttpWithHeaders(http.MethodPost, s.host+"/tenders/new", headers, given)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer second.Body.Close()
+
+	var firstTender, secondTender dto.TenderDto
+	require.NoError(s.T(), json.NewDecoder(first.Body).Decode(&firstTender))
+	require.NoError(s.T(), json.NewDecoder(second.Body).Decode(&secondTender))
+
+	require.Equal(s.T(), 200, second.StatusCode)
+	require.Equal(s.T(), "true", second.Header.Get("Idempotent-Replayed"))
+	require.Equal(s.T(), firstTender.Id, secondTender.Id)
+
+	var count int
+	require.NoError(s.T(), s.pool.QueryRow(context.Background(), "SELECT COUNT(*) FROM tender").Scan(&count))
+	require.Equal(s.T(), 1, count)
+}
+
+func (s *ApiTestSuite) TestReturn422WhenCreateTenderWithReusedIdempotencyKey() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+
+	given := dto.CreateTenderDto{
+		Name:            "1",
+		Description:     "1",
+		ServiceType:     tender.Construction,
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	}
+	headers := map[string]string{"Idempotency-Key": "create-tender-2"}
+
+	first, err := test.HttpWithHeaders(http.MethodPost, s.host+"/tenders/new", headers, given)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	first.Body.Close()
+
+	given.Name = "2"
+
+	actual, err := test.HttpWithHeaders(http.MethodPost, s.host+"/tenders/new", headers, given)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/tender/response/TestReturn422WhenCreateTenderWithReusedIdempotencyKey")
+	test.ValidateJsonResponse(s.T(), actual, expected, 422)
+}
+
+func (s *ApiTestSuite) TestReturn413WhenCreateTenderWithIdempotencyKeyAndTooLargeBody() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+
+	given := map[string]any{
+		"name":            "1",
+		"description":     "1",
+		"serviceType":     tender.Construction,
+		"organizationId":  orgId,
+		"creatorUsername": "test",
+		"padding":         strings.Repeat("1", 2<<20),
+	}
+	headers := map[string]string{"Idempotency-Key": "create-tender-3"}
+
+	actual, err := test.HttpWithHeaders(http.MethodPost, s.host+"/tenders/new", headers, given)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/tender/response/TestReturn413WhenCreateTenderWithIdempotencyKeyAndTooLargeBody")
+	test.ValidateJsonResponse(s.T(), actual, expected, 413)
+}
+
+func (s *ApiTestSuite) TestReturn400WhenCreateTenderWithoutRequiredFields() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+
+	given := dto.CreateTenderDto{
+		Name:            "1",
+		ServiceType:     tender.Construction,
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	}
+
+	actual, err := test.HttpWithHeaders(http.MethodPost, s.host+"/tenders/new", map[string]string{"X-Request-ID": "validation-request"}, given)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	require.Equal(s.T(), "application/problem+json", actual.Header.Get("Content-Type"))
+	expected := test.ReadJson("/tender/response/TestReturn400WhenCreateTenderWithoutRequiredFields")
+	test.ValidateJsonResponse(s.T(), actual, expected, 400)
+}
+
+func (s *ApiTestSuite) TestReturn400InRussianWhenCreateTenderWithoutRequiredFields() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+
+	given := dto.CreateTenderDto{
+		Name:            "1",
+		ServiceType:     tender.Construction,
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	}
+
+	actual, err := test.HttpWithHeaders(http.MethodPost, s.host+"/tenders/new", map[string]string{"Accept-Language": "ru-RU,ru;q=0.9,en;q=0.8"}, given)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	require.Equal(s.T(), "ru", actual.Header.Get("Content-Language"))
+	expected := test.ReadJson("/tender/response/TestReturn400InRussianWhenCreateTenderWithoutRequiredFields")
+	test.ValidateJsonResponse(s.T(), actual, expected, 400)
+}
+
+func (s *ApiTestSuite) TestReturn422InRussianWhenCreateTenderWithMalformedBody() {
+	request, err := http.NewRequest(http.MethodPost, s.host+"/tenders/new", strings.NewReader(`{"name":`))
+	require.NoError(s.T(), err)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Accept-Language", "ru")
+
+	actual, err := http.DefaultClient.Do(request)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/tender/response/TestReturn422InRussianWhenCreateTenderWithMalformedBody")
+	test.ValidateJsonResponse(s.T(), actual, expected, 422)
+}
+
+func (s *ApiTestSuite) TestReturn400WhenCreateTenderWithTooLongName() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+
+	given := dto.CreateTenderDto{
+		Name:            strings.Repeat("a", 101),
+		Description:     "2",
+		ServiceType:     tender.Construction,
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	}
+
+	actual, err := http.Post(s.host+"/tenders/new", typeJson, test.ToBuffer(given))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/tender/response/TestReturn400WhenCreateTenderWithTooLongName")
+	test.ValidateJsonResponse(s.T(), actual, expected, 400)
+}
+
 func (s *ApiTestSuite) TestReturn404WhenCreateTenderAndGroupDontExists() {
 	s.createEmployee("test")
 	id, _ := uuid.Parse("12d5ca77-d755-49c4-a5ab-1502966ccde0")
@@ -218,6 +400,140 @@ func (s *ApiTestSuite) TestGetTendersListWithPagination() {
 	}
 }
 
+func (s *ApiTestSuite) TestGetTendersListWithCursor() {
+	ctx := context.Background()
+	id := s.createOrganization()
+	s.createEmployee("aboba")
+
+	for _, name := range []string{"1", "2", "3"} {
+		s.tenderRepository.SaveTender(ctx, tender.Tender{
+			Name:            name,
+			Description:     name,
+			Status:          "Published",
+			ServiceType:     tender.Delivery,
+			OrganizationId:  id,
+			CreatorUsername: "aboba",
+		})
+	}
+
+	first, err := http.Get(s.host + "/tenders?limit=2&with_total=true")
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer first.Body.Close()
+
+	require.Equal(s.T(), "3", first.Header.Get(util.TotalCountHeader))
+	cursor := first.Header.Get(util.NextCursorHeader)
+	require.NotEmpty(s.T(), cursor)
+
+	expected := test.ReadJson("/tender/response/TestGetTendersListWithCursor/First")
+	test.ValidateJsonResponse(s.T(), first, expected, 200)
+
+	second, err := http.Get(s.host + fmt.Sprintf("/tenders?limit=2&cursor=%s", cursor))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer second.Body.Close()
+
+	require.Empty(s.T(), second.Header.Get(util.NextCursorHeader))
+
+	expected = test.ReadJson("/tender/response/TestGetTendersListWithCursor/Second")
+	test.ValidateJsonResponse(s.T(), second, expected, 200)
+}
+
+func (s *ApiTestSuite) TestReturn400WhenGetTendersListWithIncorrectPagination() {
+	testCases := []struct {
+		name  string
+		param string
+	}{
+		{name: "NegativeLimit", param: "limit=-1"},
+		{name: "NegativeOffset", param: "offset=-1"},
+		{name: "MalformedCursor", param: "cursor=abc"},
+		{name: "CursorWithOffset", param: "offset=1&cursor=eyJjcmVhdGVkQXQiOiIyMDI0LTAxLTAxVDAwOjAwOjAwWiIsImlkIjoiMDAwMDAwMDAtMDAwMC0wMDAwLTAwMDAtMDAwMDAwMDAwMDAxIn0"},
+		{name: "CursorOfOtherSort", param: "sort=created_at&cursor=eyJ2YWx1ZXMiOlsiYSJdLCJzb3J0IjpbeyJmaWVsZCI6Im5hbWUifV0sImlkIjoiMDAwMDAwMDAtMDAwMC0wMDAwLTAwMDAtMDAwMDAwMDAwMDAxIn0"},
+		{name: "CursorWithIncorrectValue", param: "cursor=eyJ2YWx1ZXMiOlsiYWJjIl0sInNvcnQiOlt7ImZpZWxkIjoiY3JlYXRlZF9hdCJ9XSwiaWQiOiIwMDAwMDAwMC0wMDAwLTAwMDAtMDAwMC0wMDAwMDAwMDAwMDEifQ"},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			actual, err := http.Get(s.host + fmt.Sprintf("/tenders?%s", tc.param))
+			if err != nil {
+				s.T().Fatalf("Failed to send request: %v", err)
+			}
+			defer actual.Body.Close()
+
+			expected := test.ReadJson("/tender/response/TestReturn400WhenGetTendersListWithIncorrectPagination/" + tc.name)
+			test.ValidateJsonResponse(s.T(), actual, expected, 400)
+		})
+	}
+}
+
+func (s *ApiTestSuite) TestGetTendersListWithSort() {
+	testCases := []struct {
+		name  string
+		param string
+	}{
+		{name: "NameDesc", param: "sort=-name"},
+		{name: "VersionDescName", param: "sort=-version,name"},
+		{name: "NameDescWithCursor", param: "sort=-name&limit=2"},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx := context.Background()
+			id := s.createOrganization()
+			s.createEmployee("aboba")
+
+			for _, name := range []string{"b", "c", "a"} {
+				s.tenderRepository.SaveTender(ctx, tender.Tender{
+					Name:            name,
+					Description:     name,
+					Status:          "Published",
+					ServiceType:     tender.Delivery,
+					OrganizationId:  id,
+					CreatorUsername: "aboba",
+				})
+			}
+			tend, _ := s.tenderRepository.SaveTender(ctx, tender.Tender{
+				Name:            "d",
+				Description:     "d",
+				Status:          "Published",
+				ServiceType:     tender.Delivery,
+				OrganizationId:  id,
+				CreatorUsername: "aboba",
+			})
+			s.tenderRepository.UpdateTender(ctx, tend.Id, "0", "d", tender.Delivery, nil, nil)
+
+			actual, err := http.Get(s.host + fmt.Sprintf("/tenders?%s", tc.param))
+			if err != nil {
+				s.T().Fatalf("Failed to send request: %v", err)
+			}
+			defer actual.Body.Close()
+
+			if cursor := actual.Header.Get(util.NextCursorHeader); cursor != "" {
+				actual.Body.Close()
+				actual, err = http.Get(s.host + fmt.Sprintf("/tenders?%s&cursor=%s", tc.param, cursor))
+				if err != nil {
+					s.T().Fatalf("Failed to send request: %v", err)
+				}
+				defer actual.Body.Close()
+			}
+
+			expected := test.ReadJson("/tender/response/TestGetTendersListWithSort/" + tc.name)
+			test.ValidateJsonResponse(s.T(), actual, expected, 200)
+		})
+	}
+}
+
+func (s *ApiTestSuite) TestReturn400WhenGetTendersListWithIncorrectSort() {
+	actual, err := http.Get(s.host + "/tenders?sort=description")
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/tender/response/TestReturn400WhenGetTendersListWithIncorrectSort")
+	test.ValidateJsonResponse(s.T(), actual, expected, 400)
+}
+
 func (s *ApiTestSuite) TestGetTendersListWithIncorrectFilters() {
 	actual, err := http.Get(s.host + fmt.Sprintf("/tenders?service_type=%s", "something"))
 	if err != nil {
@@ -455,6 +771,84 @@ func (s *ApiTestSuite) TestGetMyTenders() {
 	test.ValidateJsonResponse(s.T(), actual, expected, 200)
 }
 
+func (s *ApiTestSuite) TestGetMyTendersByOutcome() {
+	ctx := context.Background()
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	bidCreatorId := s.createEmployee("creator")
+
+	awarded, _ := s.tenderRepository.SaveTender(ctx, tender.Tender{
+		Name:            "awarded",
+		Description:     "2",
+		Status:          tender.Closed,
+		ServiceType:     "Delivery",
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+	b, _ := s.bidRepository.SaveBid(ctx, bid.Bid{
+		Name:        "3",
+		Description: "3",
+		Status:      bid.Published,
+		TenderId:    awarded.Id,
+		AuthorType:  bid.AuthorUser,
+		AuthorId:    bidCreatorId,
+	})
+	s.bidRepository.UpdateBidDecision(ctx, b.Id, bid.Approved)
+	s.tenderRepository.SaveTender(ctx, tender.Tender{
+		Name:            "closed",
+		Description:     "2",
+		Status:          tender.Closed,
+		ServiceType:     "Delivery",
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+	cancelled, _ := s.tenderRepository.SaveTender(ctx, tender.Tender{
+		Name:            "cancelled",
+		Description:     "2",
+		Status:          tender.Published,
+		ServiceType:     "Delivery",
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+	s.tenderRepository.CancelTender(ctx, cancelled.Id, "no budget")
+	s.tenderRepository.SaveTender(ctx, tender.Tender{
+		Name:            "published",
+		Description:     "2",
+		Status:          tender.Published,
+		ServiceType:     "Delivery",
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+
+	actual, err := http.Get(s.host + fmt.Sprintf("/tenders/my?username=%s&outcome=%s,%s", "test", tender.OutcomeClosedWithoutAward, tender.OutcomeCancelled))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	var tenders []dto.TenderDto
+	require.NoError(s.T(), json.NewDecoder(actual.Body).Decode(&tenders))
+	require.Equal(s.T(), 200, actual.StatusCode)
+
+	var names []string
+	for _, t := range tenders {
+		names = append(names, t.Name)
+	}
+	require.ElementsMatch(s.T(), []string{"closed", "cancelled"}, names)
+}
+
+func (s *ApiTestSuite) TestReturn400WhenGetMyTendersAndIncorrectOutcome() {
+	s.createEmployee("test")
+
+	actual, err := http.Get(s.host + fmt.Sprintf("/tenders/my?username=%s&outcome=%s", "test", "something"))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	require.Equal(s.T(), http.StatusBadRequest, actual.StatusCode)
+}
+
 func (s *ApiTestSuite) TestReturn401WhenGetMyTendersAndEmployeeDoesNotExists() {
 	actual, err := http.Get(s.host + fmt.Sprintf("/tenders/my?username=%s", "test"))
 	if err != nil {
@@ -466,6 +860,29 @@ func (s *ApiTestSuite) TestReturn401WhenGetMyTendersAndEmployeeDoesNotExists() {
 	test.ValidateJsonResponse(s.T(), actual, expected, 401)
 }
 
+func (s *ApiTestSuite) TestReturn401InRussianWhenGetMyTendersAndEmployeeDoesNotExists() {
+	actual, err := test.HttpWithHeaders(http.MethodGet, s.host+fmt.Sprintf("/tenders/my?username=%s", "test"), map[string]string{"Accept-Language": "ru"}, nil)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/tender/response/TestReturn401InRussianWhenGetMyTendersAndEmployeeDoesNotExists")
+	test.ValidateJsonResponse(s.T(), actual, expected, 401)
+}
+
+func (s *ApiTestSuite) TestReturn401InEnglishWhenGetMyTendersAndLanguageNotSupported() {
+	actual, err := test.HttpWithHeaders(http.MethodGet, s.host+fmt.Sprintf("/tenders/my?username=%s", "test"), map[string]string{"Accept-Language": "de-DE"}, nil)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	require.Equal(s.T(), "en", actual.Header.Get("Content-Language"))
+	expected := test.ReadJson("/tender/response/TestReturn401WhenGetMyTendersAndEmployeeDoesNotExists")
+	test.ValidateJsonResponse(s.T(), actual, expected, 401)
+}
+
 func (s *ApiTestSuite) TestEditTender() {
 	testCases := []struct {
 		name     string
@@ -594,6 +1011,317 @@ func (s *ApiTestSuite) TestReturn400WhenUpdateTenderStatusAndIncorrectStatus() {
 	test.ValidateJsonResponse(s.T(), actual, expected, http.StatusBadRequest)
 }
 
+func (s *ApiTestSuite) TestPublishTenderWithDeadline() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	deadline := time.Now().Add(24 * time.Hour)
+
+	tend, _ := s.tenderRepository.SaveTender(context.Background(), tender.Tender{
+		Name:            "1",
+		Description:     "2",
+		Status:          tender.Created,
+		ServiceType:     "Delivery",
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+		Policy:          tender.Policy{BidDeadline: &deadline},
+	})
+
+	actual, err := test.HttpPut(s.host+fmt.Sprintf("/tenders/%s/status?username=%s&status=%s", tend.Id.String(), "test", tender.Published), nil)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/tender/response/TestPublishTenderWithDeadline")
+	test.ValidateJsonResponse(s.T(), actual, expected, http.StatusOK)
+}
+
+func (s *ApiTestSuite) TestReturn409WhenUpdateTenderStatusAndTransitionNotAllowed() {
+	testCases := []struct {
+		name       string
+		status     tender.Status
+		from       tender.Status
+		votedOnBid bool
+	}{
+		{name: "WhenPublishWithoutDeadline", from: tender.Created, status: tender.Published},
+		{name: "WhenReopenClosedTender", from: tender.Closed, status: tender.Published},
+		{name: "WhenCloseWithPendingVotes", from: tender.Published, status: tender.Closed, votedOnBid: true},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx := context.Background()
+			orgId := s.createOrganization()
+			s.createEmployeeInOrg("test", orgId)
+			bidCreatorId := s.createEmployee("creator")
+
+			tend, _ := s.tenderRepository.SaveTender(ctx, tender.Tender{
+				Name:            "1",
+				Description:     "2",
+				Status:          tc.from,
+				ServiceType:     "Delivery",
+				OrganizationId:  orgId,
+				CreatorUsername: "test",
+			})
+
+			if tc.votedOnBid {
+				b, _ := s.bidRepository.SaveBid(ctx, bid.Bid{
+					Name:        "3",
+					Description: "3",
+					Status:      bid.Published,
+					TenderId:    tend.Id,
+					AuthorType:  bid.AuthorUser,
+					AuthorId:    bidCreatorId,
+				})
+				s.decisionRepository.SaveDecision(ctx, decision.Decision{
+					Verdict:  decision.Approved,
+					Username: "test",
+					BidId:    b.Id,
+				})
+			}
+
+			actual, err := test.HttpPut(s.host+fmt.Sprintf("/tenders/%s/status?username=%s&status=%s", tend.Id.String(), "test", tc.status), nil)
+			if err != nil {
+				s.T().Fatalf("Failed to send request: %v", err)
+			}
+			defer actual.Body.Close()
+
+			expected := test.ReadJson("/tender/response/TestReturn409WhenUpdateTenderStatusAndTransitionNotAllowed/" + tc.name)
+			test.ValidateJsonResponse(s.T(), actual, expected, http.StatusConflict)
+		})
+	}
+}
+
+func (s *ApiTestSuite) TestCancelTender() {
+	ctx := context.Background()
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	bidCreatorId := s.createEmployee("creator")
+
+	tend, _ := s.tenderRepository.SaveTender(ctx, tender.Tender{
+		Name:            "1",
+		Description:     "2",
+		Status:          tender.Published,
+		ServiceType:     "Delivery",
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+	open, _ := s.bidRepository.SaveBid(ctx, bid.Bid{
+		Name:        "3",
+		Description: "3",
+		Status:      bid.Published,
+		TenderId:    tend.Id,
+		AuthorType:  bid.AuthorUser,
+		AuthorId:    bidCreatorId,
+	})
+	s.decisionRepository.SaveDecision(ctx, decision.Decision{
+		Verdict:  decision.Approved,
+		Username: "test",
+		BidId:    open.Id,
+	})
+	canceled, _ := s.bidRepository.SaveBid(ctx, bid.Bid{
+		Name:        "4",
+		Description: "4",
+		Status:      bid.Canceled,
+		TenderId:    tend.Id,
+		AuthorType:  bid.AuthorUser,
+		AuthorId:    bidCreatorId,
+	})
+
+	given := dto.CancelTenderDto{Reason: "budget was withdrawn"}
+
+	actual, err := test.HttpPut(s.host+fmt.Sprintf("/tenders/%s/cancel?username=%s", tend.Id.String(), "test"), given)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/tender/response/TestCancelTender")
+	test.ValidateJsonResponse(s.T(), actual, expected, 200)
+
+	openAfter, _ := s.bidRepository.GetBidById(ctx, open.Id)
+	require.Equal(s.T(), bid.NotAwarded, openAfter.Decision)
+	canceledAfter, _ := s.bidRepository.GetBidById(ctx, canceled.Id)
+	require.Equal(s.T(), bid.None, canceledAfter.Decision)
+
+	var recipients []string
+	rows, err := s.pool.Query(ctx, "SELECT recipient FROM notification WHERE type = 'TenderCancelled' AND payload->>'tenderId' = $1", tend.Id.String())
+	require.NoError(s.T(), err)
+	for rows.Next() {
+		var recipient string
+		require.NoError(s.T(), rows.Scan(&recipient))
+		recipients = append(recipients, recipient)
+	}
+	rows.Close()
+
+	require.Equal(s.T(), []string{"creator"}, recipients)
+}
+
+func (s *ApiTestSuite) TestReturn400WhenUpdateTenderStatusToCancelled() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+
+	tend, _ := s.tenderRepository.SaveTender(context.Background(), tender.Tender{
+		Name:            "1",
+		Description:     "2",
+		Status:          tender.Published,
+		ServiceType:     "Delivery",
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+
+	actual, err := test.HttpPut(s.host+fmt.Sprintf("/tenders/%s/status?username=%s&status=%s", tend.Id.String(), "test", tender.Cancelled), nil)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/tender/response/TestReturn400WhenUpdateTenderStatusToCancelled")
+	test.ValidateJsonResponse(s.T(), actual, expected, http.StatusBadRequest)
+}
+
+func (s *ApiTestSuite) TestReturn409WhenCancelClosedTender() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+
+	tend, _ := s.tenderRepository.SaveTender(context.Background(), tender.Tender{
+		Name:            "1",
+		Description:     "2",
+		Status:          tender.Closed,
+		ServiceType:     "Delivery",
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+
+	actual, err := test.HttpPut(s.host+fmt.Sprintf("/tenders/%s/cancel?username=%s", tend.Id.String(), "test"), dto.CancelTenderDto{Reason: "late"})
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/tender/response/TestReturn409WhenCancelClosedTender")
+	test.ValidateJsonResponse(s.T(), actual, expected, http.StatusConflict)
+}
+
+func (s *ApiTestSuite) TestGetTenderAward() {
+	ctx := context.Background()
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	s.createEmployeeInOrg("test2", orgId)
+	bidCreatorId := s.createEmployee("creator")
+	price := 120000.0
+
+	tend, _ := s.tenderRepository.SaveTender(ctx, tender.Tender{
+		Name:            "1",
+		Description:     "2",
+		Status:          tender.Published,
+		ServiceType:     "Delivery",
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+	winner, _ := s.bidRepository.SaveBid(ctx, bid.Bid{
+		Name:        "3",
+		Description: "3",
+		Price:       &price,
+		Status:      bid.Published,
+		TenderId:    tend.Id,
+		AuthorType:  bid.AuthorUser,
+		AuthorId:    bidCreatorId,
+	})
+	loser, _ := s.bidRepository.SaveBid(ctx, bid.Bid{
+		Name:        "4",
+		Description: "4",
+		Status:      bid.Published,
+		TenderId:    tend.Id,
+		AuthorType:  bid.AuthorUser,
+		AuthorId:    bidCreatorId,
+	})
+	s.decisionRepository.SaveDecision(ctx, decision.Decision{
+		Verdict:  decision.Approved,
+		Username: "test2",
+		BidId:    winner.Id,
+	})
+
+	submitted, err := test.HttpPut(s.host+fmt.Sprintf("/bids/%s/submit_decision?username=%s&decision=Approved", winner.Id.String(), "test"), nil)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	submitted.Body.Close()
+	require.Equal(s.T(), 200, submitted.StatusCode)
+
+	actual, err := http.Get(s.host + fmt.Sprintf("/tenders/%s/award", tend.Id.String()))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/tender/response/TestGetTenderAward")
+	test.ValidateJsonResponse(s.T(), actual, expected, 200)
+
+	award, _ := s.awardRepository.GetAwardByTenderId(ctx, tend.Id)
+	require.Equal(s.T(), winner.Id, award.BidId)
+	closed, _ := s.tenderRepository.GetTenderById(ctx, tend.Id)
+	require.Equal(s.T(), tender.Closed, closed.Status)
+	loserFromDb, _ := s.bidRepository.GetBidById(ctx, loser.Id)
+	require.Equal(s.T(), bid.Lost, loserFromDb.Decision)
+}
+
+func (s *ApiTestSuite) TestAwardBidLeavesNothingWhenTenderNotPublished() {
+	ctx := context.Background()
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+	bidCreatorId := s.createEmployee("creator")
+
+	tend, _ := s.tenderRepository.SaveTender(ctx, tender.Tender{
+		Name:            "1",
+		Description:     "2",
+		Status:          tender.Cancelled,
+		ServiceType:     "Delivery",
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+	b, _ := s.bidRepository.SaveBid(ctx, bid.Bid{
+		Name:        "3",
+		Description: "3",
+		Status:      bid.Published,
+		TenderId:    tend.Id,
+		AuthorType:  bid.AuthorUser,
+		AuthorId:    bidCreatorId,
+	})
+
+	_, err := s.awardRepository.AwardBid(ctx, b.Id)
+	require.Error(s.T(), err)
+
+	fromDb, _ := s.bidRepository.GetBidById(ctx, b.Id)
+	require.Equal(s.T(), bid.None, fromDb.Decision)
+	_, err = s.awardRepository.GetAwardByTenderId(ctx, tend.Id)
+	require.Error(s.T(), err)
+	tenderFromDb, _ := s.tenderRepository.GetTenderById(ctx, tend.Id)
+	require.Equal(s.T(), tender.Cancelled, tenderFromDb.Status)
+}
+
+func (s *ApiTestSuite) TestReturn404WhenGetTenderAwardAndTenderNotClosed() {
+	orgId := s.createOrganization()
+	s.createEmployeeInOrg("test", orgId)
+
+	tend, _ := s.tenderRepository.SaveTender(context.Background(), tender.Tender{
+		Name:            "1",
+		Description:     "2",
+		Status:          tender.Published,
+		ServiceType:     "Delivery",
+		OrganizationId:  orgId,
+		CreatorUsername: "test",
+	})
+
+	actual, err := http.Get(s.host + fmt.Sprintf("/tenders/%s/award", tend.Id.String()))
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/tender/response/TestReturn404WhenGetTenderAwardAndTenderNotClosed")
+	test.ValidateJsonResponse(s.T(), actual, expected, http.StatusNotFound)
+}
+
 func (s *ApiTestSuite) TestRollbackTender() {
 	testCases := []struct {
 		name     string
@@ -621,7 +1349,7 @@ func (s *ApiTestSuite) TestRollbackTender() {
 				CreatorUsername: "test",
 			})
 
-			s.tenderRepository.UpdateTender(ctx, tend.Id, "new", "new", tender.Construction)
+			s.tenderRepository.UpdateTender(ctx, tend.Id, "new", "new", tender.Construction, nil, nil)
 
 			actual, err := test.HttpPut(s.host+fmt.Sprintf("/tenders/%s/rollback/1?username=%s", tend.Id.String(), tc.username), nil)
 			if err != nil {
@@ -671,3 +1399,71 @@ func (s *ApiTestSuite) TestReturn400WhenRollbackTenderWhenTenderVersionDontExist
 	expected := test.ReadJson("/tender/response/TestReturn400WhenRollbackTenderWhenTenderVersionDontExists")
 	test.ValidateJsonResponse(s.T(), actual, expected, 400)
 }
+
+func (s *ApiTestSuite) TestSearchTenders() {
+	testCases := []struct {
+		name  string
+		param string
+	}{
+		{name: "Russian", param: "q=доставка"},
+		{name: "English", param: "q=bridges"},
+		{name: "Budget", param: "q=доставка&budget_from=500&budget_to=1500"},
+		{name: "NoQuery", param: "budget_to=100"},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx := context.Background()
+			id := s.createOrganization()
+			s.createEmployee("aboba")
+
+			small, large := 100.0, 1000.0
+
+			s.tenderRepository.SaveTender(ctx, tender.Tender{
+				Name:            "Доставка оборудования",
+				Description:     "Нужно доставить оборудование в Казань",
+				Status:          tender.Published,
+				ServiceType:     tender.Delivery,
+				Budget:          &large,
+				OrganizationId:  id,
+				CreatorUsername: "aboba",
+			})
+			s.tenderRepository.SaveTender(ctx, tender.Tender{
+				Name:            "Bridge construction",
+				Description:     "Building two bridges over the river",
+				Status:          tender.Published,
+				ServiceType:     tender.Construction,
+				Budget:          &small,
+				OrganizationId:  id,
+				CreatorUsername: "aboba",
+			})
+			s.tenderRepository.SaveTender(ctx, tender.Tender{
+				Name:            "Доставка песка",
+				Description:     "Черновик",
+				Status:          tender.Created,
+				ServiceType:     tender.Delivery,
+				OrganizationId:  id,
+				CreatorUsername: "aboba",
+			})
+
+			actual, err := http.Get(s.host + fmt.Sprintf("/tenders/search?%s", tc.param))
+			if err != nil {
+				s.T().Fatalf("Failed to send request: %v", err)
+			}
+			defer actual.Body.Close()
+
+			expected := test.ReadJson("/tender/response/TestSearchTenders/" + tc.name)
+			test.ValidateJsonResponse(s.T(), actual, expected, 200)
+		})
+	}
+}
+
+func (s *ApiTestSuite) TestReturn403WhenSearchTendersByPrivateStatusWithoutOrganization() {
+	actual, err := http.Get(s.host + "/tenders/search?q=test&status=Created")
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	defer actual.Body.Close()
+
+	expected := test.ReadJson("/tender/response/TestReturn403WhenSearchTendersByPrivateStatusWithoutOrganization")
+	test.ValidateJsonResponse(s.T(), actual, expected, 403)
+}
@@ -0,0 +1,38 @@
+package integrational
+
+import (
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"slices"
+	"strings"
+	"tender-service/test"
+	"time"
+)
+
+const (
+	incomingTraceId     = "4bf92f3577b34da6a3ce929d0e0e4736"
+	incomingTraceparent = "00-" + incomingTraceId + "-00f067aa0ba902b7-01"
+)
+
+func (s *ApiTestSuite) TestTraceparentIsPropagated() {
+	actual, err := test.HttpWithHeaders(http.MethodGet, s.host+"/tenders", map[string]string{"traceparent": incomingTraceparent}, nil)
+	if err != nil {
+		s.T().Fatalf("Failed to send request: %v", err)
+	}
+	actual.Body.Close()
+	require.Equal(s.T(), 200, actual.StatusCode)
+
+	// the server span ends after the response is written, so the recorder is polled
+	require.Eventually(s.T(), func() bool {
+		names := make([]string, 0)
+		for _, span := range s.spans.Ended() {
+			if span.SpanContext().TraceID().String() == incomingTraceId {
+				names = append(names, span.Name())
+			}
+		}
+
+		return slices.Contains(names, "api") &&
+			slices.Contains(names, "tender_service.get_tenders") &&
+			slices.ContainsFunc(names, func(name string) bool { return strings.HasPrefix(name, "sql SELECT") })
+	}, 5*time.Second, 50*time.Millisecond)
+}
@@ -12,21 +12,36 @@ import (
 )
 
 func HttpPut(url string, dto any) (*http.Response, error) {
-	return do(http.MethodPut, url, dto)
+	return do(http.MethodPut, url, dto, nil)
 }
 
 func HttpPatch(url string, dto any) (*http.Response, error) {
-	return do(http.MethodPatch, url, dto)
+	return do(http.MethodPatch, url, dto, nil)
 }
 
-func do(method, url string, dto any) (*http.Response, error) {
+func HttpDelete(url string) (*http.Response, error) {
+	return do(http.MethodDelete, url, nil, nil)
+}
+
+func HttpWithBearer(method, url, token string, dto any) (*http.Response, error) {
+	return do(method, url, dto, map[string]string{"Authorization": "Bearer " + token})
+}
+
+func HttpWithHeaders(method, url string, headers map[string]string, dto any) (*http.Response, error) {
+	return do(method, url, dto, headers)
+}
+
+func do(method, url string, dto any, headers map[string]string) (*http.Response, error) {
 	client := &http.Client{}
 
 	req, err := http.NewRequest(method, url, ToBuffer(dto))
-	req.Header.Set("Content-Type", "application/json")
 	if err != nil {
 		return nil, err
 	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
 
 	resp, err := client.Do(req)
 	if err != nil {